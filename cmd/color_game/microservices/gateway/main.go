@@ -76,7 +76,7 @@ func main() {
 	go wsManager.Run()
 
 	// 6. Initialize Gateway UseCase (forwards requests to GS/GMS via gRPC)
-	gatewayUC := gatewayUseCase.NewGatewayUseCase(cgClient)
+	gatewayUC := gatewayUseCase.NewGatewayUseCase(cgClient, wsManager)
 	logger.InfoGlobal().Msg("✅ Gateway module initialized")
 
 	// Note: In microservices mode, Gateway is a pure proxy:
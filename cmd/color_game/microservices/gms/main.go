@@ -17,13 +17,16 @@ import (
 	colorgameGMSGrpc "github.com/frankieli/game_product/internal/modules/color_game/gms/adapter/grpc"
 	colorgameGMSMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
 	colorgameGMSRepo "github.com/frankieli/game_product/internal/modules/color_game/gms/repository/db"
+	colorgameGMSRedis "github.com/frankieli/game_product/internal/modules/color_game/gms/repository/redis"
 	colorgameGMSUseCase "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
 	"github.com/frankieli/game_product/pkg/admin"
 	"github.com/frankieli/game_product/pkg/discovery"
+	"github.com/frankieli/game_product/pkg/election"
 	"github.com/frankieli/game_product/pkg/grpc_client/base"
 	"github.com/frankieli/game_product/pkg/grpc_client/color_game"
 	"github.com/frankieli/game_product/pkg/logger"
 	"github.com/frankieli/game_product/pkg/netutil"
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
 	pbAdmin "github.com/frankieli/game_product/shared/proto/admin"
 	pb "github.com/frankieli/game_product/shared/proto/colorgame"
 	"github.com/redis/go-redis/v9"
@@ -38,6 +41,15 @@ func main() {
 
 	// 1. Load Config
 	cfg := config.LoadColorGameConfig()
+	paytable, err := colorgame.NewPaytable(cfg.Settings.DrawMode, cfg.Settings.Paytable, cfg.Settings.DicePayouts)
+	if err != nil {
+		logger.FatalGlobal().Err(err).Msg("Invalid paytable")
+	}
+	logger.InfoGlobal().Str("paytable", paytable.String()).Msg("✅ Paytable loaded")
+	tables, err := config.ParseTables(cfg.Settings.Tables)
+	if err != nil {
+		logger.FatalGlobal().Err(err).Msg("Invalid table list")
+	}
 
 	// 2. Initialize Database
 	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable TimeZone=Asia/Shanghai",
@@ -72,17 +84,35 @@ func main() {
 		logger.WarnGlobal().Err(err).Msg("Failed to create ColorGame client")
 	}
 
-	// 7. Initialize State Machine
-	stateMachine := colorgameGMSMachine.NewStateMachine()
-	go stateMachine.Start(context.Background())
-	logger.InfoGlobal().Msg("✅ State machine started")
+	// 7. Initialize one State Machine per table (round snapshots in Redis for crash recovery)
+	var stateMachines []*colorgameGMSMachine.StateMachine
+	for _, table := range tables {
+		stateMachine := colorgameGMSMachine.NewStateMachine()
+		stateMachine.TableID = table.ID
+		stateMachine.SetPhaseDurations(table.Betting, table.Drawing, table.Result, table.Wait, table.Rest)
+
+		resultProvider, err := colorgameGMSMachine.NewResultProvider(cfg.Settings.ResultProvider, cfg.Settings.ClientSeed, time.Duration(cfg.Settings.ManualResultTimeout)*time.Second, paytable)
+		if err != nil {
+			logger.FatalGlobal().Err(err).Msg("Failed to create result provider")
+		}
+		stateMachine.SetResultProvider(resultProvider)
+		stateMachine.SetSnapshotRepository(colorgameGMSRedis.NewRoundSnapshotRepository(rdb, table.ID))
+		stateMachines = append(stateMachines, stateMachine)
+		logger.InfoGlobal().Str("table_id", table.ID).Msg("✅ Table configured")
+	}
 
 	// 8. Initialize Repository
 	gameRoundRepo := colorgameGMSRepo.NewGameRoundRepository(db)
 
 	// 9. Initialize UseCase with Gateway broadcaster
 	// cgClient embeds BaseClient which implements GatewayService interface for broadcasting
-	gmsUC := colorgameGMSUseCase.NewGMSUseCase(stateMachine, cgClient, cgClient, gameRoundRepo)
+	gmsUC := colorgameGMSUseCase.NewGMSUseCase(stateMachines[0], cgClient, cgClient, gameRoundRepo)
+	for _, stateMachine := range stateMachines[1:] {
+		gmsUC.AddTable(stateMachine)
+	}
+	// Bet statistics are shared by every GMS replica (the leader persists them with the result)
+	gmsUC.SetRoundStatsRepository(colorgameGMSRedis.NewRoundStatsRepository(rdb))
+	gmsUC.SetPaytable(paytable)
 	logger.InfoGlobal().Msg("✅ GMS UseCase initialized")
 
 	// 9. Start gRPC Server
@@ -96,6 +126,19 @@ func main() {
 	gmsGrpcHandler := colorgameGMSGrpc.NewHandler(gmsUC)
 	// Register GMS service
 	pb.RegisterColorGameGMSServiceServer(grpcServer, gmsGrpcHandler)
+	ip := netutil.GetOutboundIP()
+
+	// The candidate id is the gRPC address, followers forward the controls of running rounds to the lease holder
+	elector := election.NewElector(rdb, "gms:color_game", fmt.Sprintf("%s:%d", ip, actualPort))
+	gmsAdminHandler := colorgameGMSGrpc.NewAdminHandler(gmsUC)
+	gmsAdminHandler.SetLeaderForwarding(elector, func(addr string) (pb.ColorGameGMSAdminServiceClient, error) {
+		conn, err := baseClient.GetInstanceConn(addr)
+		if err != nil {
+			return nil, err
+		}
+		return pb.NewColorGameGMSAdminServiceClient(conn), nil
+	})
+	pb.RegisterColorGameGMSAdminServiceServer(grpcServer, gmsAdminHandler)
 	pbAdmin.RegisterAdminServiceServer(grpcServer, admin.NewServer())
 
 	go func() {
@@ -104,8 +147,21 @@ func main() {
 		}
 	}()
 
-	// 10. Register to Nacos (Retry mechanism)
-	ip := netutil.GetOutboundIP()
+	// 10. Leader Election: only the leader runs rounds, followers serve GetCurrentRound from the shared snapshot.
+	// The tables start after the UseCase registered its handler, a recovered round emits events immediately.
+	electionCtx, stopElection := context.WithCancel(context.Background())
+	electionDone := make(chan struct{})
+	go func() {
+		defer close(electionDone)
+		elector.Run(electionCtx, func(leaderCtx context.Context, token int64) {
+			gmsUC.SetFencingToken(token)
+			logger.InfoGlobal().Int64("fencing_token", token).Msg("✅ State machines started (leader)")
+			gmsUC.Start(leaderCtx)
+		})
+	}()
+	logger.InfoGlobal().Msg("✅ Leader election started")
+
+	// 11. Register to Nacos (Retry mechanism)
 	serviceName := "gms-service"
 
 	var registered bool
@@ -124,7 +180,7 @@ func main() {
 		logger.ErrorGlobal().Msg("Failed to register to Nacos after retries")
 	}
 
-	// 11. Graceful Shutdown
+	// 12. Graceful Shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
@@ -150,13 +206,21 @@ func main() {
 		grpcServer.Stop()
 	}
 
-	// 3. Stop State Machine (Wait for current round to finish)
-	// Now safe to stop because no new requests are coming in
-	if err := stateMachine.GracefulShutdown(30 * time.Second); err != nil {
+	// 3. Leave the election, then stop State Machines (Wait for the current round of every table to finish)
+	// Resigning first keeps this instance from taking the lease again once its tables stopped,
+	// the current term ends with the last round. Now safe to stop because no new requests are coming in
+	elector.Resign()
+	if err := gmsUC.GracefulShutdown(30 * time.Second); err != nil {
 		logger.WarnGlobal().Err(err).Msg("⚠️ State Machine shutdown timed out (forced exit)")
 	} else {
 		logger.InfoGlobal().Msg("✅ State Machine stopped gracefully")
 	}
 
+	// 4. End what is left of the term (a timed out shutdown), the lease is released so a follower takes over
+	// without waiting for expiry
+	stopElection()
+	<-electionDone
+	logger.InfoGlobal().Msg("✅ Left leader election")
+
 	logger.InfoGlobal().Msg("✅ GMS shutdown complete")
 }
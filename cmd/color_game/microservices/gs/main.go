@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
@@ -8,14 +9,16 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
 	"github.com/frankieli/game_product/internal/config"
 	colorgameGSGrpc "github.com/frankieli/game_product/internal/modules/color_game/gs/adapter/grpc"
+	colorgameGSDomain "github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
 	colorgameGSRepo "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/db"
-	colorgameGSMemory "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/memory"
+	colorgameGSRedis "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/redis"
 	colorgameGSUseCase "github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
 	walletModule "github.com/frankieli/game_product/internal/modules/wallet"
 	"github.com/frankieli/game_product/pkg/admin"
@@ -24,6 +27,7 @@ import (
 	"github.com/frankieli/game_product/pkg/grpc_client/color_game"
 	"github.com/frankieli/game_product/pkg/logger"
 	"github.com/frankieli/game_product/pkg/netutil"
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
 	pbAdmin "github.com/frankieli/game_product/shared/proto/admin"
 	pb "github.com/frankieli/game_product/shared/proto/colorgame"
 )
@@ -37,6 +41,11 @@ func main() {
 
 	// 1. Load Config
 	cfg := config.LoadColorGameConfig()
+	paytable, err := colorgame.NewPaytable(cfg.Settings.DrawMode, cfg.Settings.Paytable, cfg.Settings.DicePayouts)
+	if err != nil {
+		logger.FatalGlobal().Err(err).Msg("Invalid paytable")
+	}
+	logger.InfoGlobal().Str("paytable", paytable.String()).Msg("✅ Paytable loaded")
 
 	// 2. Initialize Database
 	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable TimeZone=Asia/Shanghai",
@@ -47,6 +56,15 @@ func main() {
 	}
 	logger.InfoGlobal().Msg("✅ Database connected")
 
+	// 2.1 Initialize Redis (bets and bet limit totals are shared by every GS instance)
+	rdb := redis.NewClient(&redis.Options{
+		Addr: fmt.Sprintf("%s:%s", cfg.Redis.Host, cfg.Redis.Port),
+	})
+	if err := rdb.Ping(context.Background()).Err(); err != nil {
+		logger.FatalGlobal().Err(err).Msg("Failed to connect to Redis")
+	}
+	logger.InfoGlobal().Msg("✅ Redis connected")
+
 	// 3. Initialize Nacos for Service Discovery
 	nacosClient, err := discovery.NewNacosClient(cfg.Nacos.Host, cfg.Nacos.Port, cfg.Nacos.NamespaceID)
 	if err != nil {
@@ -63,8 +81,8 @@ func main() {
 	}
 
 	// 6. Initialize Repositories
-	// Use memory repository for bets (in-memory cache)
-	betRepo := colorgameGSMemory.NewBetRepository()
+	// Use Redis repository for bets (shared by every GS instance, a crashed GS leaves them for the one resuming its settlements)
+	betRepo := colorgameGSRedis.NewBetRepository(rdb)
 
 	// Use DB repository for bet orders (persistent)
 	betOrderRepo := colorgameGSRepo.NewBetOrderRepository(db)
@@ -83,8 +101,38 @@ func main() {
 		mockWalletSvc, // WalletService (Mock)
 		baseClient,    // GatewayService
 	)
+	gsUC.SetPaytable(paytable)
+	gsUC.SetBetLimits(colorgameGSDomain.BetLimits{
+		MinBet:      cfg.Settings.MinBet,
+		MaxBet:      cfg.Settings.MaxBet,
+		MaxRoundBet: cfg.Settings.MaxRoundBet,
+		MaxColorBet: cfg.Settings.MaxColorBet,
+		MaxExposure: cfg.Settings.MaxExposure,
+	})
+	gsUC.SetBetLimitRepository(colorgameGSRedis.NewBetLimitRepository(rdb))
+	gsUC.SetBetRequestRepository(colorgameGSRedis.NewBetRequestRepository(rdb))
+	gsUC.SetLastBetSlipRepository(colorgameGSRedis.NewLastBetSlipRepository(rdb))
+	gsUC.SetAutoBetRepository(colorgameGSRedis.NewAutoBetRepository(rdb))
+	gsUC.SetBetIntentRepository(colorgameGSRepo.NewBetIntentRepository(db))
+	gsUC.SetPayoutRepository(colorgameGSRepo.NewPayoutRepository(db))
+	gsUC.SetSettlementRepository(colorgameGSRepo.NewSettlementRepository(db))
 	logger.InfoGlobal().Msg("✅ GS UseCase initialized")
 
+	// 7.1 Retry wallet rollbacks of bets that could not be placed (PlaceBet saga) and failed payouts (payout outbox)
+	workerCtx, stopWorker := context.WithCancel(context.Background())
+	defer stopWorker()
+	go gsUC.StartCompensationWorker(workerCtx, 5*time.Second)
+	go gsUC.StartPayoutWorker(workerCtx, 5*time.Second)
+
+	// 7.2 Finish settlements left behind by a crashed GS (on startup, then whenever a lease expires)
+	go gsUC.StartSettlementWorker(workerCtx, 15*time.Second)
+
+	// 7.3 Flag bet orders left pending for too many rounds (stake deducted but never settled)
+	go gsUC.StartReconciliationWorker(workerCtx, time.Minute, cfg.Settings.PendingOrderRounds)
+
+	// 7.4 Place the slips of the players who opted in to auto bet, once per round
+	go gsUC.StartAutoBetWorker(workerCtx, time.Second)
+
 	// 8. Start gRPC Server (Random Port)
 	lis, actualPort, err := netutil.ListenWithFallback("0")
 	if err != nil {
@@ -96,6 +144,7 @@ func main() {
 	grpcServer := grpc.NewServer()
 	gsGrpcHandler := colorgameGSGrpc.NewHandler(gsUC)
 	pb.RegisterColorGameGSServiceServer(grpcServer, gsGrpcHandler)
+	pb.RegisterColorGameGSAdminServiceServer(grpcServer, colorgameGSGrpc.NewAdminHandler(gsUC))
 	pbAdmin.RegisterAdminServiceServer(grpcServer, admin.NewServer())
 
 	go func() {
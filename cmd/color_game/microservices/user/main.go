@@ -20,6 +20,8 @@ import (
 	"github.com/frankieli/game_product/internal/modules/user/usecase"
 	"github.com/frankieli/game_product/pkg/admin"
 	"github.com/frankieli/game_product/pkg/discovery"
+	grpcClient "github.com/frankieli/game_product/pkg/grpc_client/base"
+	colorGameClient "github.com/frankieli/game_product/pkg/grpc_client/color_game"
 	"github.com/frankieli/game_product/pkg/logger"
 	"github.com/frankieli/game_product/pkg/netutil"
 	pbAdmin "github.com/frankieli/game_product/shared/proto/admin"
@@ -106,6 +108,15 @@ func main() {
 
 	// 6. Start HTTP Server
 	userHttpHandler := userHttp.NewHandler(userUC)
+	if nacosClient != nil {
+		// Bet history is read from GS
+		cgClient, err := colorGameClient.NewClient(grpcClient.NewBaseClient(nacosClient))
+		if err != nil {
+			logger.WarnGlobal().Err(err).Msg("Failed to create ColorGame client, bet history disabled")
+		} else {
+			userHttpHandler.SetBetHistoryService(cgClient)
+		}
+	}
 	httpPort := cfg.Server.HTTPPort
 	httpServer := userHttp.NewServer(userHttpHandler, httpPort)
 
@@ -15,6 +15,7 @@ import (
 	colorgameGMSLocal "github.com/frankieli/game_product/internal/modules/color_game/gms/adapter/local"
 	colorgameGMSMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
 	colorgameGMSRepo "github.com/frankieli/game_product/internal/modules/color_game/gms/repository/db"
+	colorgameGMSRedis "github.com/frankieli/game_product/internal/modules/color_game/gms/repository/redis"
 	colorgameGMSUseCase "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
 	colorgameGSLocal "github.com/frankieli/game_product/internal/modules/color_game/gs/adapter/local"
 	colorgameGSDomain "github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
@@ -32,6 +33,7 @@ import (
 	userUseCase "github.com/frankieli/game_product/internal/modules/user/usecase"
 	walletModule "github.com/frankieli/game_product/internal/modules/wallet"
 	"github.com/frankieli/game_product/pkg/logger"
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
 	"github.com/gin-gonic/gin"
 	"github.com/redis/go-redis/v9"
 	"gorm.io/driver/postgres"
@@ -67,6 +69,14 @@ func main() {
 
 	// 1. Load Config
 	cfg := config.LoadMonolithConfig()
+	paytable, err := colorgame.NewPaytable(cfg.ColorGame.Settings.DrawMode, cfg.ColorGame.Settings.Paytable, cfg.ColorGame.Settings.DicePayouts)
+	if err != nil {
+		logger.FatalGlobal().Err(err).Msg("Invalid paytable")
+	}
+	tables, err := config.ParseTables(cfg.ColorGame.Settings.Tables)
+	if err != nil {
+		logger.FatalGlobal().Err(err).Msg("Invalid table list")
+	}
 
 	// 2. Initialize Infrastructure
 	dbConnStr := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
@@ -129,10 +139,25 @@ func main() {
 	// ColorGame Module (GMS + GS)
 	logger.InfoGlobal().Msg("🎲 Initializing Color Game...")
 
-	// 1. Initialize GMS (Game Machine Service) with broadcaster
-	stateMachine := colorgameGMSMachine.NewStateMachine()
-	go stateMachine.Start(context.Background())
-	logger.InfoGlobal().Msg("  ✅ State machine started")
+	// 1. Initialize GMS (Game Machine Service): one state machine per table
+	var stateMachines []*colorgameGMSMachine.StateMachine
+	for _, table := range tables {
+		stateMachine := colorgameGMSMachine.NewStateMachine()
+		stateMachine.TableID = table.ID
+		stateMachine.SetPhaseDurations(table.Betting, table.Drawing, table.Result, table.Wait, table.Rest)
+
+		resultProvider, err := colorgameGMSMachine.NewResultProvider(cfg.ColorGame.Settings.ResultProvider, cfg.ColorGame.Settings.ClientSeed, time.Duration(cfg.ColorGame.Settings.ManualResultTimeout)*time.Second, paytable)
+		if err != nil {
+			logger.FatalGlobal().Err(err).Msg("Failed to create result provider")
+		}
+		stateMachine.SetResultProvider(resultProvider)
+		if cfg.ColorGame.RepoType == "redis" {
+			// Persist round snapshots so that a restarted GMS can finish the interrupted round
+			stateMachine.SetSnapshotRepository(colorgameGMSRedis.NewRoundSnapshotRepository(rdb, table.ID))
+		}
+		stateMachines = append(stateMachines, stateMachine)
+		logger.InfoGlobal().Str("table_id", table.ID).Msg("  ✅ GMS table configured")
+	}
 
 	gameRoundRepo := colorgameGMSRepo.NewGameRoundRepository(db)
 
@@ -141,7 +166,14 @@ func main() {
 	gatewayHandler := gatewayAdapter.NewHandler(wsManager)
 
 	// Initialize GMS with multiple broadcasters (Gateway initially, GS later)
-	gmsUC := colorgameGMSUseCase.NewGMSUseCase(stateMachine, gatewayHandler, nil, gameRoundRepo)
+	gmsUC := colorgameGMSUseCase.NewGMSUseCase(stateMachines[0], gatewayHandler, nil, gameRoundRepo)
+	for _, stateMachine := range stateMachines[1:] {
+		gmsUC.AddTable(stateMachine)
+	}
+	if cfg.ColorGame.RepoType == "redis" {
+		gmsUC.SetRoundStatsRepository(colorgameGMSRedis.NewRoundStatsRepository(rdb))
+	}
+	gmsUC.SetPaytable(paytable)
 	gmsHandler := colorgameGMSLocal.NewHandler(gmsUC)
 	logger.InfoGlobal().Msg("  ✅ GMS initialized")
 
@@ -161,15 +193,42 @@ func main() {
 
 	// gmsHandler implements service.GMSService directly now
 	gsUseCase := colorgameGSUseCase.NewGSUseCase(betRepo, betOrderRepo, gmsHandler, walletSvc, gatewayHandler)
+	gsUseCase.SetPaytable(paytable)
+	gsUseCase.SetBetLimits(colorgameGSDomain.BetLimits{
+		MinBet:      cfg.ColorGame.Settings.MinBet,
+		MaxBet:      cfg.ColorGame.Settings.MaxBet,
+		MaxRoundBet: cfg.ColorGame.Settings.MaxRoundBet,
+		MaxColorBet: cfg.ColorGame.Settings.MaxColorBet,
+		MaxExposure: cfg.ColorGame.Settings.MaxExposure,
+	})
+	if cfg.ColorGame.RepoType == "redis" {
+		gsUseCase.SetBetLimitRepository(colorgameGSRedis.NewBetLimitRepository(rdb))
+		gsUseCase.SetBetRequestRepository(colorgameGSRedis.NewBetRequestRepository(rdb))
+		gsUseCase.SetLastBetSlipRepository(colorgameGSRedis.NewLastBetSlipRepository(rdb))
+		gsUseCase.SetAutoBetRepository(colorgameGSRedis.NewAutoBetRepository(rdb))
+	}
+	gsUseCase.SetBetIntentRepository(colorgameGSDB.NewBetIntentRepository(db))
+	go gsUseCase.StartCompensationWorker(context.Background(), 5*time.Second)
+	gsUseCase.SetPayoutRepository(colorgameGSDB.NewPayoutRepository(db))
+	go gsUseCase.StartPayoutWorker(context.Background(), 5*time.Second)
+	gsUseCase.SetSettlementRepository(colorgameGSDB.NewSettlementRepository(db))
+	go gsUseCase.StartSettlementWorker(context.Background(), 15*time.Second)
+	go gsUseCase.StartReconciliationWorker(context.Background(), time.Minute, cfg.ColorGame.Settings.PendingOrderRounds)
+	go gsUseCase.StartAutoBetWorker(context.Background(), time.Second)
 	gsHandler := colorgameGSLocal.NewHandler(gsUseCase)
+	userHttpHandler.SetBetHistoryService(gsHandler)
 
 	// Set GS Handler as broadcaster (it implements both ColorGameService and GSBroadcaster)
 	gmsUC.SetGSService(gsHandler)
 
 	logger.InfoGlobal().Msg("  ✅ GS initialized")
 
-	// 3. Initialize Gateway UseCase
-	gatewayUC := gatewayUseCase.NewGatewayUseCase(gsHandler)
+	// Start the tables once every event handler is wired (recovered rounds emit immediately)
+	go gmsUC.Start(context.Background())
+	logger.InfoGlobal().Int("tables", len(stateMachines)).Msg("  ✅ State machines started")
+
+	// 3. Initialize Gateway UseCase (the WebSocket manager tracks which table each player joined)
+	gatewayUC := gatewayUseCase.NewGatewayUseCase(gsHandler, wsManager)
 	logger.InfoGlobal().Msg("✅ Color Game ready")
 
 	// Initialize HTTP Handler
@@ -248,9 +307,9 @@ func main() {
 		logger.ErrorGlobal().Err(err).Msg("User HTTP server forced to shutdown")
 	}
 
-	// 6.2 Stop State Machine (wait for current round to finish)
+	// 6.2 Stop State Machines (wait for the current round of every table to finish)
 	logger.InfoGlobal().Msg("⏳ Waiting for current round to finish...")
-	if err := stateMachine.GracefulShutdown(30 * time.Second); err != nil {
+	if err := gmsUC.GracefulShutdown(30 * time.Second); err != nil {
 		logger.WarnGlobal().Err(err).Msg("⚠️ State Machine shutdown timed out (forced exit)")
 	} else {
 		logger.InfoGlobal().Msg("✅ State Machine stopped gracefully")
@@ -0,0 +1,59 @@
+// Command rtp prints the theoretical return to player and volatility of a color game paytable.
+//
+//	go run ./cmd/color_game/rtp                                   # paytable from COLORGAME_PAYTABLE (or the default)
+//	go run ./cmd/color_game/rtp -paytable "RED:3:2,GREEN:3:2,BLUE:3:2,YELLOW:1:5.5"
+//	go run ./cmd/color_game/rtp -mode three_dice -dice-payouts 2,3,4      # six colors, three dice
+//
+// The exit code is 1 when the paytable is invalid, so the command can gate a config change.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/frankieli/game_product/internal/config"
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
+)
+
+func main() {
+	settings := config.LoadColorGameConfig().Settings
+	mode := flag.String("mode", settings.DrawMode, "Draw mode: single | three_dice (default: COLORGAME_DRAW_MODE)")
+	spec := flag.String("paytable", settings.Paytable, "Paytable as color:weight[:payout] list (default: COLORGAME_PAYTABLE)")
+	dicePayouts := flag.String("dice-payouts", settings.DicePayouts, "three_dice: total return for 1, 2 and 3 matching dice (default: COLORGAME_DICE_PAYOUTS)")
+	flag.Parse()
+
+	paytable, err := colorgame.NewPaytable(*mode, *spec, *dicePayouts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Invalid paytable: %v\n", err)
+		os.Exit(1)
+	}
+
+	report := paytable.RTP()
+	fmt.Printf("Paytable: %s\n", paytable.String())
+	fmt.Printf("Total weight: %d\n", paytable.TotalWeight())
+	if paytable.IsDice() {
+		fmt.Printf("Dice: %d, payout for 1..%d matching dice: %v\n", paytable.Dice, paytable.Dice, paytable.MatchPayouts)
+	}
+	fmt.Println()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "Color\tWeight\tWin probability\tPayout\tRTP\tHouse edge\tVolatility\t")
+	for i, color := range report.Colors {
+		payout := fmt.Sprintf("%.2fx", color.Payout)
+		if paytable.IsDice() {
+			payout = "per match"
+		}
+		fmt.Fprintf(w, "%s\t%d\t%.4f%%\t%s\t%.4f%%\t%.4f%%\t%.4f\t\n",
+			color.Color, paytable.Entries[i].Weight, color.Probability*100, payout,
+			color.RTP*100, color.HouseEdge*100, color.Volatility)
+	}
+	w.Flush()
+
+	fmt.Println()
+	fmt.Printf("RTP (stake spread over every color): %.4f%%\n", report.RTP*100)
+	fmt.Printf("House edge:                          %.4f%%\n", report.HouseEdge*100)
+	fmt.Printf("Volatility (per unit staked):        %.4f\n", report.Volatility)
+	fmt.Printf("RTP range per color:                 %.4f%% - %.4f%%\n", report.MinRTP*100, report.MaxRTP*100)
+}
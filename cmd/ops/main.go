@@ -22,6 +22,7 @@ import (
 	"github.com/frankieli/game_product/pkg/grpc_client/base"
 	"github.com/frankieli/game_product/pkg/grpc_client/color_game"
 	"github.com/frankieli/game_product/pkg/logger"
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
 	"github.com/gin-gonic/gin"
 
 	// Proto definitions for unmarshalling
@@ -751,6 +752,194 @@ func initRegistry() {
 		return p.CGClient.GetCurrentRound(ctx, &req)
 	}
 
+	methodRegistry["VerifyRound"] = func(ctx context.Context, p *ProjectContext, payload []byte) (interface{}, error) {
+		if p.CGClient == nil {
+			return nil, fmt.Errorf("ColorGame client not available in project %s", p.Config.Name)
+		}
+		var req pbColorGame.ColorGameVerifyRoundReq
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return p.CGClient.VerifyRound(ctx, &req)
+	}
+
+	methodRegistry["GetRecentEvents"] = func(ctx context.Context, p *ProjectContext, payload []byte) (interface{}, error) {
+		if p.CGClient == nil {
+			return nil, fmt.Errorf("ColorGame client not available in project %s", p.Config.Name)
+		}
+		var req pbColorGame.ColorGameGetRecentEventsReq
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return p.CGClient.GetRecentEvents(ctx, &req)
+	}
+
+	methodRegistry["GetRoundHistory"] = func(ctx context.Context, p *ProjectContext, payload []byte) (interface{}, error) {
+		if p.CGClient == nil {
+			return nil, fmt.Errorf("ColorGame client not available in project %s", p.Config.Name)
+		}
+		var req pbColorGame.ColorGameGetRoundHistoryReq
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return p.CGClient.GetRoundHistory(ctx, &req)
+	}
+
+	methodRegistry["SubmitResult"] = func(ctx context.Context, p *ProjectContext, payload []byte) (interface{}, error) {
+		if p.CGClient == nil {
+			return nil, fmt.Errorf("ColorGame client not available in project %s", p.Config.Name)
+		}
+		var req pbColorGame.ColorGameSubmitResultReq
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return p.CGClient.SubmitResult(ctx, &req)
+	}
+
+	methodRegistry["PauseTable"] = func(ctx context.Context, p *ProjectContext, payload []byte) (interface{}, error) {
+		if p.CGClient == nil {
+			return nil, fmt.Errorf("ColorGame client not available in project %s", p.Config.Name)
+		}
+		var req pbColorGame.ColorGamePauseTableReq
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return p.CGClient.PauseTable(ctx, &req)
+	}
+
+	methodRegistry["ResumeTable"] = func(ctx context.Context, p *ProjectContext, payload []byte) (interface{}, error) {
+		if p.CGClient == nil {
+			return nil, fmt.Errorf("ColorGame client not available in project %s", p.Config.Name)
+		}
+		var req pbColorGame.ColorGameResumeTableReq
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return p.CGClient.ResumeTable(ctx, &req)
+	}
+
+	methodRegistry["AdjustBetting"] = func(ctx context.Context, p *ProjectContext, payload []byte) (interface{}, error) {
+		if p.CGClient == nil {
+			return nil, fmt.Errorf("ColorGame client not available in project %s", p.Config.Name)
+		}
+		var req pbColorGame.ColorGameAdjustBettingReq
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return p.CGClient.AdjustBetting(ctx, &req)
+	}
+
+	methodRegistry["VoidCurrentRound"] = func(ctx context.Context, p *ProjectContext, payload []byte) (interface{}, error) {
+		if p.CGClient == nil {
+			return nil, fmt.Errorf("ColorGame client not available in project %s", p.Config.Name)
+		}
+		var req pbColorGame.ColorGameVoidCurrentRoundReq
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return p.CGClient.VoidCurrentRound(ctx, &req)
+	}
+
+	methodRegistry["GetRound"] = func(ctx context.Context, p *ProjectContext, payload []byte) (interface{}, error) {
+		if p.CGClient == nil {
+			return nil, fmt.Errorf("ColorGame client not available in project %s", p.Config.Name)
+		}
+		var req pbColorGame.ColorGameGetRoundReq
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return p.CGClient.GetRound(ctx, &req)
+	}
+
+	methodRegistry["ListRounds"] = func(ctx context.Context, p *ProjectContext, payload []byte) (interface{}, error) {
+		if p.CGClient == nil {
+			return nil, fmt.Errorf("ColorGame client not available in project %s", p.Config.Name)
+		}
+		var req pbColorGame.ColorGameListRoundsReq
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return p.CGClient.ListRounds(ctx, &req)
+	}
+
+	methodRegistry["ListCompensations"] = func(ctx context.Context, p *ProjectContext, payload []byte) (interface{}, error) {
+		if p.CGClient == nil {
+			return nil, fmt.Errorf("ColorGame client not available in project %s", p.Config.Name)
+		}
+		var req pbColorGame.ColorGameListCompensationsReq
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return p.CGClient.ListCompensations(ctx, &req)
+	}
+
+	methodRegistry["RetryCompensation"] = func(ctx context.Context, p *ProjectContext, payload []byte) (interface{}, error) {
+		if p.CGClient == nil {
+			return nil, fmt.Errorf("ColorGame client not available in project %s", p.Config.Name)
+		}
+		var req pbColorGame.ColorGameRetryCompensationReq
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return p.CGClient.RetryCompensation(ctx, &req)
+	}
+
+	methodRegistry["GetSettlement"] = func(ctx context.Context, p *ProjectContext, payload []byte) (interface{}, error) {
+		if p.CGClient == nil {
+			return nil, fmt.Errorf("ColorGame client not available in project %s", p.Config.Name)
+		}
+		var req pbColorGame.ColorGameGetSettlementReq
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return p.CGClient.GetSettlement(ctx, &req)
+	}
+
+	methodRegistry["ListSettlements"] = func(ctx context.Context, p *ProjectContext, payload []byte) (interface{}, error) {
+		if p.CGClient == nil {
+			return nil, fmt.Errorf("ColorGame client not available in project %s", p.Config.Name)
+		}
+		var req pbColorGame.ColorGameListSettlementsReq
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return p.CGClient.ListSettlements(ctx, &req)
+	}
+
+	methodRegistry["GetBetHistory"] = func(ctx context.Context, p *ProjectContext, payload []byte) (interface{}, error) {
+		if p.CGClient == nil {
+			return nil, fmt.Errorf("ColorGame client not available in project %s", p.Config.Name)
+		}
+		var req pbColorGame.ColorGameGetBetHistoryReq
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return p.CGClient.GetBetHistory(ctx, &req)
+	}
+
+	// SearchBetHistory searches every player: by round_id, game_code and from/to (Unix seconds), user_id optional
+	methodRegistry["SearchBetHistory"] = func(ctx context.Context, p *ProjectContext, payload []byte) (interface{}, error) {
+		if p.CGClient == nil {
+			return nil, fmt.Errorf("ColorGame client not available in project %s", p.Config.Name)
+		}
+		var req pbColorGame.ColorGameGetBetHistoryReq
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return p.CGClient.SearchBetHistory(ctx, &req)
+	}
+
+	// ParseRoundID is answered locally: it extracts game code, table, start time and sequence from a round ID
+	methodRegistry["ParseRoundID"] = func(ctx context.Context, p *ProjectContext, payload []byte) (interface{}, error) {
+		var req struct {
+			RoundID string `json:"round_id"`
+		}
+		if err := json.Unmarshal(payload, &req); err != nil {
+			return nil, err
+		}
+		return colorgame.ParseRoundID(req.RoundID)
+	}
+
 	methodRegistry["GetState"] = func(ctx context.Context, p *ProjectContext, payload []byte) (interface{}, error) {
 		if p.CGClient == nil {
 			return nil, fmt.Errorf("ColorGame client not available in project %s", p.Config.Name)
@@ -1,5 +1,14 @@
 package config
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
+)
+
 // ColorGameConfig (formerly GameConfig)
 type ColorGameConfig struct {
 	Server   ServerConfig
@@ -11,7 +20,30 @@ type ColorGameConfig struct {
 }
 
 type GameSettings struct {
-	MaxPlayersPerRoom int
+	MaxPlayersPerRoom   int
+	ResultProvider      string // rng | fair (provably fair commit–reveal) | manual (live dealer)
+	ClientSeed          string // Public seed mixed into the provably fair draw
+	ManualResultTimeout int    // Seconds to wait for a live dealer result before the round is voided
+	DrawMode            string // single (one color per round) | three_dice (three colored dice, paid per matching die)
+	Paytable            string // color:weight[:payout] list shared by GMS (draw) and GS (settlement), empty = default of the draw mode
+	DicePayouts         string // three_dice: total return for 1, 2 and 3 matching dice, e.g. "2,3,4"
+	MinBet              int64  // Minimum stake of one bet
+	MaxBet              int64  // Maximum stake of one bet, 0 = unlimited
+	MaxRoundBet         int64  // Maximum a player stakes in a round, 0 = unlimited
+	MaxColorBet         int64  // Maximum a player stakes on one color in a round, 0 = unlimited
+	MaxExposure         int64  // Maximum potential payout of one color in a round (house liability), 0 = unlimited
+	PendingOrderRounds  int    // Pending bet orders this many rounds behind the current round are flagged by reconciliation
+	Tables              string // Tables hosted by GMS, parsed by ParseTables, e.g. "default,vip:20:3:5:2:3"
+}
+
+// TableSettings configures one table hosted by GMS, zero durations keep the state machine defaults
+type TableSettings struct {
+	ID      string
+	Betting time.Duration
+	Drawing time.Duration
+	Result  time.Duration
+	Wait    time.Duration
+	Rest    time.Duration
 }
 
 // LoadColorGameConfig loads configuration for ColorGame Service
@@ -46,7 +78,67 @@ func LoadColorGameConfig() *ColorGameConfig {
 		Nacos:    nacosConfig,
 		RepoType: getEnv("COLORGAME_REPO_TYPE", "memory"),
 		Settings: GameSettings{
-			MaxPlayersPerRoom: getEnvInt("GAME_MAX_PLAYERS", 100),
+			MaxPlayersPerRoom:   getEnvInt("GAME_MAX_PLAYERS", 100),
+			ResultProvider:      getEnv("COLORGAME_RESULT_PROVIDER", "fair"),
+			ClientSeed:          getEnv("COLORGAME_CLIENT_SEED", "color_game"),
+			ManualResultTimeout: getEnvInt("COLORGAME_MANUAL_RESULT_TIMEOUT", 30),
+			DrawMode:            getEnv("COLORGAME_DRAW_MODE", colorgame.DrawModeSingle),
+			Paytable:            getEnv("COLORGAME_PAYTABLE", ""),
+			DicePayouts:         getEnv("COLORGAME_DICE_PAYOUTS", colorgame.DefaultDicePayoutsSpec),
+			MinBet:              int64(getEnvInt("COLORGAME_MIN_BET", 1)),
+			MaxBet:              int64(getEnvInt("COLORGAME_MAX_BET", 0)),
+			MaxRoundBet:         int64(getEnvInt("COLORGAME_MAX_ROUND_BET", 0)),
+			MaxColorBet:         int64(getEnvInt("COLORGAME_MAX_COLOR_BET", 0)),
+			MaxExposure:         int64(getEnvInt("COLORGAME_MAX_EXPOSURE", 0)),
+			PendingOrderRounds:  getEnvInt("COLORGAME_PENDING_ORDER_ROUNDS", 3),
+			Tables:              getEnv("COLORGAME_TABLES", colorgame.DefaultTableID),
 		},
 	}
 }
+
+// ParseTables parses a comma separated table list, each entry is
+// id[:betting[:drawing[:result[:wait[:rest]]]]] with durations in seconds, e.g. "default,vip:20:3:5:2:3".
+// An empty list hosts the default table. An invalid or repeated table ID, or a duration that is not a positive
+// number of seconds, is an error: GMS must not start without a table it was configured with.
+func ParseTables(spec string) ([]TableSettings, error) {
+	if strings.TrimSpace(spec) == "" {
+		return []TableSettings{{ID: colorgame.DefaultTableID}}, nil
+	}
+
+	var tables []TableSettings
+	seen := make(map[string]bool)
+	for _, entry := range strings.Split(spec, ",") {
+		fields := strings.Split(strings.TrimSpace(entry), ":")
+		id := fields[0]
+		if !colorgame.ValidTableID(id) {
+			// Table IDs are embedded in round IDs: letters, digits, '_' and '-' only, at most 24 characters
+			return nil, fmt.Errorf("invalid table ID %q in %q", id, entry)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("duplicate table ID %q", id)
+		}
+		seen[id] = true
+
+		seconds := make([]time.Duration, 5)
+		if len(fields)-1 > len(seconds) {
+			return nil, fmt.Errorf("table %s: at most %d durations, got %d", id, len(seconds), len(fields)-1)
+		}
+		for i, field := range fields[1:] {
+			n, err := strconv.Atoi(field)
+			if err != nil || n <= 0 {
+				return nil, fmt.Errorf("table %s: invalid duration %q, expected a positive number of seconds", id, field)
+			}
+			seconds[i] = time.Duration(n) * time.Second
+		}
+
+		tables = append(tables, TableSettings{
+			ID:      id,
+			Betting: seconds[0],
+			Drawing: seconds[1],
+			Result:  seconds[2],
+			Wait:    seconds[3],
+			Rest:    seconds[4],
+		})
+	}
+	return tables, nil
+}
@@ -0,0 +1,289 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	"github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	"github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+	"github.com/frankieli/game_product/pkg/logger"
+	pb "github.com/frankieli/game_product/shared/proto/colorgame"
+	pbCommon "github.com/frankieli/game_product/shared/proto/common"
+)
+
+// forwardedKey marks an operator control a follower forwarded to the leader, it is never forwarded again
+const forwardedKey = "x-gms-forwarded"
+
+// LeaderLocator finds the GMS instance running the rounds (the holder of the election lease)
+type LeaderLocator interface {
+	IsLeader() bool
+	Leader(ctx context.Context) (string, error)
+}
+
+// AdminHandler implements the gRPC server for GMS operator controls
+type AdminHandler struct {
+	pb.UnimplementedColorGameGMSAdminServiceServer
+	gmsUC *usecase.GMSUseCase
+
+	// Leader forwarding: only the leader runs rounds, followers pass the round controls on to it
+	locator    LeaderLocator
+	dialLeader func(addr string) (pb.ColorGameGMSAdminServiceClient, error)
+}
+
+// NewAdminHandler creates a new gRPC admin handler
+func NewAdminHandler(gmsUC *usecase.GMSUseCase) *AdminHandler {
+	return &AdminHandler{
+		gmsUC: gmsUC,
+	}
+}
+
+// SetLeaderForwarding makes a follower forward the controls of running rounds to the leader.
+// dial returns the admin client of the leader address found by locator.
+func (h *AdminHandler) SetLeaderForwarding(locator LeaderLocator, dial func(addr string) (pb.ColorGameGMSAdminServiceClient, error)) {
+	h.locator = locator
+	h.dialLeader = dial
+}
+
+// leader returns the admin client of the leader and the context to forward with when this instance is a follower.
+// ok is false when the control is served here: this instance leads, forwarding is not configured, the control was
+// already forwarded once or no leader is elected (the state machine then reports TABLE_NOT_RUNNING).
+func (h *AdminHandler) leader(ctx context.Context) (client pb.ColorGameGMSAdminServiceClient, forwardCtx context.Context, ok bool) {
+	if h.locator == nil || h.locator.IsLeader() {
+		return nil, nil, false
+	}
+	if md, found := metadata.FromIncomingContext(ctx); found && len(md.Get(forwardedKey)) > 0 {
+		return nil, nil, false
+	}
+
+	addr, err := h.locator.Leader(ctx)
+	if err != nil || addr == "" {
+		logger.Warn(ctx).Err(err).Msg("No GMS leader to forward the operator control to")
+		return nil, nil, false
+	}
+	client, err = h.dialLeader(addr)
+	if err != nil {
+		logger.Warn(ctx).Err(err).Str("leader", addr).Msg("Failed to connect to the GMS leader")
+		return nil, nil, false
+	}
+
+	logger.Info(ctx).Str("leader", addr).Msg("Forwarding operator control to the GMS leader")
+	return client, metadata.AppendToOutgoingContext(ctx, forwardedKey, "1"), true
+}
+
+// SubmitResult implements the SubmitResult RPC
+func (h *AdminHandler) SubmitResult(ctx context.Context, req *pb.ColorGameSubmitResultReq) (*pb.ColorGameSubmitResultRsp, error) {
+	logger.Info(ctx).
+		Str("table_id", req.TableId).
+		Str("round_id", req.RoundId).
+		Str("result", req.Result.String()).
+		Str("operator", req.Operator).
+		Msg("SubmitResult RPC called")
+
+	// The pending draw only exists on the leader
+	if leader, forwardCtx, ok := h.leader(ctx); ok {
+		return leader.SubmitResult(forwardCtx, req)
+	}
+
+	// Single draw tables may submit only result
+	dice := req.Dice
+	if len(dice) == 0 {
+		dice = []pb.ColorGameReward{req.Result}
+	}
+
+	if err := h.gmsUC.SubmitResult(ctx, req.TableId, req.RoundId, dice, req.Operator); err != nil {
+		return &pb.ColorGameSubmitResultRsp{
+			ErrorCode: adminErrorCode(err),
+			Error:     err.Error(),
+		}, nil
+	}
+
+	return &pb.ColorGameSubmitResultRsp{
+		ErrorCode: pbCommon.ErrorCode_SUCCESS,
+	}, nil
+}
+
+// PauseTable implements the PauseTable RPC
+func (h *AdminHandler) PauseTable(ctx context.Context, req *pb.ColorGamePauseTableReq) (*pb.ColorGamePauseTableRsp, error) {
+	logger.Info(ctx).
+		Str("table_id", req.TableId).
+		Str("operator", req.Operator).
+		Msg("PauseTable RPC called")
+
+	if leader, forwardCtx, ok := h.leader(ctx); ok {
+		return leader.PauseTable(forwardCtx, req)
+	}
+
+	if err := h.gmsUC.PauseTable(ctx, req.TableId, req.Operator); err != nil {
+		return &pb.ColorGamePauseTableRsp{
+			ErrorCode: adminErrorCode(err),
+			Error:     err.Error(),
+		}, nil
+	}
+
+	return &pb.ColorGamePauseTableRsp{
+		ErrorCode: pbCommon.ErrorCode_SUCCESS,
+	}, nil
+}
+
+// ResumeTable implements the ResumeTable RPC
+func (h *AdminHandler) ResumeTable(ctx context.Context, req *pb.ColorGameResumeTableReq) (*pb.ColorGameResumeTableRsp, error) {
+	logger.Info(ctx).
+		Str("table_id", req.TableId).
+		Str("operator", req.Operator).
+		Msg("ResumeTable RPC called")
+
+	if leader, forwardCtx, ok := h.leader(ctx); ok {
+		return leader.ResumeTable(forwardCtx, req)
+	}
+
+	if err := h.gmsUC.ResumeTable(ctx, req.TableId, req.Operator); err != nil {
+		return &pb.ColorGameResumeTableRsp{
+			ErrorCode: adminErrorCode(err),
+			Error:     err.Error(),
+		}, nil
+	}
+
+	return &pb.ColorGameResumeTableRsp{
+		ErrorCode: pbCommon.ErrorCode_SUCCESS,
+	}, nil
+}
+
+// AdjustBetting implements the AdjustBetting RPC
+func (h *AdminHandler) AdjustBetting(ctx context.Context, req *pb.ColorGameAdjustBettingReq) (*pb.ColorGameAdjustBettingRsp, error) {
+	logger.Info(ctx).
+		Str("table_id", req.TableId).
+		Str("round_id", req.RoundId).
+		Int64("delta_seconds", req.DeltaSeconds).
+		Str("operator", req.Operator).
+		Msg("AdjustBetting RPC called")
+
+	if leader, forwardCtx, ok := h.leader(ctx); ok {
+		return leader.AdjustBetting(forwardCtx, req)
+	}
+
+	delta := time.Duration(req.DeltaSeconds) * time.Second
+	bettingEnd, err := h.gmsUC.AdjustBetting(ctx, req.TableId, req.RoundId, delta, req.Operator)
+	if err != nil {
+		return &pb.ColorGameAdjustBettingRsp{
+			ErrorCode: adminErrorCode(err),
+			Error:     err.Error(),
+		}, nil
+	}
+
+	return &pb.ColorGameAdjustBettingRsp{
+		ErrorCode:           pbCommon.ErrorCode_SUCCESS,
+		BettingEndTimestamp: bettingEnd.Unix(),
+	}, nil
+}
+
+// VoidCurrentRound implements the VoidCurrentRound RPC
+func (h *AdminHandler) VoidCurrentRound(ctx context.Context, req *pb.ColorGameVoidCurrentRoundReq) (*pb.ColorGameVoidCurrentRoundRsp, error) {
+	logger.Info(ctx).
+		Str("table_id", req.TableId).
+		Str("round_id", req.RoundId).
+		Str("reason", req.Reason).
+		Str("operator", req.Operator).
+		Msg("VoidCurrentRound RPC called")
+
+	if leader, forwardCtx, ok := h.leader(ctx); ok {
+		return leader.VoidCurrentRound(forwardCtx, req)
+	}
+
+	if err := h.gmsUC.VoidCurrentRound(ctx, req.TableId, req.RoundId, req.Reason, req.Operator); err != nil {
+		return &pb.ColorGameVoidCurrentRoundRsp{
+			ErrorCode: adminErrorCode(err),
+			Error:     err.Error(),
+		}, nil
+	}
+
+	return &pb.ColorGameVoidCurrentRoundRsp{
+		ErrorCode: pbCommon.ErrorCode_SUCCESS,
+	}, nil
+}
+
+// GetRound implements the GetRound RPC
+func (h *AdminHandler) GetRound(ctx context.Context, req *pb.ColorGameGetRoundReq) (*pb.ColorGameGetRoundRsp, error) {
+	round, err := h.gmsUC.GetRound(ctx, req.RoundId)
+	if err != nil {
+		code := pbCommon.ErrorCode_INTERNAL_ERROR
+		if errors.Is(err, domain.ErrRoundNotFound) {
+			code = pbCommon.ErrorCode_NOT_FOUND
+		}
+		return &pb.ColorGameGetRoundRsp{
+			ErrorCode: code,
+			Error:     err.Error(),
+		}, nil
+	}
+
+	return &pb.ColorGameGetRoundRsp{
+		ErrorCode: pbCommon.ErrorCode_SUCCESS,
+		Round:     toRoundRecord(round),
+	}, nil
+}
+
+// ListRounds implements the ListRounds RPC
+func (h *AdminHandler) ListRounds(ctx context.Context, req *pb.ColorGameListRoundsReq) (*pb.ColorGameListRoundsRsp, error) {
+	var from, to time.Time
+	if req.From > 0 {
+		from = time.Unix(req.From, 0)
+	}
+	if req.To > 0 {
+		to = time.Unix(req.To, 0)
+	}
+
+	rounds, err := h.gmsUC.ListRounds(ctx, req.TableId, from, to, int(req.Limit))
+	if err != nil {
+		return &pb.ColorGameListRoundsRsp{
+			ErrorCode: pbCommon.ErrorCode_INTERNAL_ERROR,
+			Error:     err.Error(),
+		}, nil
+	}
+
+	rsp := &pb.ColorGameListRoundsRsp{
+		ErrorCode: pbCommon.ErrorCode_SUCCESS,
+		Rounds:    make([]*pb.ColorGameRoundRecord, 0, len(rounds)),
+	}
+	for _, round := range rounds {
+		rsp.Rounds = append(rsp.Rounds, toRoundRecord(round))
+	}
+	return rsp, nil
+}
+
+// toRoundRecord converts a persisted round, the dice are left empty when the result cannot be read
+func toRoundRecord(round *domain.GameRound) *pb.ColorGameRoundRecord {
+	record := &pb.ColorGameRoundRecord{
+		RoundId:        round.RoundID,
+		TableId:        round.TableID,
+		Status:         round.Status.String(),
+		StartTime:      round.StartTime.Unix(),
+		TotalBets:      int32(round.TotalBets),
+		TotalPlayers:   int32(round.TotalPlayers),
+		TotalBetAmount: int64(round.TotalBetAmount),
+		ServerSeedHash: round.ServerSeedHash,
+		ServerSeed:     round.ServerSeed,
+		ClientSeed:     round.ClientSeed,
+	}
+	if round.EndTime != nil {
+		record.EndTime = round.EndTime.Unix()
+	}
+	if round.Status == domain.RoundStatusEnded && round.Result != "" {
+		record.Dice, _ = domain.ParseResult(round.Result)
+	}
+	return record
+}
+
+// adminErrorCode maps operator control errors: unknown tables are NOT_FOUND, tables without a running state machine
+// are TABLE_NOT_RUNNING, everything else concerns the round
+func adminErrorCode(err error) pbCommon.ErrorCode {
+	switch {
+	case errors.Is(err, domain.ErrTableNotFound):
+		return pbCommon.ErrorCode_NOT_FOUND
+	case errors.Is(err, machine.ErrNotRunning):
+		return pbCommon.ErrorCode_TABLE_NOT_RUNNING
+	}
+	return pbCommon.ErrorCode_ROUND_NOT_ACTIVE
+}
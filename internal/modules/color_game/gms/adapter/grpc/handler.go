@@ -3,8 +3,10 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	"github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	"github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
 	"github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
 	"github.com/frankieli/game_product/pkg/logger"
 	pb "github.com/frankieli/game_product/shared/proto/colorgame"
@@ -27,17 +29,18 @@ func NewHandler(gmsUC *usecase.GMSUseCase) *Handler {
 // RecordBet implements the RecordBet RPC
 func (h *Handler) RecordBet(ctx context.Context, req *pb.ColorGameRecordBetReq) (*pb.ColorGameRecordBetRsp, error) {
 	logger.Debug(ctx).
+		Str("table_id", req.TableId).
 		Str("round_id", req.RoundId).
 		Int64("user_id", req.UserId).
 		Int32("color", int32(req.Color)).
 		Int64("amount", req.Amount).
 		Msg("RecordBet RPC called")
 
-	err := h.gmsUC.RecordBet(ctx, req.RoundId, req.UserId, domain.Color(req.Color), req.Amount)
+	err := h.gmsUC.RecordBet(ctx, req.TableId, req.RoundId, req.UserId, domain.Color(req.Color), req.Amount)
 	if err != nil {
 		logger.Error(ctx).Err(err).Msg("Failed to record bet")
 		return &pb.ColorGameRecordBetRsp{
-			ErrorCode: pbCommon.ErrorCode_INTERNAL_ERROR,
+			ErrorCode: toErrorCode(err),
 			Error:     err.Error(),
 		}, nil
 	}
@@ -47,15 +50,44 @@ func (h *Handler) RecordBet(ctx context.Context, req *pb.ColorGameRecordBetReq)
 	}, nil
 }
 
+// RevokeBet implements the RevokeBet RPC, a restore puts the stake back
+func (h *Handler) RevokeBet(ctx context.Context, req *pb.ColorGameRevokeBetReq) (*pb.ColorGameRevokeBetRsp, error) {
+	logger.Debug(ctx).
+		Str("table_id", req.TableId).
+		Str("round_id", req.RoundId).
+		Int64("user_id", req.UserId).
+		Int32("color", int32(req.Color)).
+		Int64("amount", req.Amount).
+		Bool("removed", req.Removed).
+		Bool("restore", req.Restore).
+		Msg("RevokeBet RPC called")
+
+	revoke := h.gmsUC.RevokeBet
+	if req.Restore {
+		revoke = h.gmsUC.RestoreBet
+	}
+	err := revoke(ctx, req.TableId, req.RoundId, req.UserId, domain.Color(req.Color), req.Amount, req.Removed)
+	if err != nil {
+		return &pb.ColorGameRevokeBetRsp{
+			ErrorCode: toErrorCode(err),
+			Error:     err.Error(),
+		}, nil
+	}
+
+	return &pb.ColorGameRevokeBetRsp{
+		ErrorCode: pbCommon.ErrorCode_SUCCESS,
+	}, nil
+}
+
 // GetCurrentRound implements the GetCurrentRound RPC
 func (h *Handler) GetCurrentRound(ctx context.Context, req *pb.ColorGameGetCurrentRoundReq) (*pb.ColorGameGetCurrentRoundRsp, error) {
-	logger.Debug(ctx).Int64("user_id", req.UserId).Msg("GetCurrentRound RPC called")
+	logger.Debug(ctx).Int64("user_id", req.UserId).Str("table_id", req.TableId).Msg("GetCurrentRound RPC called")
 
-	round, err := h.gmsUC.GetCurrentRound(ctx)
+	round, err := h.gmsUC.GetCurrentRound(ctx, req.TableId)
 	if err != nil {
 		logger.Error(ctx).Err(err).Msg("Failed to get current round")
 		return &pb.ColorGameGetCurrentRoundRsp{
-			ErrorCode: pbCommon.ErrorCode_INTERNAL_ERROR,
+			ErrorCode: toErrorCode(err),
 		}, nil
 	}
 
@@ -77,6 +109,7 @@ func (h *Handler) GetCurrentRound(ctx context.Context, req *pb.ColorGameGetCurre
 
 	return &pb.ColorGameGetCurrentRoundRsp{
 		ErrorCode:           pbCommon.ErrorCode_SUCCESS,
+		TableId:             round.TableID,
 		RoundId:             round.RoundID,
 		State:               pb.ColorGameState(round.State),
 		BettingEndTimestamp: round.BettingEnd.Unix(),
@@ -84,3 +117,102 @@ func (h *Handler) GetCurrentRound(ctx context.Context, req *pb.ColorGameGetCurre
 		LeftTime:            round.LeftTime,
 	}, nil
 }
+
+// VerifyRound implements the VerifyRound RPC
+func (h *Handler) VerifyRound(ctx context.Context, req *pb.ColorGameVerifyRoundReq) (*pb.ColorGameVerifyRoundRsp, error) {
+	proof, err := h.gmsUC.VerifyRound(ctx, req.RoundId)
+	if err != nil {
+		logger.Warn(ctx).Err(err).Str("round_id", req.RoundId).Msg("Failed to verify round")
+		return &pb.ColorGameVerifyRoundRsp{
+			ErrorCode: pbCommon.ErrorCode_INVALID_PARAMS,
+			Error:     err.Error(),
+			RoundId:   req.RoundId,
+		}, nil
+	}
+
+	return toVerifyRoundRsp(proof), nil
+}
+
+// GetRecentEvents implements the GetRecentEvents RPC
+func (h *Handler) GetRecentEvents(ctx context.Context, req *pb.ColorGameGetRecentEventsReq) (*pb.ColorGameGetRecentEventsRsp, error) {
+	events, complete, err := h.gmsUC.GetRecentEvents(ctx, req.TableId, req.RoundId, req.AfterSeq)
+	if err != nil {
+		logger.Warn(ctx).Err(err).Str("table_id", req.TableId).Msg("Failed to get recent events")
+		return &pb.ColorGameGetRecentEventsRsp{
+			ErrorCode: toErrorCode(err),
+		}, nil
+	}
+
+	return &pb.ColorGameGetRecentEventsRsp{
+		ErrorCode: pbCommon.ErrorCode_SUCCESS,
+		Events:    events,
+		Complete:  complete,
+	}, nil
+}
+
+// GetRoundHistory implements the GetRoundHistory RPC
+func (h *Handler) GetRoundHistory(ctx context.Context, req *pb.ColorGameGetRoundHistoryReq) (*pb.ColorGameGetRoundHistoryRsp, error) {
+	history, err := h.gmsUC.GetRoundHistory(ctx, req.TableId, int(req.Limit))
+	if err != nil {
+		logger.Warn(ctx).Err(err).Str("table_id", req.TableId).Msg("Failed to get round history")
+		return &pb.ColorGameGetRoundHistoryRsp{
+			ErrorCode: toErrorCode(err),
+			Error:     err.Error(),
+		}, nil
+	}
+
+	return toRoundHistoryRsp(history), nil
+}
+
+func toVerifyRoundRsp(proof *domain.FairnessProof) *pb.ColorGameVerifyRoundRsp {
+	return &pb.ColorGameVerifyRoundRsp{
+		ErrorCode:      pbCommon.ErrorCode_SUCCESS,
+		RoundId:        proof.RoundID,
+		ServerSeed:     proof.ServerSeed,
+		ServerSeedHash: proof.ServerSeedHash,
+		ClientSeed:     proof.ClientSeed,
+		Result:         proof.Result,
+		ComputedResult: proof.ComputedResult,
+		Dice:           proof.Dice,
+		ComputedDice:   proof.ComputedDice,
+		HashMatched:    proof.HashMatched,
+		Verified:       proof.Verified,
+	}
+}
+
+// toRoundHistoryRsp converts a results roadmap
+func toRoundHistoryRsp(history *domain.RoundHistory) *pb.ColorGameGetRoundHistoryRsp {
+	rsp := &pb.ColorGameGetRoundHistoryRsp{
+		ErrorCode: pbCommon.ErrorCode_SUCCESS,
+		TableId:   history.TableID,
+		Rounds:    make([]*pb.ColorGameRoadmapEntry, 0, len(history.Rounds)),
+		Stats:     make([]*pb.ColorGameColorStat, 0, len(history.Stats)),
+	}
+	for _, entry := range history.Rounds {
+		rsp.Rounds = append(rsp.Rounds, &pb.ColorGameRoadmapEntry{
+			RoundId: entry.RoundID,
+			Dice:    entry.Dice,
+			Voided:  entry.Voided,
+			EndTime: entry.EndTime.Unix(),
+		})
+	}
+	for _, stat := range history.Stats {
+		rsp.Stats = append(rsp.Stats, &pb.ColorGameColorStat{
+			Color:  stat.Color,
+			Count:  int32(stat.Count),
+			Rounds: int32(stat.Rounds),
+		})
+	}
+	return rsp
+}
+
+// toErrorCode maps usecase errors to error codes, an unknown table is NOT_FOUND
+func toErrorCode(err error) pbCommon.ErrorCode {
+	switch {
+	case errors.Is(err, domain.ErrTableNotFound):
+		return pbCommon.ErrorCode_NOT_FOUND
+	case errors.Is(err, machine.ErrNotBetting), errors.Is(err, machine.ErrRoundMismatch), errors.Is(err, domain.ErrBettingClosed):
+		return pbCommon.ErrorCode_ROUND_NOT_ACTIVE
+	}
+	return pbCommon.ErrorCode_INTERNAL_ERROR
+}
@@ -2,9 +2,13 @@ package local
 
 import (
 	"context"
+	"errors"
 
+	"github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	"github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
 	"github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
 	pb "github.com/frankieli/game_product/shared/proto/colorgame"
+	pbCommon "github.com/frankieli/game_product/shared/proto/common"
 )
 
 // Handler is the local adapter handler for GMS
@@ -21,24 +25,130 @@ func NewHandler(gmsUC *usecase.GMSUseCase) *Handler {
 
 // RecordBet records a bet
 func (h *Handler) RecordBet(ctx context.Context, req *pb.ColorGameRecordBetReq) (*pb.ColorGameRecordBetRsp, error) {
-	err := h.gmsUC.IncrementBetCount(ctx, req.RoundId, req.UserId, float64(req.Amount))
+	err := h.gmsUC.RecordBet(ctx, req.TableId, req.RoundId, req.UserId, req.Color, req.Amount)
 	if err != nil {
 		return nil, err
 	}
 	return &pb.ColorGameRecordBetRsp{}, nil
 }
 
+// RevokeBet takes a cancelled stake back from the round stats, ROUND_NOT_ACTIVE once betting closed.
+// A restore puts the stake back at any time.
+func (h *Handler) RevokeBet(ctx context.Context, req *pb.ColorGameRevokeBetReq) (*pb.ColorGameRevokeBetRsp, error) {
+	revoke := h.gmsUC.RevokeBet
+	if req.Restore {
+		revoke = h.gmsUC.RestoreBet
+	}
+	err := revoke(ctx, req.TableId, req.RoundId, req.UserId, req.Color, req.Amount, req.Removed)
+	if errors.Is(err, machine.ErrNotBetting) || errors.Is(err, machine.ErrRoundMismatch) || errors.Is(err, domain.ErrBettingClosed) {
+		return &pb.ColorGameRevokeBetRsp{
+			ErrorCode: pbCommon.ErrorCode_ROUND_NOT_ACTIVE,
+			Error:     err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ColorGameRevokeBetRsp{}, nil
+}
+
 // GetCurrentRound gets the current round
 func (h *Handler) GetCurrentRound(ctx context.Context, req *pb.ColorGameGetCurrentRoundReq) (*pb.ColorGameGetCurrentRoundRsp, error) {
-	round, err := h.gmsUC.GetCurrentRound(ctx)
+	round, err := h.gmsUC.GetCurrentRound(ctx, req.TableId)
+	if errors.Is(err, domain.ErrTableNotFound) {
+		return &pb.ColorGameGetCurrentRoundRsp{
+			ErrorCode: pbCommon.ErrorCode_NOT_FOUND,
+		}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.ColorGameGetCurrentRoundRsp{
+		TableId:             round.TableID,
 		RoundId:             round.RoundID,
 		State:               round.State,
 		BettingEndTimestamp: round.BettingEnd.Unix(),
 		PlayerBets:          []*pb.ColorGamePlayerBet{}, // GMS doesn't store player bets, return empty array
 	}, nil
 }
+
+// VerifyRound recomputes the provably fair result of a historical round
+func (h *Handler) VerifyRound(ctx context.Context, req *pb.ColorGameVerifyRoundReq) (*pb.ColorGameVerifyRoundRsp, error) {
+	proof, err := h.gmsUC.VerifyRound(ctx, req.RoundId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ColorGameVerifyRoundRsp{
+		RoundId:        proof.RoundID,
+		ServerSeed:     proof.ServerSeed,
+		ServerSeedHash: proof.ServerSeedHash,
+		ClientSeed:     proof.ClientSeed,
+		Result:         proof.Result,
+		ComputedResult: proof.ComputedResult,
+		Dice:           proof.Dice,
+		ComputedDice:   proof.ComputedDice,
+		HashMatched:    proof.HashMatched,
+		Verified:       proof.Verified,
+	}, nil
+}
+
+// GetRecentEvents returns the buffered round state events after (round_id, after_seq)
+func (h *Handler) GetRecentEvents(ctx context.Context, req *pb.ColorGameGetRecentEventsReq) (*pb.ColorGameGetRecentEventsRsp, error) {
+	events, complete, err := h.gmsUC.GetRecentEvents(ctx, req.TableId, req.RoundId, req.AfterSeq)
+	if errors.Is(err, domain.ErrTableNotFound) {
+		return &pb.ColorGameGetRecentEventsRsp{
+			ErrorCode: pbCommon.ErrorCode_NOT_FOUND,
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ColorGameGetRecentEventsRsp{
+		Events:   events,
+		Complete: complete,
+	}, nil
+}
+
+// GetRoundHistory returns the results roadmap of a table
+func (h *Handler) GetRoundHistory(ctx context.Context, req *pb.ColorGameGetRoundHistoryReq) (*pb.ColorGameGetRoundHistoryRsp, error) {
+	history, err := h.gmsUC.GetRoundHistory(ctx, req.TableId, int(req.Limit))
+	if errors.Is(err, domain.ErrTableNotFound) {
+		return &pb.ColorGameGetRoundHistoryRsp{
+			ErrorCode: pbCommon.ErrorCode_NOT_FOUND,
+			Error:     err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toRoundHistoryRsp(history), nil
+}
+
+// toRoundHistoryRsp converts a results roadmap
+func toRoundHistoryRsp(history *domain.RoundHistory) *pb.ColorGameGetRoundHistoryRsp {
+	rsp := &pb.ColorGameGetRoundHistoryRsp{
+		TableId: history.TableID,
+		Rounds:  make([]*pb.ColorGameRoadmapEntry, 0, len(history.Rounds)),
+		Stats:   make([]*pb.ColorGameColorStat, 0, len(history.Stats)),
+	}
+	for _, entry := range history.Rounds {
+		rsp.Rounds = append(rsp.Rounds, &pb.ColorGameRoadmapEntry{
+			RoundId: entry.RoundID,
+			Dice:    entry.Dice,
+			Voided:  entry.Voided,
+			EndTime: entry.EndTime.Unix(),
+		})
+	}
+	for _, stat := range history.Stats {
+		rsp.Stats = append(rsp.Stats, &pb.ColorGameColorStat{
+			Color:  stat.Color,
+			Count:  int32(stat.Count),
+			Rounds: int32(stat.Rounds),
+		})
+	}
+	return rsp
+}
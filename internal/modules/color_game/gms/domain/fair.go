@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"slices"
+
+	"github.com/frankieli/game_product/pkg/service/color_game"
+)
+
+// GenerateServerSeed creates a new secret server seed (32 random bytes, hex encoded)
+func GenerateServerSeed() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate server seed: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// HashServerSeed returns the commitment published before betting: hex(sha256(serverSeed))
+func HashServerSeed(serverSeed string) string {
+	sum := sha256.Sum256([]byte(serverSeed))
+	return hex.EncodeToString(sum[:])
+}
+
+// FairResult derives the dice of the round from the seeds, die i uses bytes 4i..4i+4 of the same MAC:
+//
+//	h       = HMAC-SHA256(key = serverSeed, message = clientSeed + ":" + roundID)
+//	dice[i] = paytable.Pick(uint32(h[4i:4i+4] big-endian) % paytable.TotalWeight())
+//
+// The paytable (colors, weights, their order and the number of dice) is part of the published algorithm:
+// changing it changes the result every historical seed maps to.
+func FairResult(serverSeed, clientSeed, roundID string, paytable *color_game.Paytable) []Color {
+	mac := hmac.New(sha256.New, []byte(serverSeed))
+	mac.Write([]byte(clientSeed + ":" + roundID))
+	sum := mac.Sum(nil)
+
+	dice := make([]Color, paytable.DiceCount())
+	for i := range dice {
+		n := binary.BigEndian.Uint32(sum[4*i : 4*i+4])
+		dice[i] = paytable.Pick(int(n % uint32(paytable.TotalWeight())))
+	}
+	return dice
+}
+
+// FairnessProof is the outcome of re-computing a historical round
+type FairnessProof struct {
+	RoundID        string
+	ServerSeed     string
+	ServerSeedHash string
+	ClientSeed     string
+	Result         Color   // Result recorded for the round (first die)
+	ComputedResult Color   // Result re-computed from the revealed seeds (first die)
+	Dice           []Color // Every die recorded for the round
+	ComputedDice   []Color // Every die re-computed from the revealed seeds
+	HashMatched    bool    // sha256(ServerSeed) equals the commitment published at round start
+	Verified       bool    // HashMatched and ComputedDice equals Dice
+}
+
+// VerifyFairness re-computes the result of a finished round from its revealed seeds with the paytable in force
+func VerifyFairness(round *GameRound, paytable *color_game.Paytable) (*FairnessProof, error) {
+	if round.ServerSeedHash == "" {
+		return nil, fmt.Errorf("round %s was not drawn in provably fair mode", round.RoundID)
+	}
+	if round.ServerSeed == "" {
+		return nil, fmt.Errorf("server seed of round %s is not revealed yet", round.RoundID)
+	}
+
+	dice, err := ParseResult(round.Result)
+	if err != nil {
+		return nil, fmt.Errorf("round %s: %w", round.RoundID, err)
+	}
+	computed := FairResult(round.ServerSeed, round.ClientSeed, round.RoundID, paytable)
+	hashMatched := HashServerSeed(round.ServerSeed) == round.ServerSeedHash
+
+	return &FairnessProof{
+		RoundID:        round.RoundID,
+		ServerSeed:     round.ServerSeed,
+		ServerSeedHash: round.ServerSeedHash,
+		ClientSeed:     round.ClientSeed,
+		Result:         dice[0],
+		ComputedResult: computed[0],
+		Dice:           dice,
+		ComputedDice:   computed,
+		HashMatched:    hashMatched,
+		Verified:       hashMatched && slices.Equal(computed, dice),
+	}, nil
+}
@@ -10,12 +10,27 @@ type RoundStatus int
 const (
 	RoundStatusInProgress RoundStatus = 0 // 進行中
 	RoundStatusEnded      RoundStatus = 1 // 已結束
+	RoundStatusVoided     RoundStatus = 2 // 已作廢 (已退款)
 )
 
+// String returns the status name shown to operators
+func (s RoundStatus) String() string {
+	switch s {
+	case RoundStatusInProgress:
+		return "IN_PROGRESS"
+	case RoundStatusEnded:
+		return "ENDED"
+	case RoundStatusVoided:
+		return "VOIDED"
+	}
+	return "UNKNOWN"
+}
+
 // GameRound represents a game round history record
 type GameRound struct {
 	RoundID        string      `gorm:"primaryKey;type:varchar(64)" json:"round_id"`
 	GameCode       string      `gorm:"index;type:varchar(32);not null" json:"game_code"`
+	TableID        string      `gorm:"index;type:varchar(32);not null;default:'default'" json:"table_id"`
 	Status         RoundStatus `gorm:"type:int;not null;default:0" json:"status"`
 	StartTime      time.Time   `gorm:"not null" json:"start_time"`
 	EndTime        *time.Time  `json:"end_time"`
@@ -23,6 +38,9 @@ type GameRound struct {
 	TotalBets      int         `gorm:"default:0" json:"total_bets"`
 	TotalPlayers   int         `gorm:"default:0" json:"total_players"`
 	TotalBetAmount float64     `gorm:"type:decimal(18,2);default:0" json:"total_bet_amount"`
+	ServerSeedHash string      `gorm:"type:varchar(64)" json:"server_seed_hash"` // Provably fair commitment, published at round start
+	ServerSeed     string      `gorm:"type:varchar(64)" json:"server_seed"`      // Revealed when the result is announced
+	ClientSeed     string      `gorm:"type:varchar(64)" json:"client_seed"`
 	CreatedAt      time.Time   `gorm:"autoCreateTime:false" json:"created_at"`
 	UpdatedAt      time.Time   `gorm:"autoUpdateTime:false" json:"updated_at"`
 }
@@ -9,4 +9,13 @@ import (
 type GameRoundRepository interface {
 	Create(ctx context.Context, round *GameRound) error
 	UpdateResult(ctx context.Context, roundID string, result string, endTime *time.Time, totalBets int, totalPlayers int, totalAmount float64) error
+	MarkVoided(ctx context.Context, roundID string, endTime *time.Time) error
+	RevealServerSeed(ctx context.Context, roundID string, serverSeed string) error
+	GetByRoundID(ctx context.Context, roundID string) (*GameRound, error) // Returns nil if not found
+
+	// ListRecent returns the last finished (ended or voided) rounds of a table, newest first
+	ListRecent(ctx context.Context, tableID string, limit int) ([]*GameRound, error)
+
+	// ListByTimeRange returns the rounds started in [from, to) newest first, an empty tableID lists every table
+	ListByTimeRange(ctx context.Context, tableID string, from, to time.Time, limit int) ([]*GameRound, error)
 }
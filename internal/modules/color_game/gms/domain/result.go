@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+)
+
+// FormatResult encodes the dice for GameRound.Result: the color name of a single draw (e.g. "REWARD_RED"),
+// a JSON array of color names for several dice (e.g. ["REWARD_RED","REWARD_RED","REWARD_BLUE"])
+func FormatResult(dice []Color) string {
+	if len(dice) == 1 {
+		return dice[0].String()
+	}
+	names := make([]string, len(dice))
+	for i, color := range dice {
+		names[i] = color.String()
+	}
+	data, _ := json.Marshal(names)
+	return string(data)
+}
+
+// ParseResult decodes a GameRound.Result written by FormatResult
+func ParseResult(result string) ([]Color, error) {
+	names := []string{result}
+	if strings.HasPrefix(result, "[") {
+		if err := json.Unmarshal([]byte(result), &names); err != nil {
+			return nil, fmt.Errorf("invalid result %q: %w", result, err)
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("invalid result %q: no dice", result)
+	}
+
+	dice := make([]Color, len(names))
+	for i, name := range names {
+		value, ok := pbColorGame.ColorGameReward_value[name]
+		if !ok || value == int32(pbColorGame.ColorGameReward_REWARD_UNSPECIFIED) {
+			return nil, fmt.Errorf("invalid result %q: unknown color %q", result, name)
+		}
+		dice[i] = Color(value)
+	}
+	return dice, nil
+}
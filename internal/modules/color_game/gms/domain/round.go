@@ -11,13 +11,20 @@ type Color = pbColorGame.ColorGameReward
 
 // Round represents a game round
 type Round struct {
+	TableID    string
 	RoundID    string
 	State      pbColorGame.ColorGameState
-	Result     pbColorGame.ColorGameReward
+	Result     pbColorGame.ColorGameReward // First die, the result of a single draw round
+	Dice       []Color                     // Every die drawn (one in single draw mode)
 	StartTime  time.Time
 	BettingEnd time.Time
 	TotalBets  int
 	LeftTime   int64
+
+	// Provably fair commitment (empty when the round is drawn by plain RNG)
+	ServerSeed     string // Secret until the result is announced
+	ServerSeedHash string // Published at round start
+	ClientSeed     string // Public seed mixed into the draw
 }
 
 // PlayerBet represents a player's bet in a round
@@ -26,30 +33,43 @@ type PlayerBet struct {
 	Amount int64
 }
 
-// NewRound creates a new round
-func NewRound(roundID string) *Round {
+// NewRound creates a new round starting at now
+func NewRound(roundID string, now time.Time) *Round {
 	return &Round{
 		RoundID:   roundID,
 		State:     pbColorGame.ColorGameState_GAME_STATE_ROUND_STARTED,
-		StartTime: time.Now(),
+		StartTime: now,
 	}
 }
 
-// StartBetting transitions to betting state
-func (r *Round) StartBetting(duration time.Duration) {
+// CommitSeed binds the round to a server seed, only the hash is published until the result is shown
+func (r *Round) CommitSeed(serverSeed, clientSeed string) {
+	r.ServerSeed = serverSeed
+	r.ServerSeedHash = HashServerSeed(serverSeed)
+	r.ClientSeed = clientSeed
+}
+
+// IsProvablyFair checks if the round result is derived from committed seeds
+func (r *Round) IsProvablyFair() bool {
+	return r.ServerSeed != ""
+}
+
+// StartBetting transitions to betting state, betting closes duration after now
+func (r *Round) StartBetting(now time.Time, duration time.Duration) {
 	r.State = pbColorGame.ColorGameState_GAME_STATE_BETTING
-	r.BettingEnd = time.Now().Add(duration)
+	r.BettingEnd = now.Add(duration)
 }
 
-// CanAcceptBet checks if bets can be accepted
-func (r *Round) CanAcceptBet() bool {
-	return r.State == pbColorGame.ColorGameState_GAME_STATE_BETTING && time.Now().Before(r.BettingEnd)
+// CanAcceptBet checks if bets can be accepted at now
+func (r *Round) CanAcceptBet(now time.Time) bool {
+	return r.State == pbColorGame.ColorGameState_GAME_STATE_BETTING && now.Before(r.BettingEnd)
 }
 
-// Draw transitions to drawing state and selects result
-func (r *Round) Draw(result Color) {
+// Draw transitions to drawing state and records the drawn dice
+func (r *Round) Draw(dice []Color) {
 	r.State = pbColorGame.ColorGameState_GAME_STATE_DRAWING
-	r.Result = result
+	r.Result = dice[0]
+	r.Dice = dice
 }
 
 // ShowResult transitions to result state
@@ -57,6 +77,11 @@ func (r *Round) ShowResult() {
 	r.State = pbColorGame.ColorGameState_GAME_STATE_RESULT
 }
 
+// Void transitions to voided state, the round will never be settled
+func (r *Round) Void() {
+	r.State = pbColorGame.ColorGameState_GAME_STATE_VOIDED
+}
+
 // IsFinished checks if round is finished
 func (r *Round) IsFinished() bool {
 	return r.State == pbColorGame.ColorGameState_GAME_STATE_RESULT
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrRoundNotFound is returned when a round ID is not in the round history
+var ErrRoundNotFound = errors.New("round not found")
+
+// RoadmapEntry is a finished round of the results roadmap of a table
+type RoadmapEntry struct {
+	RoundID string
+	TableID string
+	Dice    []Color // drawn dice, empty for a voided round
+	Voided  bool
+	EndTime time.Time
+}
+
+// RoadmapEntryOf converts a persisted round, false when the round is not finished or its result cannot be read
+func RoadmapEntryOf(round *GameRound) (RoadmapEntry, bool) {
+	entry := RoadmapEntry{RoundID: round.RoundID, TableID: round.TableID}
+	if round.EndTime != nil {
+		entry.EndTime = *round.EndTime
+	}
+
+	switch round.Status {
+	case RoundStatusVoided:
+		entry.Voided = true
+		return entry, true
+	case RoundStatusEnded:
+		dice, err := ParseResult(round.Result)
+		if err != nil {
+			return entry, false
+		}
+		entry.Dice = dice
+		return entry, true
+	default:
+		return entry, false
+	}
+}
+
+// ColorStat is how often a color was drawn in the rounds of a roadmap
+type ColorStat struct {
+	Color  Color
+	Count  int // dice showing the color
+	Rounds int // rounds the color was drawn in
+}
+
+// ColorStats counts the colors drawn in the entries, in the order of colors (the paytable) followed by
+// any other drawn color. Voided rounds have no dice and are not counted.
+func ColorStats(entries []RoadmapEntry, colors []Color) []ColorStat {
+	stats := make([]ColorStat, 0, len(colors))
+	index := make(map[Color]int, len(colors))
+	stat := func(color Color) *ColorStat {
+		i, ok := index[color]
+		if !ok {
+			i = len(stats)
+			index[color] = i
+			stats = append(stats, ColorStat{Color: color})
+		}
+		return &stats[i]
+	}
+	for _, color := range colors {
+		stat(color)
+	}
+
+	for _, entry := range entries {
+		seen := make(map[Color]bool, len(entry.Dice))
+		for _, color := range entry.Dice {
+			s := stat(color)
+			s.Count++
+			if !seen[color] {
+				seen[color] = true
+				s.Rounds++
+			}
+		}
+	}
+	return stats
+}
+
+// RoundHistory is the results roadmap of a table: its last finished rounds newest first and their color frequencies
+type RoundHistory struct {
+	TableID string
+	Rounds  []RoadmapEntry
+	Stats   []ColorStat
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+)
+
+// ErrFencedOut is returned when a snapshot is written with an older fencing token than the stored one,
+// i.e. by a GMS replica that has already lost leadership.
+var ErrFencedOut = errors.New("snapshot rejected: stale fencing token")
+
+// RoundSnapshot is the persisted checkpoint of the state machine.
+// It is written on every phase transition so that a restarted GMS can pick up an unfinished round.
+type RoundSnapshot struct {
+	RoundID      string                     `json:"round_id"`
+	State        pbColorGame.ColorGameState `json:"state"`
+	Result       Color                      `json:"result"`
+	Dice         []Color                    `json:"dice,omitempty"` // Empty in snapshots written before dice mode, use [Result]
+	StartTime    time.Time                  `json:"start_time"`
+	BettingEnd   time.Time                  `json:"betting_end"`
+	PhaseEndTime time.Time                  `json:"phase_end_time"`
+	RoundCounter int                        `json:"round_counter"`
+	EventSeq     int64                      `json:"event_seq"` // Last event sequence number emitted for the round
+	UpdatedAt    time.Time                  `json:"updated_at"`
+	FencingToken int64                      `json:"fencing_token"` // Leadership term that wrote the snapshot
+
+	// Provably fair seeds, the committed seed must survive a restart or the published hash is worthless
+	ServerSeed     string `json:"server_seed,omitempty"`
+	ServerSeedHash string `json:"server_seed_hash,omitempty"`
+	ClientSeed     string `json:"client_seed,omitempty"`
+
+	// Operator controls survive a failover: the table stays paused and a requested void is still carried out
+	Paused     bool   `json:"paused,omitempty"`
+	VoidReason string `json:"void_reason,omitempty"`
+}
+
+// DrawnDice returns the dice of the snapshot, nil when the round was not drawn yet
+func (s *RoundSnapshot) DrawnDice() []Color {
+	if len(s.Dice) > 0 {
+		return s.Dice
+	}
+	if s.Result != pbColorGame.ColorGameReward_REWARD_UNSPECIFIED {
+		return []Color{s.Result}
+	}
+	return nil
+}
+
+// IsFinished checks if the snapshot describes a round that needs no recovery
+func (s *RoundSnapshot) IsFinished() bool {
+	return s.State == pbColorGame.ColorGameState_GAME_STATE_ROUND_ENDED ||
+		s.State == pbColorGame.ColorGameState_GAME_STATE_STOPPED ||
+		s.State == pbColorGame.ColorGameState_GAME_STATE_VOIDED
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "context"
+
+// RoundSnapshotRepository defines the interface for state machine checkpoint persistence
+type RoundSnapshotRepository interface {
+	// Save overwrites the current snapshot, returns ErrFencedOut if the stored snapshot has a newer fencing token
+	Save(ctx context.Context, snapshot *RoundSnapshot) error
+
+	// Load returns the last saved snapshot, or nil if there is none
+	Load(ctx context.Context) (*RoundSnapshot, error)
+
+	// Clear removes the current snapshot
+	Clear(ctx context.Context) error
+}
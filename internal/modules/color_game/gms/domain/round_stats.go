@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrBettingClosed is returned when a cancelled stake is taken back after betting of the round closed
+var ErrBettingClosed = errors.New("betting closed")
+
+// RoundStats are the bet statistics of a round, persisted with the result in game_rounds
+type RoundStats struct {
+	TotalBets    int
+	TotalPlayers int
+	TotalAmount  int64
+	Pools        map[Color]*BetPool // per color totals, only colors with bets
+}
+
+// BetPool is the total staked on one color of a round
+type BetPool struct {
+	Amount  int64
+	Bettors int
+}
+
+// RoundStatsRepository counts the bets of running rounds.
+// It is shared by every GMS process, stats are deleted once the round is persisted or voided.
+type RoundStatsRepository interface {
+	// RecordBet atomically adds a bet of userID on color, returns the bet count of the round
+	RecordBet(ctx context.Context, roundID string, userID int64, color Color, amount int64) (int, error)
+
+	// CancelBet atomically takes amount back from the pool of color, removed also drops the bet from the count.
+	// Unique player counts are kept (a cancelled player still took part in the round).
+	// It returns ErrBettingClosed once CloseBetting was called for the round.
+	CancelBet(ctx context.Context, roundID string, color Color, amount int64, removed bool) error
+
+	// RestoreBet atomically puts back a stake taken by CancelBet whose bet could not be cancelled after all,
+	// removed also counts the bet again. It is accepted after betting closed.
+	RestoreBet(ctx context.Context, roundID string, color Color, amount int64, removed bool) error
+
+	// CloseBetting rejects every later CancelBet of the round, the leader calls it when betting ends
+	CloseBetting(ctx context.Context, roundID string) error
+
+	// Get returns the stats of a round, zero stats if nothing was recorded
+	Get(ctx context.Context, roundID string) (*RoundStats, error)
+
+	// Delete evicts the stats of a round
+	Delete(ctx context.Context, roundID string) error
+}
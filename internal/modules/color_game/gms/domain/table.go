@@ -0,0 +1,6 @@
+package domain
+
+import "errors"
+
+// ErrTableNotFound is returned when a request names a table that is not hosted by this GMS
+var ErrTableNotFound = errors.New("table not found")
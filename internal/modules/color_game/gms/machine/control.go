@@ -0,0 +1,210 @@
+package machine
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+)
+
+var (
+	// ErrNotRunning is returned by operator controls when this instance is not running rounds (not the leader)
+	ErrNotRunning = errors.New("state machine is not running rounds")
+	// ErrRoundMismatch is returned when an operator control targets a round that is not the current one
+	ErrRoundMismatch = errors.New("round is not the current round")
+	// ErrNotBetting is returned when the betting phase is adjusted outside of betting
+	ErrNotBetting = errors.New("round is not in betting phase")
+	// ErrRoundNotVoidable is returned when the result of the round was already announced
+	ErrRoundNotVoidable = errors.New("round can no longer be voided")
+)
+
+// Pause lets the current round finish and holds the table before the next round until Resume.
+// The pause is checkpointed, a new leader keeps the table paused.
+func (sm *StateMachine) Pause() error {
+	sm.mu.Lock()
+	if !sm.running {
+		sm.mu.Unlock()
+		return ErrNotRunning
+	}
+	sm.paused = true
+	sm.mu.Unlock()
+
+	sm.checkpoint(context.Background())
+	return nil
+}
+
+// Resume starts rounds again on a paused table
+func (sm *StateMachine) Resume() error {
+	sm.mu.Lock()
+	if !sm.running {
+		sm.mu.Unlock()
+		return ErrNotRunning
+	}
+	sm.paused = false
+	sm.mu.Unlock()
+
+	sm.checkpoint(context.Background())
+	sm.wakeUp()
+	return nil
+}
+
+// IsPaused reports whether the table holds before the next round
+func (sm *StateMachine) IsPaused() bool {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+	return sm.paused
+}
+
+// AdjustBetting moves the betting end of the current round by delta (negative shortens).
+// Betting never ends in the past: shortening beyond now closes betting immediately.
+func (sm *StateMachine) AdjustBetting(roundID string, delta time.Duration) (time.Time, error) {
+	sm.mu.Lock()
+	if err := sm.checkCurrentRoundLocked(roundID); err != nil {
+		sm.mu.Unlock()
+		return time.Time{}, err
+	}
+	round := sm.currentRound
+	if round.State != pbColorGame.ColorGameState_GAME_STATE_BETTING {
+		sm.mu.Unlock()
+		return time.Time{}, ErrNotBetting
+	}
+
+	bettingEnd := round.BettingEnd.Add(delta)
+	if now := sm.clock.Now(); bettingEnd.Before(now) {
+		bettingEnd = now
+	}
+	round.BettingEnd = bettingEnd
+	sm.phaseEndTime = bettingEnd
+	sm.mu.Unlock()
+
+	sm.wakeUp()
+	return bettingEnd, nil
+}
+
+// VoidCurrentRound abandons the current round before its result is announced, every bet of the round is refunded.
+// The request is checkpointed, a new leader voids the round if this one fails over first.
+func (sm *StateMachine) VoidCurrentRound(roundID string, reason string) error {
+	sm.mu.Lock()
+	if err := sm.checkCurrentRoundLocked(roundID); err != nil {
+		sm.mu.Unlock()
+		return err
+	}
+	switch sm.currentRound.State {
+	case pbColorGame.ColorGameState_GAME_STATE_ROUND_STARTED,
+		pbColorGame.ColorGameState_GAME_STATE_BETTING,
+		pbColorGame.ColorGameState_GAME_STATE_DRAWING:
+	default:
+		sm.mu.Unlock()
+		return ErrRoundNotVoidable
+	}
+	sm.voidReason = reason
+	cancelDraw := sm.cancelDraw
+	sm.mu.Unlock()
+
+	sm.checkpoint(context.Background())
+
+	// A blocking provider (live dealer) stops waiting for the result
+	if cancelDraw != nil {
+		cancelDraw()
+	}
+	sm.wakeUp()
+	return nil
+}
+
+func (sm *StateMachine) checkCurrentRoundLocked(roundID string) error {
+	if !sm.running {
+		return ErrNotRunning
+	}
+	if sm.currentRound == nil || sm.currentRound.RoundID != roundID {
+		return ErrRoundMismatch
+	}
+	return nil
+}
+
+// wakeUp interrupts the wait of the round loop so that it re-reads phase end, pause and void requests
+func (sm *StateMachine) wakeUp() {
+	select {
+	case sm.wake <- struct{}{}:
+	default:
+	}
+}
+
+// takeVoidRequest returns and clears a pending operator void
+func (sm *StateMachine) takeVoidRequest() string {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	reason := sm.voidReason
+	sm.voidReason = ""
+	return reason
+}
+
+// waitPhase sleeps until the end of the current phase, which operators may move while waiting.
+// onAdjust is called whenever the phase end changed. It returns false if the context was cancelled
+// or the round was voided by an operator (the round is then already voided).
+func (sm *StateMachine) waitPhase(ctx context.Context, round *domain.Round, onAdjust func(end time.Time) bool) bool {
+	sm.mu.RLock()
+	lastEnd := sm.phaseEndTime
+	sm.mu.RUnlock()
+
+	for {
+		if reason := sm.takeVoidRequest(); reason != "" {
+			sm.voidRound(ctx, round, reason)
+			return false
+		}
+
+		sm.mu.RLock()
+		end := sm.phaseEndTime
+		sm.mu.RUnlock()
+
+		if !end.Equal(lastEnd) {
+			lastEnd = end
+			if onAdjust != nil && !onAdjust(end) {
+				return false
+			}
+		}
+
+		wait := end.Sub(sm.clock.Now())
+		if wait <= 0 {
+			return true
+		}
+
+		timer := sm.clock.NewTimer(wait)
+		select {
+		case <-timer.C():
+		case <-sm.wake:
+			timer.Stop()
+		case <-ctx.Done():
+			timer.Stop()
+			return false
+		}
+	}
+}
+
+// waitWhilePaused holds the loop before the next round while the table is paused.
+// It returns false if the context was cancelled.
+func (sm *StateMachine) waitWhilePaused(ctx context.Context) bool {
+	announced := false
+	for {
+		sm.mu.RLock()
+		paused := sm.paused && !sm.stopping
+		sm.mu.RUnlock()
+		if !paused {
+			return true
+		}
+
+		if !announced {
+			announced = true
+			sm.emitEvent(GameEvent{
+				Type: pbColorGame.ColorGameState_GAME_STATE_PAUSED,
+			})
+		}
+
+		select {
+		case <-sm.wake:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package machine
+
+// DefaultEventBufferSize is the number of recent events kept per table for resynchronisation
+const DefaultEventBufferSize = 64
+
+// bufferedEvent is an event with the round its sequence number belongs to
+// (PAUSED / STOPPED have no round ID and continue the sequence of the previous round)
+type bufferedEvent struct {
+	seqRoundID string
+	event      GameEvent
+}
+
+// eventBuffer is a bounded ring buffer of the most recent events of a table, oldest first
+type eventBuffer struct {
+	events []bufferedEvent
+	start  int
+	size   int
+}
+
+func newEventBuffer(capacity int) *eventBuffer {
+	if capacity <= 0 {
+		capacity = DefaultEventBufferSize
+	}
+	return &eventBuffer{events: make([]bufferedEvent, capacity)}
+}
+
+// add appends an event, overwriting the oldest one when the buffer is full
+func (b *eventBuffer) add(seqRoundID string, event GameEvent) {
+	capacity := len(b.events)
+	if b.size < capacity {
+		b.events[(b.start+b.size)%capacity] = bufferedEvent{seqRoundID: seqRoundID, event: event}
+		b.size++
+		return
+	}
+	b.events[b.start] = bufferedEvent{seqRoundID: seqRoundID, event: event}
+	b.start = (b.start + 1) % capacity
+}
+
+func (b *eventBuffer) at(i int) bufferedEvent {
+	return b.events[(b.start+i)%len(b.events)]
+}
+
+// since returns the events after (roundID, afterSeq).
+// complete is false when events in between were already overwritten, the caller must resync from the current round.
+func (b *eventBuffer) since(roundID string, afterSeq int64) (events []GameEvent, complete bool) {
+	if roundID == "" {
+		return b.from(0), false
+	}
+
+	// Find the first buffered event of the round that follows afterSeq
+	for i := 0; i < b.size; i++ {
+		e := b.at(i)
+		if e.seqRoundID != roundID || e.event.Seq <= afterSeq {
+			continue
+		}
+		// The event right after afterSeq must still be buffered, unless the round was already complete
+		return b.from(i), e.event.Seq == afterSeq+1
+	}
+
+	// Nothing newer in that round: everything after its last buffered event follows
+	for i := b.size - 1; i >= 0; i-- {
+		e := b.at(i)
+		if e.seqRoundID == roundID {
+			return b.from(i + 1), e.event.Seq >= afterSeq
+		}
+	}
+
+	// The round is unknown (too old, or this instance did not run it)
+	return b.from(0), false
+}
+
+func (b *eventBuffer) from(i int) []GameEvent {
+	events := make([]GameEvent, 0, b.size-i)
+	for ; i < b.size; i++ {
+		events = append(events, b.at(i).event)
+	}
+	return events
+}
@@ -0,0 +1,189 @@
+package machine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	"github.com/frankieli/game_product/pkg/clock"
+	"github.com/frankieli/game_product/pkg/service/color_game"
+)
+
+var (
+	// ErrResultTimeout is returned when a manual result was not submitted in time, the round is voided
+	ErrResultTimeout = errors.New("result was not submitted in time")
+	// ErrNoPendingDraw is returned when a result is submitted for a round that is not drawing
+	ErrNoPendingDraw = errors.New("round is not waiting for a result")
+	// ErrManualResultNotSupported is returned when the active provider does not accept submitted results
+	ErrManualResultNotSupported = errors.New("result provider does not accept manual results")
+)
+
+// ResultProvider decides the result of a round during the DRAWING phase: one color per die of the paytable.
+// Draw may block (e.g. waiting for a live dealer), an error voids the round.
+type ResultProvider interface {
+	Draw(ctx context.Context, round *domain.Round) ([]domain.Color, error)
+}
+
+// SeedCommitter is implemented by providers that commit to a seed when the round starts (provably fair)
+type SeedCommitter interface {
+	CommitSeed(round *domain.Round) error
+}
+
+// ResultSubmitter is implemented by providers whose results are entered by an operator
+type ResultSubmitter interface {
+	Submit(roundID string, dice []domain.Color) error
+}
+
+// RNGProvider draws with math/rand, weighted by the paytable
+type RNGProvider struct {
+	Paytable *color_game.Paytable // Colors and draw weights
+
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+// NewRNGProvider creates the default RNG provider seeded with the current time
+func NewRNGProvider() *RNGProvider {
+	return NewSeededProvider(time.Now().UnixNano())
+}
+
+// NewSeededProvider creates a deterministic RNG provider, the same seed always yields the same result sequence (tests)
+func NewSeededProvider(seed int64) *RNGProvider {
+	return &RNGProvider{
+		Paytable: color_game.DefaultPaytable(),
+		rnd:      rand.New(rand.NewSource(seed)),
+	}
+}
+
+func (p *RNGProvider) Draw(ctx context.Context, round *domain.Round) ([]domain.Color, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	dice := make([]domain.Color, p.Paytable.DiceCount())
+	for i := range dice {
+		dice[i] = p.Paytable.Pick(p.rnd.Intn(p.Paytable.TotalWeight()))
+	}
+	return dice, nil
+}
+
+// FairProvider derives the result from a server seed committed at round start (commit–reveal)
+type FairProvider struct {
+	Paytable *color_game.Paytable // Outcome table of the draw, must be the one VerifyRound checks against
+
+	clientSeed string
+}
+
+// NewFairProvider creates a provably fair provider with the given public client seed
+func NewFairProvider(clientSeed string) *FairProvider {
+	return &FairProvider{
+		Paytable:   color_game.DefaultPaytable(),
+		clientSeed: clientSeed,
+	}
+}
+
+func (p *FairProvider) CommitSeed(round *domain.Round) error {
+	serverSeed, err := domain.GenerateServerSeed()
+	if err != nil {
+		return err
+	}
+	round.CommitSeed(serverSeed, p.clientSeed)
+	return nil
+}
+
+func (p *FairProvider) Draw(ctx context.Context, round *domain.Round) ([]domain.Color, error) {
+	if !round.IsProvablyFair() {
+		return nil, fmt.Errorf("round %s has no committed server seed", round.RoundID)
+	}
+	return domain.FairResult(round.ServerSeed, round.ClientSeed, round.RoundID, p.Paytable), nil
+}
+
+// ManualProvider waits for an operator (live dealer) to submit the result of the round
+type ManualProvider struct {
+	Timeout  time.Duration
+	Clock    clock.Clock          // Measures Timeout, tests may use a fake clock
+	Paytable *color_game.Paytable // Colors the dealer may submit
+
+	mu      sync.Mutex
+	pending map[string]chan []domain.Color // roundID -> submitted dice
+}
+
+// NewManualProvider creates a manual provider, rounds without a result after timeout are voided
+func NewManualProvider(timeout time.Duration) *ManualProvider {
+	return &ManualProvider{
+		Timeout:  timeout,
+		Clock:    clock.New(),
+		Paytable: color_game.DefaultPaytable(),
+		pending:  make(map[string]chan []domain.Color),
+	}
+}
+
+func (p *ManualProvider) Draw(ctx context.Context, round *domain.Round) ([]domain.Color, error) {
+	ch := make(chan []domain.Color, 1)
+
+	p.mu.Lock()
+	p.pending[round.RoundID] = ch
+	p.mu.Unlock()
+
+	defer func() {
+		p.mu.Lock()
+		delete(p.pending, round.RoundID)
+		p.mu.Unlock()
+	}()
+
+	timer := p.Clock.NewTimer(p.Timeout)
+	defer timer.Stop()
+
+	select {
+	case dice := <-ch:
+		return dice, nil
+	case <-timer.C():
+		return nil, ErrResultTimeout
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Submit delivers the dice of a drawing round, only the first submission counts
+func (p *ManualProvider) Submit(roundID string, dice []domain.Color) error {
+	if err := p.Paytable.ValidDice(dice); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	ch, ok := p.pending[roundID]
+	if ok {
+		delete(p.pending, roundID)
+	}
+	p.mu.Unlock()
+
+	if !ok {
+		return ErrNoPendingDraw
+	}
+	ch <- dice
+	return nil
+}
+
+// NewResultProvider builds a provider by name: "rng", "fair" (provably fair) or "manual" (live dealer),
+// drawing the colors of the paytable
+func NewResultProvider(kind string, clientSeed string, manualTimeout time.Duration, paytable *color_game.Paytable) (ResultProvider, error) {
+	switch kind {
+	case "rng":
+		provider := NewRNGProvider()
+		provider.Paytable = paytable
+		return provider, nil
+	case "fair":
+		provider := NewFairProvider(clientSeed)
+		provider.Paytable = paytable
+		return provider, nil
+	case "manual":
+		provider := NewManualProvider(manualTimeout)
+		provider.Paytable = paytable
+		return provider, nil
+	default:
+		return nil, fmt.Errorf("unknown result provider: %s", kind)
+	}
+}
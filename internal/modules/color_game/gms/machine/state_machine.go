@@ -2,23 +2,38 @@ package machine
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"math/rand"
 	"sync"
 	"time"
 
 	"github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	"github.com/frankieli/game_product/pkg/clock"
 	"github.com/frankieli/game_product/pkg/logger"
+	"github.com/frankieli/game_product/pkg/service/color_game"
 	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
 )
 
 // GameEvent represents a game event
 type GameEvent struct {
+	TableID             string
 	Type                pbColorGame.ColorGameState
 	RoundID             string
 	Data                interface{}
 	LeftTime            int64
 	BettingEndTimestamp int64
+
+	// Provably fair commitment, ServerSeed is only set once the result is announced
+	ServerSeedHash string
+	ClientSeed     string
+	ServerSeed     string
+
+	// Time is when the event happened on the clock of the state machine
+	Time time.Time
+
+	// Seq increases by one with every event of a round, starting at 1 with ROUND_STARTED.
+	// PAUSED / STOPPED carry no round ID and continue the sequence of the previous round.
+	Seq int64
 }
 
 // EventHandler handles game events
@@ -37,16 +52,27 @@ type RoundView struct {
 
 // StateMachine manages the game state machine (application layer)
 type StateMachine struct {
+	// TableID names the table hosted by this state machine, set it before Start
+	TableID string
+
 	mu           sync.RWMutex
+	clock        clock.Clock
 	currentRound *domain.Round
 	roundCounter int
 
-	eventHandlers []EventHandler
-	rnd           *rand.Rand
+	eventHandlers  []EventHandler
+	resultProvider ResultProvider
 
-	// Worker Pool
-	jobQueue chan func()
-	workerWg sync.WaitGroup
+	// bettingClosed is called synchronously once betting of a round closed, before the drawing is announced
+	bettingClosed func(ctx context.Context, roundID string)
+
+	// Event delivery: one ordered queue and worker per handler, emitMu serialises sequencing and enqueueing
+	handlerQueues []*handlerQueue
+	workerWg      sync.WaitGroup
+	emitMu        sync.Mutex
+	eventSeq      int64
+	seqRoundID    string
+	recentEvents  *eventBuffer
 
 	// durations for each phase
 	BettingDuration time.Duration
@@ -56,28 +82,146 @@ type StateMachine struct {
 	RestDuration    time.Duration
 	phaseEndTime    time.Time
 
+	// Crash recovery: snapshots older than MaxRecoveryAge are voided instead of resumed.
+	// checkpointMu orders the snapshots of the round loop and of operator controls, the last one written is the newest.
+	snapshotRepo   domain.RoundSnapshotRepository
+	MaxRecoveryAge time.Duration
+	checkpointMu   sync.Mutex
+
+	// Leadership: Start may be called again after a term ended, fencingToken tags every snapshot of the term
+	fencingToken int64
+	running      bool
+	cancelRun    context.CancelFunc
+
 	stopping bool
 	doneChan chan struct{}
+
+	// Operator controls: paused holds the table before the next round, voidReason requests a void of the current round
+	paused     bool
+	voidReason string
+	cancelDraw context.CancelFunc
+	wake       chan struct{}
 }
 
 // NewStateMachine creates a new state machine
 func NewStateMachine() *StateMachine {
 	return &StateMachine{
+		TableID:         color_game.DefaultTableID,
+		clock:           clock.New(),
 		eventHandlers:   make([]EventHandler, 0),
-		rnd:             rand.New(rand.NewSource(time.Now().UnixNano())),
-		jobQueue:        make(chan func(), 100), // Buffered job queue
+		resultProvider:  NewRNGProvider(),
 		BettingDuration: 10 * time.Second,
 		DrawingDuration: 2 * time.Second,
 		ResultDuration:  5 * time.Second,
 		WaitDuration:    2 * time.Second,
 		RestDuration:    3 * time.Second,
-		doneChan:        make(chan struct{}),
+		MaxRecoveryAge:  time.Minute,
+		doneChan:        closedChan(),
+		wake:            make(chan struct{}, 1),
+		recentEvents:    newEventBuffer(DefaultEventBufferSize),
+	}
+}
+
+// closedChan returns an already closed channel (a state machine that is not running is "done")
+func closedChan() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}
+
+// SetPhaseDurations overrides the phase durations of the table, zero keeps the current value
+func (sm *StateMachine) SetPhaseDurations(betting, drawing, result, wait, rest time.Duration) {
+	if betting > 0 {
+		sm.BettingDuration = betting
+	}
+	if drawing > 0 {
+		sm.DrawingDuration = drawing
+	}
+	if result > 0 {
+		sm.ResultDuration = result
+	}
+	if wait > 0 {
+		sm.WaitDuration = wait
+	}
+	if rest > 0 {
+		sm.RestDuration = rest
+	}
+}
+
+// SetClock replaces the clock of the state machine (tests use a fake clock), call it before Start
+func (sm *StateMachine) SetClock(c clock.Clock) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.clock = c
+}
+
+// Clock returns the clock of the state machine
+func (sm *StateMachine) Clock() clock.Clock {
+	return sm.clock
+}
+
+// SetSnapshotRepository enables checkpointing of every phase transition and recovery on Start
+func (sm *StateMachine) SetSnapshotRepository(repo domain.RoundSnapshotRepository) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.snapshotRepo = repo
+}
+
+// SetResultProvider replaces the source of round results (RNG by default)
+func (sm *StateMachine) SetResultProvider(provider ResultProvider) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.resultProvider = provider
+}
+
+// SetBettingClosedHandler sets the hook the bet stores close the betting of a round with.
+// It runs on the round loop when betting ends, late bet changes are then rejected by the stores themselves
+// instead of by the phase of this instance (followers and in-flight requests see a stale phase).
+func (sm *StateMachine) SetBettingClosedHandler(handler func(ctx context.Context, roundID string)) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.bettingClosed = handler
+}
+
+// SubmitResult delivers the operator submitted dice to the active provider (live dealer tables).
+// Only the instance running rounds waits for a result, a follower returns ErrNotRunning.
+func (sm *StateMachine) SubmitResult(roundID string, dice []domain.Color) error {
+	sm.mu.RLock()
+	provider := sm.resultProvider
+	running := sm.running
+	sm.mu.RUnlock()
+
+	if !running {
+		return ErrNotRunning
+	}
+
+	submitter, ok := provider.(ResultSubmitter)
+	if !ok {
+		return ErrManualResultNotSupported
 	}
+	return submitter.Submit(roundID, dice)
+}
+
+// SetFencingToken sets the leadership term written into every snapshot, call it before Start
+func (sm *StateMachine) SetFencingToken(token int64) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.fencingToken = token
+}
+
+// IsRunning reports whether this instance is currently running rounds (i.e. is the leader)
+func (sm *StateMachine) IsRunning() bool {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+	return sm.running
 }
 
 // WaitForDone blocks until the StateMachine has completely stopped
 func (sm *StateMachine) WaitForDone() {
-	<-sm.doneChan
+	sm.mu.RLock()
+	done := sm.doneChan
+	sm.mu.RUnlock()
+	<-done
 }
 
 // GracefulShutdown signals the state machine to stop and waits for it to finish.
@@ -104,81 +248,159 @@ func (sm *StateMachine) RegisterEventHandler(handler EventHandler) {
 	sm.mu.Lock()
 	defer sm.mu.Unlock()
 	sm.eventHandlers = append(sm.eventHandlers, handler)
+	if sm.running {
+		sm.handlerQueues = append(sm.handlerQueues, sm.startWorker(handler))
+	}
+}
+
+// handlerQueue delivers the events of one handler in the order they were emitted
+type handlerQueue struct {
+	handler EventHandler
+	events  chan GameEvent
 }
 
-// emitEvent emits an event to all handlers via worker pool
+// emitEvent sequences an event, records it for resynchronisation and queues it for every handler.
+// Each handler has its own queue and worker, so a handler never sees BETTING after DRAWING.
+// It never waits for a handler: a handler whose queue is full misses the event, gateways notice the gap
+// in Seq and resync from the recent events (EventsSince).
 func (sm *StateMachine) emitEvent(event GameEvent) {
-	sm.mu.RLock()
-	handlers := make([]EventHandler, len(sm.eventHandlers))
-	copy(handlers, sm.eventHandlers)
-	sm.mu.RUnlock()
+	sm.emitMu.Lock()
+	defer sm.emitMu.Unlock()
 
-	for _, handler := range handlers {
-		// Capture closure variables
-		h := handler
-		evt := event
+	sm.mu.Lock()
+	if event.RoundID != "" && event.RoundID != sm.seqRoundID {
+		sm.seqRoundID = event.RoundID
+		sm.eventSeq = 0
+	}
+	sm.eventSeq++
+	event.Seq = sm.eventSeq
+	event.TableID = sm.TableID
+	event.Time = sm.clock.Now()
+	sm.recentEvents.add(sm.seqRoundID, event)
+	queues := make([]*handlerQueue, len(sm.handlerQueues))
+	copy(queues, sm.handlerQueues)
+	sm.mu.Unlock()
 
+	for _, q := range queues {
 		select {
-		case sm.jobQueue <- func() { h(evt) }:
-			// Job submitted successfully
+		case q.events <- event:
+			// Event queued successfully
 		default:
-			// Queue is full, degrade to spawning a goroutine directly
+			// Queue is full: drop the event for the slow handler instead of holding back the round
 			logger.Warn(context.Background()).
 				Str("round_id", event.RoundID).
 				Str("event_type", event.Type.String()).
-				Msg("⚠️ [GMS] Job queue full, degrading to goroutine")
-			go h(evt)
+				Int64("seq", event.Seq).
+				Msg("⚠️ [GMS] Handler queue full, event dropped for slow handler")
 		}
 	}
 }
 
-// startWorkers starts the worker pool
-func (sm *StateMachine) startWorkers() {
-	workerCount := 5
-	for i := 0; i < workerCount; i++ {
-		sm.workerWg.Add(1)
-		go func(workerID int) {
-			defer sm.workerWg.Done()
-			for job := range sm.jobQueue {
-				// Execute job with panic recovery
-				func() {
-					defer func() {
-						if r := recover(); r != nil {
-							logger.Error(context.Background()).
-								Interface("panic", r).
-								Int("worker_id", workerID).
-								Msg("🔥 [GMS] Worker panic recovered")
-						}
-					}()
-					job()
-				}()
-			}
-		}(i)
+// EventsSince returns the recent events after (roundID, afterSeq) in emission order.
+// complete is false when some of the events in between are no longer buffered (or roundID is empty),
+// the caller then resynchronises from the current round instead.
+func (sm *StateMachine) EventsSince(roundID string, afterSeq int64) (events []GameEvent, complete bool) {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+	return sm.recentEvents.since(roundID, afterSeq)
+}
+
+// SetEventBufferSize sets how many recent events are kept for resynchronisation, call it before Start
+func (sm *StateMachine) SetEventBufferSize(size int) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	sm.recentEvents = newEventBuffer(size)
+}
+
+// startWorker starts the worker delivering the events of one handler, must be called with sm.mu held
+func (sm *StateMachine) startWorker(handler EventHandler) *handlerQueue {
+	q := &handlerQueue{
+		handler: handler,
+		events:  make(chan GameEvent, 100), // Buffered event queue
 	}
+
+	sm.workerWg.Add(1)
+	go func() {
+		defer sm.workerWg.Done()
+		for event := range q.events {
+			// Execute handler with panic recovery
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						logger.Error(context.Background()).
+							Interface("panic", r).
+							Str("round_id", event.RoundID).
+							Str("event_type", event.Type.String()).
+							Msg("🔥 [GMS] Event handler panic recovered")
+					}
+				}()
+				q.handler(event)
+			}()
+		}
+	}()
+	return q
 }
 
-// shutdownWorkers stops key workers
+// shutdownWorkers delivers the queued events and stops the workers
 func (sm *StateMachine) shutdownWorkers() {
-	close(sm.jobQueue)
+	sm.emitMu.Lock()
+	defer sm.emitMu.Unlock()
+
+	sm.mu.Lock()
+	queues := sm.handlerQueues
+	sm.handlerQueues = nil
+	sm.mu.Unlock()
+
+	for _, q := range queues {
+		close(q.events)
+	}
 	sm.workerWg.Wait()
 }
 
-// Stop signals the state machine to stop after the current round
+// Stop signals the state machine to stop after the current round, it will not start again
 func (sm *StateMachine) Stop() {
 	sm.mu.Lock()
-	defer sm.mu.Unlock()
 	sm.stopping = true
+	sm.mu.Unlock()
+
+	// A paused table stops without resuming
+	sm.wakeUp()
 }
 
-// Start starts the state machine loop
+// Start starts the state machine loop and blocks until it stops.
+// It can be called again after it returned (e.g. on every new leadership term), unless Stop was called.
 func (sm *StateMachine) Start(ctx context.Context) {
-	logger.Info(ctx).Msg("🚀 [GMS] State Machine Started (Worker Pool Active)")
-	defer close(sm.doneChan)
-
+	sm.mu.Lock()
+	if sm.running || sm.stopping {
+		sm.mu.Unlock()
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	sm.running = true
+	sm.cancelRun = cancel
+	sm.doneChan = done
 	// Start workers
-	sm.startWorkers()
+	for _, handler := range sm.eventHandlers {
+		sm.handlerQueues = append(sm.handlerQueues, sm.startWorker(handler))
+	}
+	sm.mu.Unlock()
+
+	logger.Info(ctx).Str("table_id", sm.TableID).Msg("🚀 [GMS] State Machine Started (Event Workers Active)")
+	defer func() {
+		cancel()
+		sm.mu.Lock()
+		sm.running = false
+		sm.cancelRun = nil
+		sm.mu.Unlock()
+		close(done)
+	}()
+
 	defer sm.shutdownWorkers()
 
+	// Finish the round left behind by a crash before starting new ones
+	sm.recoverRound(ctx)
+
 	for {
 		// Check context for FORCE stop
 		select {
@@ -204,6 +426,17 @@ func (sm *StateMachine) Start(ctx context.Context) {
 			return
 		}
 
+		// A paused table does not start new rounds until resumed
+		if !sm.waitWhilePaused(ctx) {
+			continue
+		}
+		sm.mu.RLock()
+		stopping = sm.stopping
+		sm.mu.RUnlock()
+		if stopping {
+			continue
+		}
+
 		// Run the full round
 		sm.runRound(ctx)
 
@@ -212,164 +445,505 @@ func (sm *StateMachine) Start(ctx context.Context) {
 	}
 }
 
-// sleepWithContext sleeps for duration d, or until ctx is cancelled
-func sleepWithContext(ctx context.Context, d time.Duration) {
-	select {
-	case <-time.After(d):
-	case <-ctx.Done():
-	}
+// sleep sleeps for duration d on the clock of the state machine, or until ctx is cancelled
+func (sm *StateMachine) sleep(ctx context.Context, d time.Duration) {
+	clock.Sleep(sm.clock, d, ctx.Done())
 }
 
 // runRound executes a single round
 func (sm *StateMachine) runRound(ctx context.Context) {
-	// ... (rest of the function using sleepWithContext)
 	sm.mu.Lock()
 	sm.roundCounter++
+	sm.voidReason = ""
 	roundID := sm.generateRoundID()
-	sm.currentRound = domain.NewRound(roundID)
+	sm.currentRound = domain.NewRound(roundID, sm.clock.Now())
 	round := sm.currentRound
+	roundCounter := sm.roundCounter
+	committer, commitSeed := sm.resultProvider.(SeedCommitter)
 	sm.mu.Unlock()
 
+	if commitSeed {
+		sm.mu.Lock()
+		err := committer.CommitSeed(round)
+		sm.mu.Unlock()
+		if err != nil {
+			// Without a commitment the result cannot be proven, the provider voids the round at drawing
+			logger.Error(ctx).Err(err).Str("round_id", roundID).Msg("❌ [GMS] Failed to commit server seed")
+		}
+	}
+
 	logger.Info(ctx).
+		Str("table_id", sm.TableID).
 		Str("round_id", roundID).
-		Int("round_counter", sm.roundCounter).
+		Int("round_counter", roundCounter).
 		Msg("🔄 [GMS] 回合開始 (Round Started)")
 
+	sm.playRound(ctx, round)
+}
+
+// playRound runs every phase of a round that has not opened betting yet
+func (sm *StateMachine) playRound(ctx context.Context, round *domain.Round) {
+	if !sm.runRoundStarted(ctx, round, sm.WaitDuration) {
+		return
+	}
+	if !sm.runBetting(ctx, round, sm.BettingDuration) {
+		return
+	}
+	sm.finishRound(ctx, round, sm.DrawingDuration)
+}
+
+// finishRound runs the phases after betting has closed: drawing, result and rest
+func (sm *StateMachine) finishRound(ctx context.Context, round *domain.Round, drawingDuration time.Duration) {
+	if !sm.runDrawing(ctx, round, drawingDuration) {
+		return
+	}
+	if !sm.runResult(ctx, round, sm.ResultDuration) {
+		return
+	}
+	sm.runRoundEnded(ctx, round)
+}
+
+// runRoundStarted runs the waiting phase before betting, returns false if the context was cancelled or the round was voided
+func (sm *StateMachine) runRoundStarted(ctx context.Context, round *domain.Round, d time.Duration) bool {
+	sm.mu.Lock()
+	sm.phaseEndTime = sm.clock.Now().Add(d)
+	sm.mu.Unlock()
+	if !sm.checkpoint(ctx) {
+		return false
+	}
+
 	sm.emitEvent(GameEvent{
 		Type:                pbColorGame.ColorGameState_GAME_STATE_ROUND_STARTED,
-		RoundID:             roundID,
-		LeftTime:            int64(sm.WaitDuration.Seconds()),
+		RoundID:             round.RoundID,
+		ServerSeedHash:      round.ServerSeedHash,
+		ClientSeed:          round.ClientSeed,
+		LeftTime:            int64(d.Seconds()),
 		BettingEndTimestamp: 0,
 	})
 
-	sleepWithContext(ctx, sm.WaitDuration)
-	if ctx.Err() != nil {
-		return
-	}
+	return sm.waitPhase(ctx, round, nil)
+}
 
-	//--------------------------------------------
-	// Betting phase
-	//--------------------------------------------
+// runBetting runs the betting phase, returns false if the context was cancelled or the round was voided.
+// Operators may extend or shorten the phase, the new betting end is announced again.
+func (sm *StateMachine) runBetting(ctx context.Context, round *domain.Round, d time.Duration) bool {
 	sm.mu.Lock()
-	round.StartBetting(sm.BettingDuration)
+	round.StartBetting(sm.clock.Now(), d)
 	bettingEnd := round.BettingEnd
 	sm.phaseEndTime = bettingEnd
 	sm.mu.Unlock()
+	if !sm.checkpoint(ctx) {
+		return false
+	}
 
 	logger.Info(ctx).
-		Str("round_id", roundID).
+		Str("round_id", round.RoundID).
 		Time("betting_end", bettingEnd).
-		Dur("duration", sm.BettingDuration).
+		Dur("duration", d).
 		Msg("🟢 [GMS] 開始下注 (Betting Started)")
 
 	sm.emitEvent(GameEvent{
 		Type:                pbColorGame.ColorGameState_GAME_STATE_BETTING,
-		RoundID:             roundID,
+		RoundID:             round.RoundID,
+		ServerSeedHash:      round.ServerSeedHash,
+		ClientSeed:          round.ClientSeed,
 		Data:                bettingEnd,
-		LeftTime:            int64(sm.BettingDuration.Seconds()),
+		LeftTime:            int64(d.Seconds()),
 		BettingEndTimestamp: bettingEnd.Unix(),
 	})
 
-	sleepWithContext(ctx, sm.BettingDuration)
-	if ctx.Err() != nil {
-		return
-	}
+	return sm.waitPhase(ctx, round, func(end time.Time) bool {
+		if !sm.checkpoint(ctx) {
+			return false
+		}
 
-	//--------------------------------------------
-	// Drawing phase
-	//--------------------------------------------
-	result := sm.drawResult()
+		logger.Info(ctx).
+			Str("round_id", round.RoundID).
+			Time("betting_end", end).
+			Msg("⏱️ [GMS] 下注時間調整 (Betting Adjusted)")
+
+		sm.emitEvent(GameEvent{
+			Type:                pbColorGame.ColorGameState_GAME_STATE_BETTING,
+			RoundID:             round.RoundID,
+			ServerSeedHash:      round.ServerSeedHash,
+			ClientSeed:          round.ClientSeed,
+			Data:                end,
+			LeftTime:            leftSeconds(sm.clock.Now(), end),
+			BettingEndTimestamp: end.Unix(),
+		})
+		return true
+	})
+}
 
+// runDrawing runs the drawing phase, returns false if the context was cancelled or the round was voided.
+// The phase lasts at least d; a blocking provider (live dealer) extends it until the result is submitted.
+func (sm *StateMachine) runDrawing(ctx context.Context, round *domain.Round, d time.Duration) bool {
 	sm.mu.Lock()
-	round.Draw(result)
-	sm.phaseEndTime = time.Now().Add(sm.DrawingDuration)
+	round.State = pbColorGame.ColorGameState_GAME_STATE_DRAWING
+	sm.phaseEndTime = sm.clock.Now().Add(d)
+	provider := sm.resultProvider
+	bettingClosed := sm.bettingClosed
 	sm.mu.Unlock()
 
+	// Close betting in the bet stores before the drawing is persisted, a recovered DRAWING round was closed already
+	if bettingClosed != nil {
+		bettingClosed(ctx, round.RoundID)
+	}
+	if !sm.checkpoint(ctx) {
+		return false
+	}
+
 	logger.Info(ctx).
-		Str("round_id", roundID).
-		Str("result_color", result.String()).
-		Dur("duration", sm.DrawingDuration).
+		Str("round_id", round.RoundID).
+		Dur("duration", d).
 		Msg("🎲 [GMS] 停止下注，正在開獎 (Drawing)")
 
 	sm.emitEvent(GameEvent{
 		Type:                pbColorGame.ColorGameState_GAME_STATE_DRAWING,
-		RoundID:             roundID,
-		Data:                result,
-		LeftTime:            int64(sm.DrawingDuration.Seconds()),
+		RoundID:             round.RoundID,
+		ServerSeedHash:      round.ServerSeedHash,
+		ClientSeed:          round.ClientSeed,
+		LeftTime:            int64(d.Seconds()),
 		BettingEndTimestamp: round.BettingEnd.Unix(),
 	})
 
-	sleepWithContext(ctx, sm.DrawingDuration)
-	if ctx.Err() != nil {
-		return
+	// A result persisted before a restart is never drawn again
+	if round.Result == pbColorGame.ColorGameReward_REWARD_UNSPECIFIED {
+		// An operator void cancels a provider that is still waiting for the result,
+		// a void requested before the draw (or restored by recovery) cancels it right away
+		drawCtx, cancelDraw := context.WithCancel(ctx)
+		sm.mu.Lock()
+		sm.cancelDraw = cancelDraw
+		voidRequested := sm.voidReason != ""
+		sm.mu.Unlock()
+		if voidRequested {
+			cancelDraw()
+		}
+
+		dice, err := provider.Draw(drawCtx, round)
+
+		sm.mu.Lock()
+		sm.cancelDraw = nil
+		sm.mu.Unlock()
+		cancelDraw()
+
+		if ctx.Err() != nil {
+			return false
+		}
+		if reason := sm.takeVoidRequest(); reason != "" {
+			sm.voidRound(ctx, round, reason)
+			return false
+		}
+		if err != nil {
+			sm.voidRound(ctx, round, "draw failed: "+err.Error())
+			return false
+		}
+
+		sm.mu.Lock()
+		round.Draw(dice)
+		sm.mu.Unlock()
+		if !sm.checkpoint(ctx) {
+			return false
+		}
 	}
 
-	//--------------------------------------------
-	// Result phase
-	//--------------------------------------------
+	logger.Info(ctx).
+		Str("round_id", round.RoundID).
+		Str("result_color", round.Result.String()).
+		Str("dice", domain.FormatResult(round.Dice)).
+		Msg("🎯 [GMS] 開獎結果 (Result Drawn)")
+
+	return sm.waitPhase(ctx, round, nil)
+}
+
+// runResult runs the result phase, returns false if the context was cancelled or the round was voided
+func (sm *StateMachine) runResult(ctx context.Context, round *domain.Round, d time.Duration) bool {
 	sm.mu.Lock()
+	// A void requested at the end of drawing wins over the result, which was not announced yet
+	if reason := sm.voidReason; reason != "" {
+		sm.voidReason = ""
+		sm.mu.Unlock()
+		sm.voidRound(ctx, round, reason)
+		return false
+	}
 	round.ShowResult()
-	sm.phaseEndTime = time.Now().Add(sm.ResultDuration)
+	dice := round.Dice
+	sm.phaseEndTime = sm.clock.Now().Add(d)
 	sm.mu.Unlock()
+	if !sm.checkpoint(ctx) {
+		return false
+	}
 
 	logger.Info(ctx).
-		Str("round_id", roundID).
-		Str("final_result", result.String()).
-		Dur("duration", sm.ResultDuration).
+		Str("round_id", round.RoundID).
+		Str("final_result", domain.FormatResult(dice)).
+		Dur("duration", d).
 		Msg("📊 [GMS] 公布結果 (Show Result)")
 
 	sm.emitEvent(GameEvent{
 		Type:                pbColorGame.ColorGameState_GAME_STATE_RESULT,
-		RoundID:             roundID,
-		Data:                result,
-		LeftTime:            int64(sm.ResultDuration.Seconds()),
+		RoundID:             round.RoundID,
+		ServerSeedHash:      round.ServerSeedHash,
+		ClientSeed:          round.ClientSeed,
+		ServerSeed:          round.ServerSeed,
+		Data:                dice,
+		LeftTime:            int64(d.Seconds()),
 		BettingEndTimestamp: round.BettingEnd.Unix(),
 	})
 
-	sleepWithContext(ctx, sm.ResultDuration)
-	if ctx.Err() != nil {
-		return
-	}
+	sm.sleep(ctx, d)
+	return ctx.Err() == nil
+}
 
-	//--------------------------------------------
-	// Round Ended phase (Rest)
-	//--------------------------------------------
+// runRoundEnded runs the rest phase between rounds
+func (sm *StateMachine) runRoundEnded(ctx context.Context, round *domain.Round) {
 	logger.Info(ctx).
-		Str("round_id", roundID).
+		Str("round_id", round.RoundID).
 		Msg("🏁 [GMS] 回合結束 (Round Ended)")
 
+	sm.mu.Lock()
+	round.State = pbColorGame.ColorGameState_GAME_STATE_ROUND_ENDED
+	sm.phaseEndTime = sm.clock.Now().Add(sm.RestDuration)
+	sm.mu.Unlock()
+	if !sm.checkpoint(ctx) {
+		return
+	}
+
 	sm.emitEvent(GameEvent{
 		Type:                pbColorGame.ColorGameState_GAME_STATE_ROUND_ENDED,
-		RoundID:             roundID,
+		RoundID:             round.RoundID,
+		ServerSeedHash:      round.ServerSeedHash,
+		ClientSeed:          round.ClientSeed,
+		ServerSeed:          round.ServerSeed,
 		Data:                nil,
 		LeftTime:            int64(sm.RestDuration.Seconds()),
 		BettingEndTimestamp: round.BettingEnd.Unix(),
 	})
 
+	sm.sleep(ctx, sm.RestDuration)
+}
+
+// voidRound abandons a round without a result, all bets of the round must be refunded
+func (sm *StateMachine) voidRound(ctx context.Context, round *domain.Round, reason string) {
 	sm.mu.Lock()
-	sm.phaseEndTime = time.Now().Add(sm.RestDuration)
+	round.Void()
+	sm.phaseEndTime = sm.clock.Now().Add(sm.RestDuration)
 	sm.mu.Unlock()
+	if !sm.checkpoint(ctx) {
+		return
+	}
+
+	logger.Warn(ctx).
+		Str("round_id", round.RoundID).
+		Str("reason", reason).
+		Msg("⛔ [GMS] 回合作廢 (Round Voided)")
+
+	var bettingEndTimestamp int64
+	if !round.BettingEnd.IsZero() {
+		bettingEndTimestamp = round.BettingEnd.Unix()
+	}
+
+	sm.emitEvent(GameEvent{
+		Type:                pbColorGame.ColorGameState_GAME_STATE_VOIDED,
+		RoundID:             round.RoundID,
+		ServerSeedHash:      round.ServerSeedHash,
+		ClientSeed:          round.ClientSeed,
+		ServerSeed:          round.ServerSeed,
+		Data:                reason,
+		LeftTime:            int64(sm.RestDuration.Seconds()),
+		BettingEndTimestamp: bettingEndTimestamp,
+	})
+
+	sm.sleep(ctx, sm.RestDuration)
+}
+
+// checkpoint persists the current round so that it can be recovered after a crash or failover.
+// It returns false if a newer leader owns the snapshot; the run is then cancelled and nothing may be emitted.
+func (sm *StateMachine) checkpoint(ctx context.Context) bool {
+	if sm.snapshotRepo == nil {
+		return true
+	}
+
+	sm.checkpointMu.Lock()
+	defer sm.checkpointMu.Unlock()
+
+	sm.mu.RLock()
+	if sm.currentRound == nil {
+		sm.mu.RUnlock()
+		return true
+	}
+	r := sm.currentRound
+	snapshot := &domain.RoundSnapshot{
+		RoundID:      r.RoundID,
+		State:        r.State,
+		Result:       r.Result,
+		Dice:         r.Dice,
+		StartTime:    r.StartTime,
+		BettingEnd:   r.BettingEnd,
+		PhaseEndTime: sm.phaseEndTime,
+		RoundCounter: sm.roundCounter,
+		EventSeq:     sm.roundEventSeq(r.RoundID),
+		UpdatedAt:    sm.clock.Now(),
+		FencingToken: sm.fencingToken,
+
+		ServerSeed:     r.ServerSeed,
+		ServerSeedHash: r.ServerSeedHash,
+		ClientSeed:     r.ClientSeed,
+
+		Paused:     sm.paused,
+		VoidReason: sm.voidReason,
+	}
+	sm.mu.RUnlock()
+
+	err := sm.snapshotRepo.Save(ctx, snapshot)
+	if errors.Is(err, domain.ErrFencedOut) {
+		logger.Warn(ctx).
+			Str("round_id", snapshot.RoundID).
+			Int64("fencing_token", snapshot.FencingToken).
+			Msg("⛔ [GMS] Snapshot fenced out by a newer leader, stopping")
+		sm.mu.RLock()
+		cancel := sm.cancelRun
+		sm.mu.RUnlock()
+		if cancel != nil {
+			cancel()
+		}
+		return false
+	}
+	if err != nil {
+		logger.Error(ctx).
+			Err(err).
+			Str("round_id", snapshot.RoundID).
+			Str("state", snapshot.State.String()).
+			Msg("❌ [GMS] Failed to save round snapshot")
+	}
+	return true
+}
 
-	sleepWithContext(ctx, sm.RestDuration)
+// roundEventSeq returns the last sequence number emitted for a round, must be called with sm.mu held
+func (sm *StateMachine) roundEventSeq(roundID string) int64 {
+	if sm.seqRoundID != roundID {
+		return 0
+	}
+	return sm.eventSeq
 }
 
-// drawResult simulates drawing a result
-func (sm *StateMachine) drawResult() domain.Color {
-	colors := []domain.Color{
-		pbColorGame.ColorGameReward_REWARD_RED,
-		pbColorGame.ColorGameReward_REWARD_GREEN,
-		pbColorGame.ColorGameReward_REWARD_BLUE,
-		pbColorGame.ColorGameReward_REWARD_YELLOW,
+// recoverRound finishes the round left behind by a previous run, if any.
+//
+// Recovery is deterministic and never redraws a result that was already drawn:
+//   - ROUND_STARTED: betting never opened, the round is replayed from the beginning
+//   - BETTING: betting resumes until the original betting end, then the round is drawn
+//   - DRAWING: a persisted result is kept, otherwise the provider draws (again)
+//   - RESULT: the persisted result is announced again so GS can settle
+//   - snapshots older than MaxRecoveryAge are voided and every bet is refunded
+//   - a paused table stays paused and a void requested by an operator is carried out
+func (sm *StateMachine) recoverRound(ctx context.Context) {
+	if sm.snapshotRepo == nil {
+		return
+	}
+
+	snapshot, err := sm.snapshotRepo.Load(ctx)
+	if err != nil {
+		logger.Error(ctx).Err(err).Msg("❌ [GMS] Failed to load round snapshot, skipping recovery")
+		return
+	}
+	if snapshot == nil {
+		return
+	}
+
+	// The operator controls of the previous leader still apply
+	sm.mu.Lock()
+	sm.roundCounter = snapshot.RoundCounter
+	sm.paused = snapshot.Paused
+	sm.mu.Unlock()
+
+	if snapshot.IsFinished() {
+		return
+	}
+
+	round := &domain.Round{
+		RoundID:    snapshot.RoundID,
+		State:      snapshot.State,
+		Result:     snapshot.Result,
+		Dice:       snapshot.DrawnDice(),
+		StartTime:  snapshot.StartTime,
+		BettingEnd: snapshot.BettingEnd,
+
+		ServerSeed:     snapshot.ServerSeed,
+		ServerSeedHash: snapshot.ServerSeedHash,
+		ClientSeed:     snapshot.ClientSeed,
+	}
+	sm.mu.Lock()
+	sm.currentRound = round
+	sm.phaseEndTime = snapshot.PhaseEndTime
+	// Events re-announced after recovery keep the sequence of the round
+	sm.seqRoundID = snapshot.RoundID
+	sm.eventSeq = snapshot.EventSeq
+	sm.voidReason = snapshot.VoidReason
+	sm.mu.Unlock()
+
+	age := sm.clock.Now().Sub(snapshot.UpdatedAt)
+	logger.Warn(ctx).
+		Str("round_id", snapshot.RoundID).
+		Str("state", snapshot.State.String()).
+		Dur("snapshot_age", age).
+		Msg("♻️ [GMS] 發現未完成回合，開始恢復 (Recovering Round)")
+
+	if age > sm.MaxRecoveryAge {
+		sm.voidRound(ctx, round, "recovery: snapshot expired")
+		return
+	}
+
+	switch snapshot.State {
+	case pbColorGame.ColorGameState_GAME_STATE_ROUND_STARTED:
+		sm.playRound(ctx, round)
+
+	case pbColorGame.ColorGameState_GAME_STATE_BETTING:
+		if remaining := snapshot.BettingEnd.Sub(sm.clock.Now()); remaining > 0 {
+			if !sm.runBetting(ctx, round, remaining) {
+				return
+			}
+		}
+		sm.finishRound(ctx, round, sm.DrawingDuration)
+
+	case pbColorGame.ColorGameState_GAME_STATE_DRAWING:
+		remaining := snapshot.PhaseEndTime.Sub(sm.clock.Now())
+		if remaining < 0 {
+			remaining = 0
+		}
+		sm.finishRound(ctx, round, remaining)
+
+	case pbColorGame.ColorGameState_GAME_STATE_RESULT:
+		if !sm.runResult(ctx, round, sm.ResultDuration) {
+			return
+		}
+		sm.runRoundEnded(ctx, round)
+
+	default:
+		sm.voidRound(ctx, round, "recovery: unknown state "+snapshot.State.String())
 	}
-	return colors[sm.rnd.Intn(len(colors))]
 }
 
+// generateRoundID creates the round ID from the table and the round counter (see color_game.NewRoundID)
 func (sm *StateMachine) generateRoundID() string {
-	return time.Now().Format("20060102150405")
+	return color_game.NewRoundID(color_game.GameCode, sm.TableID, sm.clock.Now(), int64(sm.roundCounter))
 }
 
 // GetCurrentRound returns a snapshot of the current round (thread-safe)
 func (sm *StateMachine) GetCurrentRound() RoundView {
+	if round, phaseEnd, ok := sm.followerRound(); ok {
+		if round == nil {
+			return RoundView{}
+		}
+		return RoundView{
+			RoundID:    round.RoundID,
+			State:      round.State,
+			Result:     round.Result,
+			StartTime:  round.StartTime,
+			BettingEnd: round.BettingEnd,
+			LeftTime:   leftSeconds(sm.clock.Now(), phaseEnd),
+		}
+	}
+
 	sm.mu.RLock()
 	defer sm.mu.RUnlock()
 
@@ -378,11 +952,6 @@ func (sm *StateMachine) GetCurrentRound() RoundView {
 	}
 
 	r := sm.currentRound
-	leftTime := int64(time.Until(sm.phaseEndTime).Seconds())
-	if leftTime < 0 {
-		leftTime = 0
-	}
-
 	return RoundView{
 		RoundID:    r.RoundID,
 		State:      r.State,
@@ -390,17 +959,62 @@ func (sm *StateMachine) GetCurrentRound() RoundView {
 		StartTime:  r.StartTime,
 		BettingEnd: r.BettingEnd,
 		TotalBets:  r.TotalBets,
-		LeftTime:   leftTime,
+		LeftTime:   leftSeconds(sm.clock.Now(), sm.phaseEndTime),
 	}
 }
 
 // CanAcceptBet checks if current round can accept bets
 func (sm *StateMachine) CanAcceptBet() bool {
+	if round, _, ok := sm.followerRound(); ok {
+		return round != nil && round.CanAcceptBet(sm.clock.Now())
+	}
+
 	sm.mu.RLock()
 	defer sm.mu.RUnlock()
 
 	if sm.currentRound == nil {
 		return false
 	}
-	return sm.currentRound.CanAcceptBet()
+	return sm.currentRound.CanAcceptBet(sm.clock.Now())
+}
+
+// followerRound returns the leader's round from the shared snapshot when this instance is not running rounds.
+// ok is false when the local state machine is authoritative (running, or no shared store configured).
+func (sm *StateMachine) followerRound() (round *domain.Round, phaseEnd time.Time, ok bool) {
+	sm.mu.RLock()
+	running := sm.running
+	repo := sm.snapshotRepo
+	sm.mu.RUnlock()
+
+	if running || repo == nil {
+		return nil, time.Time{}, false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	snapshot, err := repo.Load(ctx)
+	if err != nil {
+		logger.Warn(ctx).Err(err).Msg("⚠️ [GMS] Failed to load shared round snapshot")
+		return nil, time.Time{}, true
+	}
+	if snapshot == nil {
+		return nil, time.Time{}, true
+	}
+
+	return &domain.Round{
+		RoundID:    snapshot.RoundID,
+		State:      snapshot.State,
+		Result:     snapshot.Result,
+		StartTime:  snapshot.StartTime,
+		BettingEnd: snapshot.BettingEnd,
+	}, snapshot.PhaseEndTime, true
+}
+
+func leftSeconds(now time.Time, phaseEnd time.Time) int64 {
+	left := int64(phaseEnd.Sub(now).Seconds())
+	if left < 0 {
+		return 0
+	}
+	return left
 }
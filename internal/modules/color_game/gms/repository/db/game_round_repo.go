@@ -2,6 +2,7 @@ package db
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
@@ -40,3 +41,57 @@ func (r *GameRoundRepository) UpdateResult(ctx context.Context, roundID string,
 		Where("round_id = ?", roundID).
 		Updates(updates).Error
 }
+
+func (r *GameRoundRepository) MarkVoided(ctx context.Context, roundID string, endTime *time.Time) error {
+	updates := map[string]interface{}{
+		"status":     domain.RoundStatusVoided,
+		"updated_at": time.Now(),
+	}
+	if endTime != nil {
+		updates["end_time"] = endTime
+	}
+	return r.db.WithContext(ctx).Model(&domain.GameRound{}).
+		Where("round_id = ?", roundID).
+		Updates(updates).Error
+}
+
+func (r *GameRoundRepository) RevealServerSeed(ctx context.Context, roundID string, serverSeed string) error {
+	return r.db.WithContext(ctx).Model(&domain.GameRound{}).
+		Where("round_id = ?", roundID).
+		Updates(map[string]interface{}{
+			"server_seed": serverSeed,
+			"updated_at":  time.Now(),
+		}).Error
+}
+
+func (r *GameRoundRepository) GetByRoundID(ctx context.Context, roundID string) (*domain.GameRound, error) {
+	var round domain.GameRound
+	err := r.db.WithContext(ctx).Where("round_id = ?", roundID).First(&round).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &round, nil
+}
+
+func (r *GameRoundRepository) ListRecent(ctx context.Context, tableID string, limit int) ([]*domain.GameRound, error) {
+	var rounds []*domain.GameRound
+	err := r.db.WithContext(ctx).
+		Where("table_id = ? AND status IN ?", tableID, []domain.RoundStatus{domain.RoundStatusEnded, domain.RoundStatusVoided}).
+		Order("start_time DESC").
+		Limit(limit).
+		Find(&rounds).Error
+	return rounds, err
+}
+
+func (r *GameRoundRepository) ListByTimeRange(ctx context.Context, tableID string, from, to time.Time, limit int) ([]*domain.GameRound, error) {
+	query := r.db.WithContext(ctx).Where("start_time >= ? AND start_time < ?", from, to)
+	if tableID != "" {
+		query = query.Where("table_id = ?", tableID)
+	}
+	var rounds []*domain.GameRound
+	err := query.Order("start_time DESC").Limit(limit).Find(&rounds).Error
+	return rounds, err
+}
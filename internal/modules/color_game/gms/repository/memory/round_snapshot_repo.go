@@ -0,0 +1,52 @@
+// Package memory provides memory-based repositories for the color game GMS module.
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+)
+
+// RoundSnapshotRepository implements domain.RoundSnapshotRepository using memory.
+// It only survives a restart of the state machine, not of the process (useful for tests).
+type RoundSnapshotRepository struct {
+	snapshot *domain.RoundSnapshot
+	mu       sync.RWMutex
+}
+
+// NewRoundSnapshotRepository creates a new memory snapshot repository
+func NewRoundSnapshotRepository() *RoundSnapshotRepository {
+	return &RoundSnapshotRepository{}
+}
+
+func (r *RoundSnapshotRepository) Save(ctx context.Context, snapshot *domain.RoundSnapshot) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.snapshot != nil && snapshot.FencingToken < r.snapshot.FencingToken {
+		return domain.ErrFencedOut
+	}
+	copied := *snapshot
+	r.snapshot = &copied
+	return nil
+}
+
+func (r *RoundSnapshotRepository) Load(ctx context.Context) (*domain.RoundSnapshot, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.snapshot == nil {
+		return nil, nil
+	}
+	copied := *r.snapshot
+	return &copied, nil
+}
+
+func (r *RoundSnapshotRepository) Clear(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.snapshot = nil
+	return nil
+}
@@ -0,0 +1,178 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+)
+
+// roundStatsTTL evicts rounds that were never persisted nor voided (e.g. lost events)
+const roundStatsTTL = time.Hour
+
+type roundStats struct {
+	bets      int
+	players   map[int64]struct{}
+	amount    int64
+	pools     map[domain.Color]*colorPool
+	closed    bool
+	updatedAt time.Time
+}
+
+type colorPool struct {
+	amount  int64
+	bettors map[int64]struct{}
+}
+
+// RoundStatsRepository implements domain.RoundStatsRepository using memory.
+// It is only correct for a single GMS process, use the Redis implementation for replicas.
+type RoundStatsRepository struct {
+	rounds    map[string]*roundStats
+	lastSweep time.Time
+	mu        sync.Mutex
+}
+
+// NewRoundStatsRepository creates a new memory round stats repository
+func NewRoundStatsRepository() *RoundStatsRepository {
+	return &RoundStatsRepository{
+		rounds:    make(map[string]*roundStats),
+		lastSweep: time.Now(),
+	}
+}
+
+func (r *RoundStatsRepository) RecordBet(ctx context.Context, roundID string, userID int64, color domain.Color, amount int64) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.sweepLocked(now)
+
+	stats := r.roundLocked(roundID)
+	stats.bets++
+	stats.players[userID] = struct{}{}
+	stats.amount += amount
+
+	pool, ok := stats.pools[color]
+	if !ok {
+		pool = &colorPool{bettors: make(map[int64]struct{})}
+		stats.pools[color] = pool
+	}
+	pool.amount += amount
+	pool.bettors[userID] = struct{}{}
+	stats.updatedAt = now
+	return stats.bets, nil
+}
+
+func (r *RoundStatsRepository) CancelBet(ctx context.Context, roundID string, color domain.Color, amount int64, removed bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats, ok := r.rounds[roundID]
+	if !ok {
+		return nil
+	}
+	if stats.closed {
+		return domain.ErrBettingClosed
+	}
+	if removed {
+		stats.bets--
+	}
+	stats.amount -= amount
+	if pool, ok := stats.pools[color]; ok {
+		pool.amount -= amount
+	}
+	stats.updatedAt = time.Now()
+	return nil
+}
+
+func (r *RoundStatsRepository) RestoreBet(ctx context.Context, roundID string, color domain.Color, amount int64, removed bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats, ok := r.rounds[roundID]
+	if !ok {
+		return nil
+	}
+	if removed {
+		stats.bets++
+	}
+	stats.amount += amount
+	if pool, ok := stats.pools[color]; ok {
+		pool.amount += amount
+	}
+	stats.updatedAt = time.Now()
+	return nil
+}
+
+func (r *RoundStatsRepository) CloseBetting(ctx context.Context, roundID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats := r.roundLocked(roundID)
+	stats.closed = true
+	stats.updatedAt = time.Now()
+	return nil
+}
+
+func (r *RoundStatsRepository) Get(ctx context.Context, roundID string) (*domain.RoundStats, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats, ok := r.rounds[roundID]
+	if !ok {
+		return &domain.RoundStats{Pools: map[domain.Color]*domain.BetPool{}}, nil
+	}
+
+	pools := make(map[domain.Color]*domain.BetPool, len(stats.pools))
+	for color, pool := range stats.pools {
+		pools[color] = &domain.BetPool{Amount: pool.amount, Bettors: len(pool.bettors)}
+	}
+	return &domain.RoundStats{
+		TotalBets:    stats.bets,
+		TotalPlayers: len(stats.players),
+		TotalAmount:  stats.amount,
+		Pools:        pools,
+	}, nil
+}
+
+func (r *RoundStatsRepository) Delete(ctx context.Context, roundID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.rounds, roundID)
+	return nil
+}
+
+// Len returns the number of rounds with stats in memory
+func (r *RoundStatsRepository) Len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.rounds)
+}
+
+// roundLocked returns the stats of a round, creating them on first use
+func (r *RoundStatsRepository) roundLocked(roundID string) *roundStats {
+	stats, ok := r.rounds[roundID]
+	if !ok {
+		stats = &roundStats{
+			players: make(map[int64]struct{}),
+			pools:   make(map[domain.Color]*colorPool),
+		}
+		r.rounds[roundID] = stats
+	}
+	return stats
+}
+
+// sweepLocked evicts expired rounds, at most once per minute
+func (r *RoundStatsRepository) sweepLocked(now time.Time) {
+	if now.Sub(r.lastSweep) < time.Minute {
+		return
+	}
+	r.lastSweep = now
+	for roundID, stats := range r.rounds {
+		if now.Sub(stats.updatedAt) > roundStatsTTL {
+			delete(r.rounds, roundID)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// Package redis provides Redis-based repositories for the color game GMS module.
+package redis
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	"github.com/frankieli/game_product/pkg/service/color_game"
+	"github.com/redis/go-redis/v9"
+)
+
+// saveScript only overwrites the snapshot if the writer's fencing token is not older than the stored one
+var saveScript = redis.NewScript(`
+local current = tonumber(redis.call('GET', KEYS[2]) or '0')
+if tonumber(ARGV[2]) < current then
+	return 0
+end
+redis.call('SET', KEYS[2], ARGV[2])
+redis.call('SET', KEYS[1], ARGV[1])
+return 1
+`)
+
+// RoundSnapshotRepository implements domain.RoundSnapshotRepository using Redis
+type RoundSnapshotRepository struct {
+	rdb      *redis.Client
+	key      string
+	tokenKey string
+}
+
+// NewRoundSnapshotRepository creates a new Redis snapshot repository for a table.
+// The default table keeps the original key so that snapshots written before tables existed are still recovered.
+func NewRoundSnapshotRepository(rdb *redis.Client, tableID string) *RoundSnapshotRepository {
+	key := "gms_snapshot:color_game"
+	if tableID != "" && tableID != color_game.DefaultTableID {
+		key = "gms_snapshot:color_game:table:" + tableID
+	}
+	return &RoundSnapshotRepository{
+		rdb:      rdb,
+		key:      key,
+		tokenKey: key + ":token",
+	}
+}
+
+// Save overwrites the current snapshot (fenced by snapshot.FencingToken)
+func (r *RoundSnapshotRepository) Save(ctx context.Context, snapshot *domain.RoundSnapshot) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	// No TTL: the snapshot must survive an arbitrarily long outage, staleness is judged by UpdatedAt
+	saved, err := saveScript.Run(ctx, r.rdb, []string{r.key, r.tokenKey}, data, snapshot.FencingToken).Int64()
+	if err != nil {
+		return err
+	}
+	if saved == 0 {
+		return domain.ErrFencedOut
+	}
+	return nil
+}
+
+// Load returns the last saved snapshot, or nil if there is none
+func (r *RoundSnapshotRepository) Load(ctx context.Context) (*domain.RoundSnapshot, error) {
+	data, err := r.rdb.Get(ctx, r.key).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var snapshot domain.RoundSnapshot
+	if err := json.Unmarshal([]byte(data), &snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// Clear removes the current snapshot
+func (r *RoundSnapshotRepository) Clear(ctx context.Context) error {
+	return r.rdb.Del(ctx, r.key).Err()
+}
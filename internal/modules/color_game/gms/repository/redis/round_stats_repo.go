@@ -0,0 +1,168 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"github.com/redis/go-redis/v9"
+)
+
+// roundStatsTTL evicts rounds that were never persisted nor voided (e.g. lost events)
+const roundStatsTTL = 24 * time.Hour
+
+// RoundStatsRepository implements domain.RoundStatsRepository using Redis, shared by every GMS replica.
+// Counters are a hash (HINCRBY), unique players a HyperLogLog (PFADD / PFCOUNT), per round and per color.
+type RoundStatsRepository struct {
+	rdb *redis.Client
+}
+
+// NewRoundStatsRepository creates a new Redis round stats repository
+func NewRoundStatsRepository(rdb *redis.Client) *RoundStatsRepository {
+	return &RoundStatsRepository{rdb: rdb}
+}
+
+// The round ID is a hash tag so that every key of a round lives in the same cluster slot (MULTI)
+func statsKey(roundID string) string {
+	return "gms_round_stats:{" + roundID + "}"
+}
+
+func playersKey(roundID string) string {
+	return "gms_round_stats:{" + roundID + "}:players"
+}
+
+func colorPlayersKey(roundID string, color domain.Color) string {
+	return playersKey(roundID) + ":" + strconv.Itoa(int(color))
+}
+
+// Hash fields: bets, amount, amount:<color> and closed (set once betting of the round closed)
+const colorAmountPrefix = "amount:"
+
+// cancelBetScript takes a cancelled stake back from the round unless betting was closed (0)
+var cancelBetScript = redis.NewScript(`
+if redis.call('HEXISTS', KEYS[1], 'closed') == 1 then
+	return 0
+end
+if ARGV[3] == '1' then
+	redis.call('HINCRBY', KEYS[1], 'bets', -1)
+end
+redis.call('HINCRBY', KEYS[1], 'amount', ARGV[1])
+redis.call('HINCRBY', KEYS[1], ARGV[2], ARGV[1])
+redis.call('EXPIRE', KEYS[1], ARGV[4])
+return 1
+`)
+
+func (r *RoundStatsRepository) RecordBet(ctx context.Context, roundID string, userID int64, color domain.Color, amount int64) (int, error) {
+	user := strconv.FormatInt(userID, 10)
+	var bets *redis.IntCmd
+	_, err := r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		bets = pipe.HIncrBy(ctx, statsKey(roundID), "bets", 1)
+		pipe.HIncrBy(ctx, statsKey(roundID), "amount", amount)
+		pipe.HIncrBy(ctx, statsKey(roundID), colorAmountPrefix+strconv.Itoa(int(color)), amount)
+		pipe.PFAdd(ctx, playersKey(roundID), user)
+		pipe.PFAdd(ctx, colorPlayersKey(roundID, color), user)
+		pipe.Expire(ctx, statsKey(roundID), roundStatsTTL)
+		pipe.Expire(ctx, playersKey(roundID), roundStatsTTL)
+		pipe.Expire(ctx, colorPlayersKey(roundID, color), roundStatsTTL)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(bets.Val()), nil
+}
+
+func (r *RoundStatsRepository) CancelBet(ctx context.Context, roundID string, color domain.Color, amount int64, removed bool) error {
+	removedFlag := "0"
+	if removed {
+		removedFlag = "1"
+	}
+	field := colorAmountPrefix + strconv.Itoa(int(color))
+	cancelled, err := cancelBetScript.Run(ctx, r.rdb, []string{statsKey(roundID)},
+		strconv.FormatInt(-amount, 10), field, removedFlag, int64(roundStatsTTL.Seconds())).Int64()
+	if err != nil {
+		return err
+	}
+	if cancelled == 0 {
+		return domain.ErrBettingClosed
+	}
+	return nil
+}
+
+func (r *RoundStatsRepository) RestoreBet(ctx context.Context, roundID string, color domain.Color, amount int64, removed bool) error {
+	_, err := r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		if removed {
+			pipe.HIncrBy(ctx, statsKey(roundID), "bets", 1)
+		}
+		pipe.HIncrBy(ctx, statsKey(roundID), "amount", amount)
+		pipe.HIncrBy(ctx, statsKey(roundID), colorAmountPrefix+strconv.Itoa(int(color)), amount)
+		pipe.Expire(ctx, statsKey(roundID), roundStatsTTL)
+		return nil
+	})
+	return err
+}
+
+func (r *RoundStatsRepository) CloseBetting(ctx context.Context, roundID string) error {
+	_, err := r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, statsKey(roundID), "closed", 1)
+		pipe.Expire(ctx, statsKey(roundID), roundStatsTTL)
+		return nil
+	})
+	return err
+}
+
+func (r *RoundStatsRepository) Get(ctx context.Context, roundID string) (*domain.RoundStats, error) {
+	fields, err := r.rdb.HGetAll(ctx, statsKey(roundID)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	bets, _ := strconv.Atoi(fields["bets"])
+	amount, _ := strconv.ParseInt(fields["amount"], 10, 64)
+	stats := &domain.RoundStats{
+		TotalBets:   bets,
+		TotalAmount: amount,
+		Pools:       make(map[domain.Color]*domain.BetPool),
+	}
+
+	// Count the unique players of the round and of every color with bets in one round trip
+	bettors := make(map[domain.Color]*redis.IntCmd)
+	var players *redis.IntCmd
+	_, err = r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		players = pipe.PFCount(ctx, playersKey(roundID))
+		for field, value := range fields {
+			if !strings.HasPrefix(field, colorAmountPrefix) {
+				continue
+			}
+			c, err := strconv.Atoi(strings.TrimPrefix(field, colorAmountPrefix))
+			if err != nil {
+				continue
+			}
+			color := domain.Color(c)
+			poolAmount, _ := strconv.ParseInt(value, 10, 64)
+			stats.Pools[color] = &domain.BetPool{Amount: poolAmount}
+			bettors[color] = pipe.PFCount(ctx, colorPlayersKey(roundID, color))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats.TotalPlayers = int(players.Val())
+	for color, cmd := range bettors {
+		stats.Pools[color].Bettors = int(cmd.Val())
+	}
+	return stats, nil
+}
+
+func (r *RoundStatsRepository) Delete(ctx context.Context, roundID string) error {
+	keys := []string{statsKey(roundID), playersKey(roundID)}
+	for value := range pbColorGame.ColorGameReward_name {
+		keys = append(keys, colorPlayersKey(roundID, domain.Color(value)))
+	}
+	return r.rdb.Del(ctx, keys...).Err()
+}
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	"github.com/frankieli/game_product/pkg/clock"
+	"github.com/frankieli/game_product/pkg/logger"
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"google.golang.org/protobuf/proto"
+)
+
+// BetPoolInterval is how often the bet pool totals of a table are broadcast during betting
+const BetPoolInterval = 500 * time.Millisecond
+
+// betPool broadcasts the per color totals of one betting round of a table
+type betPool struct {
+	roundID string
+	final   bool // broadcast the closing totals when stopped
+	stop    chan struct{}
+}
+
+// startBetPool starts broadcasting the pool totals of a table, BETTING re-announced after an adjustment keeps the running one
+func (uc *GMSUseCase) startBetPool(tableID string, roundID string) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if uc.gatewayBroadcaster == nil {
+		return
+	}
+	if running, ok := uc.betPools[tableID]; ok {
+		if running.roundID == roundID {
+			return
+		}
+		close(running.stop)
+	}
+	stateMachine, ok := uc.tables[tableID]
+	if !ok {
+		return
+	}
+
+	pool := &betPool{roundID: roundID, stop: make(chan struct{})}
+	uc.betPools[tableID] = pool
+	go uc.runBetPool(stateMachine.Clock(), tableID, pool)
+}
+
+// stopBetPool stops the pool broadcast of a table, final sends the closing totals if they changed since the last tick
+func (uc *GMSUseCase) stopBetPool(tableID string, final bool) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	pool, ok := uc.betPools[tableID]
+	if !ok {
+		return
+	}
+	delete(uc.betPools, tableID)
+	pool.final = final
+	close(pool.stop)
+}
+
+// runBetPool broadcasts the totals every BetPoolInterval, skipping ticks without new bets
+func (uc *GMSUseCase) runBetPool(c clock.Clock, tableID string, pool *betPool) {
+	var last *pbColorGame.ColorGameBetPoolBRC
+	for {
+		last = uc.broadcastBetPool(tableID, pool.roundID, last)
+		if !clock.Sleep(c, BetPoolInterval, pool.stop) {
+			break
+		}
+	}
+
+	uc.mu.RLock()
+	final := pool.final
+	uc.mu.RUnlock()
+	if final {
+		uc.broadcastBetPool(tableID, pool.roundID, last)
+	}
+}
+
+// broadcastBetPool broadcasts the current totals unless they equal last, returns what the table has seen
+func (uc *GMSUseCase) broadcastBetPool(tableID string, roundID string, last *pbColorGame.ColorGameBetPoolBRC) *pbColorGame.ColorGameBetPoolBRC {
+	ctx := context.Background()
+	stats, err := uc.statsRepo().Get(ctx, roundID)
+	if err != nil {
+		logger.Warn(ctx).Err(err).Str("round_id", roundID).Msg("⚠️ [GMS] Failed to get bet pool")
+		return last
+	}
+
+	brc := toBetPoolBRC(tableID, roundID, stats)
+	if last != nil && proto.Equal(brc, last) {
+		return last
+	}
+	uc.gatewayBroadcaster.BroadcastToTable(ctx, "color_game", tableID, brc)
+	return brc
+}
+
+// toBetPoolBRC converts round stats to the pool broadcast, colors in enum order
+func toBetPoolBRC(tableID string, roundID string, stats *domain.RoundStats) *pbColorGame.ColorGameBetPoolBRC {
+	brc := &pbColorGame.ColorGameBetPoolBRC{
+		TableId:      tableID,
+		RoundId:      roundID,
+		TotalAmount:  stats.TotalAmount,
+		TotalBettors: int32(stats.TotalPlayers),
+	}
+	for color, pool := range stats.Pools {
+		brc.Pools = append(brc.Pools, &pbColorGame.ColorGameBetPool{
+			Color:   color,
+			Amount:  pool.Amount,
+			Bettors: int32(pool.Bettors),
+		})
+	}
+	sort.Slice(brc.Pools, func(i, j int) bool {
+		return brc.Pools[i].Color < brc.Pools[j].Color
+	})
+	return brc
+}
@@ -9,40 +9,152 @@ import (
 
 	"github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
 	"github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	"github.com/frankieli/game_product/internal/modules/color_game/gms/repository/memory"
 	"github.com/frankieli/game_product/pkg/logger"
 	"github.com/frankieli/game_product/pkg/service"
 	"github.com/frankieli/game_product/pkg/service/color_game"
 	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	pbCommon "github.com/frankieli/game_product/shared/proto/common"
 )
 
-// GMSUseCase handles game round logic
+// GMSUseCase handles game round logic for every table hosted by this GMS
 type GMSUseCase struct {
-	stateMachine       *machine.StateMachine
-	betCount           map[string]int                // roundID -> bet count
-	betPlayers         map[string]map[int64]struct{} // roundID -> set of userIDs
-	betAmount          map[string]float64            // roundID -> total bet amount
+	tables             map[string]*machine.StateMachine // tableID -> state machine of the table
+	tableIDs           []string                         // tables in registration order, the first one is the default
+	roundStats         domain.RoundStatsRepository      // bet statistics of running rounds
+	betPools           map[string]*betPool              // tableID -> pool broadcast of the betting round
+	paytable           *color_game.Paytable             // outcome table the provably fair results are verified with
 	gatewayBroadcaster service.GatewayService
 	gsBroadcaster      color_game.ColorGameGSService
 	gameRoundRepo      domain.GameRoundRepository
 	mu                 sync.RWMutex
+
+	roadmaps  map[string]*roadmap // tableID -> results roadmap cache
+	roadmapMu sync.Mutex
+
+	roundTasks  map[string]*roundTasks // tableID -> DB writes and GS notifications waiting to run
+	roundTaskMu sync.Mutex
+	roundTaskWg sync.WaitGroup
 }
 
-// NewGMSUseCase creates a new round use case
+// NewGMSUseCase creates a new round use case, stateMachine hosts the default table (more tables can be added with AddTable)
 func NewGMSUseCase(stateMachine *machine.StateMachine, gatewayBroadcaster service.GatewayService, gsBroadcaster color_game.ColorGameGSService, gameRoundRepo domain.GameRoundRepository) *GMSUseCase {
 	uc := &GMSUseCase{
-		stateMachine:       stateMachine,
-		betCount:           make(map[string]int),
-		betPlayers:         make(map[string]map[int64]struct{}),
-		betAmount:          make(map[string]float64),
+		tables:             make(map[string]*machine.StateMachine),
+		roundStats:         memory.NewRoundStatsRepository(),
+		betPools:           make(map[string]*betPool),
+		paytable:           color_game.DefaultPaytable(),
 		gatewayBroadcaster: gatewayBroadcaster,
 		gsBroadcaster:      gsBroadcaster,
 		gameRoundRepo:      gameRoundRepo,
+		roadmaps:           make(map[string]*roadmap),
+		roundTasks:         make(map[string]*roundTasks),
+	}
+
+	uc.AddTable(stateMachine)
+
+	return uc
+}
+
+// AddTable hosts another table, each table runs its own rounds with its own phase durations
+func (uc *GMSUseCase) AddTable(stateMachine *machine.StateMachine) {
+	uc.mu.Lock()
+	if _, exists := uc.tables[stateMachine.TableID]; !exists {
+		uc.tableIDs = append(uc.tableIDs, stateMachine.TableID)
 	}
+	uc.tables[stateMachine.TableID] = stateMachine
+	uc.mu.Unlock()
 
 	// Register event handler to broadcast game events
 	stateMachine.RegisterEventHandler(uc.handleGameEvent)
+	stateMachine.SetBettingClosedHandler(func(ctx context.Context, roundID string) {
+		uc.closeBetting(ctx, stateMachine.TableID, roundID)
+	})
+}
 
-	return uc
+// Tables returns the state machines of every hosted table in registration order
+func (uc *GMSUseCase) Tables() []*machine.StateMachine {
+	uc.mu.RLock()
+	defer uc.mu.RUnlock()
+
+	tables := make([]*machine.StateMachine, 0, len(uc.tableIDs))
+	for _, tableID := range uc.tableIDs {
+		tables = append(tables, uc.tables[tableID])
+	}
+	return tables
+}
+
+// table resolves a table ID, an empty ID selects the default (first) table
+func (uc *GMSUseCase) table(tableID string) (*machine.StateMachine, error) {
+	uc.mu.RLock()
+	defer uc.mu.RUnlock()
+
+	if tableID == "" && len(uc.tableIDs) > 0 {
+		tableID = uc.tableIDs[0]
+	}
+	stateMachine, ok := uc.tables[tableID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", domain.ErrTableNotFound, tableID)
+	}
+	return stateMachine, nil
+}
+
+// Start runs the rounds of every table and blocks until all of them stopped
+func (uc *GMSUseCase) Start(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, stateMachine := range uc.Tables() {
+		wg.Add(1)
+		go func(sm *machine.StateMachine) {
+			defer wg.Done()
+			sm.Start(ctx)
+		}(stateMachine)
+	}
+	wg.Wait()
+}
+
+// SetFencingToken sets the leadership term of every table
+func (uc *GMSUseCase) SetFencingToken(token int64) {
+	for _, stateMachine := range uc.Tables() {
+		stateMachine.SetFencingToken(token)
+	}
+}
+
+// GracefulShutdown lets every table finish its current round and the round tasks run,
+// it returns the first error after all tables stopped
+func (uc *GMSUseCase) GracefulShutdown(timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	tables := uc.Tables()
+	errs := make(chan error, len(tables))
+	for _, stateMachine := range tables {
+		go func(sm *machine.StateMachine) {
+			errs <- sm.GracefulShutdown(timeout)
+		}(stateMachine)
+	}
+
+	var firstErr error
+	for range tables {
+		if err := <-errs; err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	if err := uc.waitRoundTasks(time.Until(deadline)); err != nil && firstErr == nil {
+		firstErr = err
+	}
+	return firstErr
+}
+
+// SetRoundStatsRepository replaces the in-memory bet statistics (GMS replicas share a Redis store)
+func (uc *GMSUseCase) SetRoundStatsRepository(repo domain.RoundStatsRepository) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	uc.roundStats = repo
+}
+
+// SetPaytable sets the paytable the result providers draw with, VerifyRound re-computes results with it
+func (uc *GMSUseCase) SetPaytable(paytable *color_game.Paytable) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	uc.paytable = paytable
 }
 
 // SetGSService sets the GS service
@@ -62,19 +174,26 @@ func (uc *GMSUseCase) handleGameEvent(event machine.GameEvent) {
 		pbColorGame.ColorGameState_GAME_STATE_DRAWING,
 		pbColorGame.ColorGameState_GAME_STATE_RESULT,
 		pbColorGame.ColorGameState_GAME_STATE_ROUND_ENDED,
-		pbColorGame.ColorGameState_GAME_STATE_STOPPED:
-
-		// Convert event type enum to string
-		brc := &pbColorGame.ColorGameRoundStateBRC{
-			RoundId:             event.RoundID,
-			State:               event.Type,
-			BettingEndTimestamp: event.BettingEndTimestamp,
-			LeftTime:            event.LeftTime,
-		}
+		pbColorGame.ColorGameState_GAME_STATE_STOPPED,
+		pbColorGame.ColorGameState_GAME_STATE_VOIDED,
+		pbColorGame.ColorGameState_GAME_STATE_PAUSED:
 
-		// Broadcast BRC to gateway
+		brc := toRoundStateBRC(event)
+
+		// Broadcast BRC to the members of the table
 		if uc.gatewayBroadcaster != nil {
-			uc.gatewayBroadcaster.Broadcast(context.Background(), "color_game", brc)
+			uc.gatewayBroadcaster.BroadcastToTable(context.Background(), "color_game", event.TableID, brc)
+		}
+
+		// Live pool totals while betting is open
+		switch event.Type {
+		case pbColorGame.ColorGameState_GAME_STATE_BETTING:
+			uc.startBetPool(event.TableID, event.RoundID)
+		case pbColorGame.ColorGameState_GAME_STATE_DRAWING:
+			uc.stopBetPool(event.TableID, true)
+		case pbColorGame.ColorGameState_GAME_STATE_VOIDED,
+			pbColorGame.ColorGameState_GAME_STATE_STOPPED:
+			uc.stopBetPool(event.TableID, false)
 		}
 
 	default:
@@ -82,63 +201,89 @@ func (uc *GMSUseCase) handleGameEvent(event machine.GameEvent) {
 		// We are moving away from generic ColorGameEvent
 	}
 
-	// Update DB
-	if uc.gameRoundRepo != nil {
-		ctx := context.Background()
-		switch event.Type {
-		case pbColorGame.ColorGameState_GAME_STATE_ROUND_STARTED:
-			uc.gameRoundRepo.Create(ctx, &domain.GameRound{
-				RoundID:   event.RoundID,
-				GameCode:  "color_game",
-				Status:    domain.RoundStatusInProgress,
-				StartTime: time.Now(),
-			})
-
-		case pbColorGame.ColorGameState_GAME_STATE_RESULT:
-			endTime := time.Now()
-
-			// Get stats from memory
-			uc.mu.RLock()
-			totalBets := uc.betCount[event.RoundID]
-			totalPlayers := len(uc.betPlayers[event.RoundID])
-			totalAmount := uc.betAmount[event.RoundID]
-			uc.mu.RUnlock()
-
-			uc.gameRoundRepo.UpdateResult(ctx, event.RoundID, event.Data.(pbColorGame.ColorGameReward).String(), &endTime, totalBets, totalPlayers, totalAmount)
-		}
+	// Results roadmap, pushed to clients on connect
+	uc.recordRoadmap(event)
+
+	// Persist the round and notify GS in event order, without holding back the delivery of the next events
+	switch event.Type {
+	case pbColorGame.ColorGameState_GAME_STATE_ROUND_STARTED,
+		pbColorGame.ColorGameState_GAME_STATE_RESULT,
+		pbColorGame.ColorGameState_GAME_STATE_VOIDED:
+		uc.runRoundTask(event.TableID, func() {
+			uc.persistRoundEvent(event)
+			uc.notifyGS(event)
+		})
+	}
+}
+
+// closeBettingTimeout bounds the GS notification, the drawing waits for it
+const closeBettingTimeout = time.Second
+
+// closeBetting closes the betting of a round in the round stats and in the GS bet store when the betting phase ends,
+// a stake cancelled after it is rejected by both. If GS misses it, its settlement closes betting before reading the bets.
+func (uc *GMSUseCase) closeBetting(ctx context.Context, tableID string, roundID string) {
+	if err := uc.statsRepo().CloseBetting(ctx, roundID); err != nil {
+		logger.Error(ctx).Err(err).Str("table_id", tableID).Str("round_id", roundID).Msg("❌ [GMS] Failed to close betting in round stats")
 	}
 
 	uc.mu.RLock()
-	defer uc.mu.RUnlock()
+	gsService := uc.gsBroadcaster
+	uc.mu.RUnlock()
+	if gsService == nil {
+		return
+	}
 
-	// Broadcast to GS - result event (filtering done in adapter)
-	if event.Type == pbColorGame.ColorGameState_GAME_STATE_RESULT && uc.gsBroadcaster != nil {
-		req := &pbColorGame.ColorGameRoundResultReq{
-			RoundId: event.RoundID,
-			Result:  event.Data.(pbColorGame.ColorGameReward),
-		}
-		// Create a background context for the call
-		ctx := context.Background()
-		_, _ = uc.gsBroadcaster.RoundResult(ctx, req) // Ignore response for now as it's fire-and-forget
+	gsCtx, cancel := context.WithTimeout(ctx, closeBettingTimeout)
+	defer cancel()
+	rsp, err := gsService.CloseBetting(gsCtx, &pbColorGame.ColorGameCloseBettingReq{
+		TableId: tableID,
+		RoundId: roundID,
+	})
+	if err == nil && rsp.ErrorCode != pbCommon.ErrorCode_SUCCESS {
+		err = fmt.Errorf("GS returned %s: %s", rsp.ErrorCode, rsp.Error)
+	}
+	if err != nil {
+		logger.Error(ctx).Err(err).Str("table_id", tableID).Str("round_id", roundID).Msg("❌ [GMS] Failed to close betting in GS")
 	}
 }
 
-// IncrementBetCount increments the bet count for the current round
-func (uc *GMSUseCase) IncrementBetCount(ctx context.Context, roundID string, userID int64, amount float64) error {
+// toRoundStateBRC converts a state machine event to the broadcast sent to clients
+func toRoundStateBRC(event machine.GameEvent) *pbColorGame.ColorGameRoundStateBRC {
+	return &pbColorGame.ColorGameRoundStateBRC{
+		TableId:             event.TableID,
+		RoundId:             event.RoundID,
+		State:               event.Type,
+		BettingEndTimestamp: event.BettingEndTimestamp,
+		LeftTime:            event.LeftTime,
+		ServerSeedHash:      event.ServerSeedHash,
+		ClientSeed:          event.ClientSeed,
+		ServerSeed:          event.ServerSeed,
+		Seq:                 event.Seq,
+	}
+}
+
+// IncrementBetCount counts a bet of the current round of a table in the round stats and bet pool
+func (uc *GMSUseCase) IncrementBetCount(ctx context.Context, tableID string, roundID string, userID int64, color domain.Color, amount int64) error {
 	logger.Debug(ctx).
+		Str("table_id", tableID).
 		Str("round_id", roundID).
 		Int64("user_id", userID).
 		Msg("GMS 接收下注计数请求")
 
+	stateMachine, err := uc.table(tableID)
+	if err != nil {
+		return err
+	}
+
 	// Check if can accept bet
-	if !uc.stateMachine.CanAcceptBet() {
+	if !stateMachine.CanAcceptBet() {
 		logger.Warn(ctx).
 			Str("round_id", roundID).
 			Msg("当前状态不接受下注")
 		return fmt.Errorf("betting not allowed in current state")
 	}
 
-	currentRound := uc.stateMachine.GetCurrentRound()
+	currentRound := stateMachine.GetCurrentRound()
 	if currentRound.RoundID == "" || currentRound.RoundID != roundID {
 		logger.Warn(ctx).
 			Str("round_id", roundID).
@@ -147,16 +292,12 @@ func (uc *GMSUseCase) IncrementBetCount(ctx context.Context, roundID string, use
 		return fmt.Errorf("invalid round ID")
 	}
 
-	// Increment bet count (thread-safe, handles concurrency across multiple GS instances)
-	uc.mu.Lock()
-	uc.betCount[roundID]++
-	if uc.betPlayers[roundID] == nil {
-		uc.betPlayers[roundID] = make(map[int64]struct{})
+	// Increment bet count (atomic in the stats store, handles concurrency across multiple GS and GMS instances)
+	count, err := uc.statsRepo().RecordBet(ctx, roundID, userID, color, amount)
+	if err != nil {
+		logger.Error(ctx).Err(err).Str("round_id", roundID).Msg("GMS 下注计数失败")
+		return fmt.Errorf("failed to record bet stats: %w", err)
 	}
-	uc.betPlayers[roundID][userID] = struct{}{}
-	uc.betAmount[roundID] += amount
-	count := uc.betCount[roundID]
-	uc.mu.Unlock()
 
 	logger.Info(ctx).
 		Str("round_id", roundID).
@@ -166,13 +307,34 @@ func (uc *GMSUseCase) IncrementBetCount(ctx context.Context, roundID string, use
 	return nil
 }
 
-func (uc *GMSUseCase) GetCurrentRound(ctx context.Context) (*domain.Round, error) {
-	roundView := uc.stateMachine.GetCurrentRound()
+// statsRepo returns the round stats repository
+func (uc *GMSUseCase) statsRepo() domain.RoundStatsRepository {
+	uc.mu.RLock()
+	defer uc.mu.RUnlock()
+	return uc.roundStats
+}
+
+// evictRoundStats deletes the stats of a finished round
+func (uc *GMSUseCase) evictRoundStats(ctx context.Context, roundID string) {
+	if err := uc.statsRepo().Delete(ctx, roundID); err != nil {
+		logger.Warn(ctx).Err(err).Str("round_id", roundID).Msg("⚠️ [GMS] Failed to evict round stats")
+	}
+}
+
+// GetCurrentRound returns the current round of a table, an empty tableID selects the default table
+func (uc *GMSUseCase) GetCurrentRound(ctx context.Context, tableID string) (*domain.Round, error) {
+	stateMachine, err := uc.table(tableID)
+	if err != nil {
+		return nil, err
+	}
+
+	roundView := stateMachine.GetCurrentRound()
 	if roundView.RoundID == "" {
 		return nil, fmt.Errorf("no active round")
 	}
 
 	return &domain.Round{
+		TableID:    stateMachine.TableID,
 		RoundID:    roundView.RoundID,
 		State:      roundView.State,
 		BettingEnd: roundView.BettingEnd,
@@ -180,9 +342,255 @@ func (uc *GMSUseCase) GetCurrentRound(ctx context.Context) (*domain.Round, error
 	}, nil
 }
 
+// GetRecentEvents returns the buffered round state broadcasts of a table after (roundID, afterSeq).
+// complete is false when the gap can no longer be filled, the gateway then resyncs with GetCurrentRound.
+func (uc *GMSUseCase) GetRecentEvents(ctx context.Context, tableID string, roundID string, afterSeq int64) ([]*pbColorGame.ColorGameRoundStateBRC, bool, error) {
+	stateMachine, err := uc.table(tableID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	events, complete := stateMachine.EventsSince(roundID, afterSeq)
+	brcs := make([]*pbColorGame.ColorGameRoundStateBRC, 0, len(events))
+	for _, event := range events {
+		brcs = append(brcs, toRoundStateBRC(event))
+	}
+
+	logger.Debug(ctx).
+		Str("table_id", stateMachine.TableID).
+		Str("round_id", roundID).
+		Int64("after_seq", afterSeq).
+		Int("events", len(brcs)).
+		Bool("complete", complete).
+		Msg("GMS 事件重新同步")
+
+	return brcs, complete, nil
+}
+
+// VerifyRound recomputes the provably fair result of a historical round
+func (uc *GMSUseCase) VerifyRound(ctx context.Context, roundID string) (*domain.FairnessProof, error) {
+	if uc.gameRoundRepo == nil {
+		return nil, fmt.Errorf("game round repository not configured")
+	}
+
+	round, err := uc.gameRoundRepo.GetByRoundID(ctx, roundID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get round: %w", err)
+	}
+	if round == nil {
+		return nil, fmt.Errorf("round %s not found", roundID)
+	}
+
+	uc.mu.RLock()
+	paytable := uc.paytable
+	uc.mu.RUnlock()
+
+	proof, err := domain.VerifyFairness(round, paytable)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info(ctx).
+		Str("round_id", roundID).
+		Bool("verified", proof.Verified).
+		Msg("GMS 公平性驗證")
+
+	return proof, nil
+}
+
+// SubmitResult submits the operator entered dice for the drawing round (live dealer tables)
+func (uc *GMSUseCase) SubmitResult(ctx context.Context, tableID string, roundID string, dice []domain.Color, operator string) error {
+	stateMachine, err := uc.table(tableID)
+	if err != nil {
+		return err
+	}
+
+	if err := stateMachine.SubmitResult(roundID, dice); err != nil {
+		logger.Warn(ctx).
+			Err(err).
+			Str("table_id", tableID).
+			Str("round_id", roundID).
+			Str("result", domain.FormatResult(dice)).
+			Str("operator", operator).
+			Msg("GMS 提交開獎結果失敗")
+		return err
+	}
+
+	logger.Info(ctx).
+		Str("table_id", tableID).
+		Str("round_id", roundID).
+		Str("result", domain.FormatResult(dice)).
+		Str("operator", operator).
+		Msg("GMS 操作員提交開獎結果")
+
+	return nil
+}
+
+// PauseTable holds a table after its current round until ResumeTable (operator control)
+func (uc *GMSUseCase) PauseTable(ctx context.Context, tableID string, operator string) error {
+	stateMachine, err := uc.table(tableID)
+	if err != nil {
+		return err
+	}
+
+	if err := stateMachine.Pause(); err != nil {
+		logger.Warn(ctx).Err(err).Str("table_id", tableID).Str("operator", operator).Msg("GMS 暫停牌桌失敗")
+		return err
+	}
+
+	logger.Info(ctx).Str("table_id", stateMachine.TableID).Str("operator", operator).Msg("GMS 操作員暫停牌桌")
+	return nil
+}
+
+// ResumeTable starts rounds again on a paused table (operator control)
+func (uc *GMSUseCase) ResumeTable(ctx context.Context, tableID string, operator string) error {
+	stateMachine, err := uc.table(tableID)
+	if err != nil {
+		return err
+	}
+
+	if err := stateMachine.Resume(); err != nil {
+		logger.Warn(ctx).Err(err).Str("table_id", tableID).Str("operator", operator).Msg("GMS 恢復牌桌失敗")
+		return err
+	}
+
+	logger.Info(ctx).Str("table_id", stateMachine.TableID).Str("operator", operator).Msg("GMS 操作員恢復牌桌")
+	return nil
+}
+
+// AdjustBetting extends (positive delta) or shortens (negative delta) the betting phase of the current round.
+// It returns the new betting end.
+func (uc *GMSUseCase) AdjustBetting(ctx context.Context, tableID string, roundID string, delta time.Duration, operator string) (time.Time, error) {
+	stateMachine, err := uc.table(tableID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	bettingEnd, err := stateMachine.AdjustBetting(roundID, delta)
+	if err != nil {
+		logger.Warn(ctx).
+			Err(err).
+			Str("table_id", tableID).
+			Str("round_id", roundID).
+			Dur("delta", delta).
+			Str("operator", operator).
+			Msg("GMS 調整下注時間失敗")
+		return time.Time{}, err
+	}
+
+	logger.Info(ctx).
+		Str("table_id", stateMachine.TableID).
+		Str("round_id", roundID).
+		Dur("delta", delta).
+		Time("betting_end", bettingEnd).
+		Str("operator", operator).
+		Msg("GMS 操作員調整下注時間")
+
+	return bettingEnd, nil
+}
+
+// VoidCurrentRound voids the current round before its result is announced, GS refunds every bet of the round
+func (uc *GMSUseCase) VoidCurrentRound(ctx context.Context, tableID string, roundID string, reason string, operator string) error {
+	stateMachine, err := uc.table(tableID)
+	if err != nil {
+		return err
+	}
+
+	if reason == "" {
+		reason = "voided by operator"
+	}
+	if err := stateMachine.VoidCurrentRound(roundID, reason); err != nil {
+		logger.Warn(ctx).
+			Err(err).
+			Str("table_id", tableID).
+			Str("round_id", roundID).
+			Str("operator", operator).
+			Msg("GMS 作廢回合失敗")
+		return err
+	}
+
+	logger.Warn(ctx).
+		Str("table_id", stateMachine.TableID).
+		Str("round_id", roundID).
+		Str("reason", reason).
+		Str("operator", operator).
+		Msg("GMS 操作員作廢回合")
+
+	return nil
+}
+
 // RecordBet records a bet in GMS (called by GS)
-func (uc *GMSUseCase) RecordBet(ctx context.Context, roundID string, userID int64, color domain.Color, amount int64) error {
-	return uc.IncrementBetCount(ctx, roundID, userID, float64(amount))
+func (uc *GMSUseCase) RecordBet(ctx context.Context, tableID string, roundID string, userID int64, color domain.Color, amount int64) error {
+	return uc.IncrementBetCount(ctx, tableID, roundID, userID, color, amount)
+}
+
+// RevokeBet takes a stake cancelled by a player back from the round stats (called by GS).
+// The phase is only checked as a shortcut, the stats repository rejects the stake atomically once betting closed.
+func (uc *GMSUseCase) RevokeBet(ctx context.Context, tableID string, roundID string, userID int64, color domain.Color, amount int64, removed bool) error {
+	stateMachine, err := uc.table(tableID)
+	if err != nil {
+		return err
+	}
+
+	err = checkBettingRound(stateMachine, roundID)
+	if err == nil {
+		err = uc.statsRepo().CancelBet(ctx, roundID, color, amount, removed)
+	}
+	if err != nil {
+		logger.Warn(ctx).
+			Err(err).
+			Str("round_id", roundID).
+			Int64("user_id", userID).
+			Msg("GMS 取消下注失败")
+		return err
+	}
+
+	logger.Info(ctx).
+		Str("round_id", roundID).
+		Int64("user_id", userID).
+		Int64("amount", amount).
+		Bool("removed", removed).
+		Msg("GMS 取消下注成功")
+
+	return nil
+}
+
+// RestoreBet puts a stake taken back by RevokeBet into the round stats again when GS could not cancel the bet after all
+// (called by GS). The bet stands, so the stake is restored even once betting closed.
+func (uc *GMSUseCase) RestoreBet(ctx context.Context, tableID string, roundID string, userID int64, color domain.Color, amount int64, removed bool) error {
+	if _, err := uc.table(tableID); err != nil {
+		return err
+	}
+
+	if err := uc.statsRepo().RestoreBet(ctx, roundID, color, amount, removed); err != nil {
+		logger.Warn(ctx).
+			Err(err).
+			Str("round_id", roundID).
+			Int64("user_id", userID).
+			Msg("GMS 回补下注统计失败")
+		return err
+	}
+
+	logger.Info(ctx).
+		Str("round_id", roundID).
+		Int64("user_id", userID).
+		Int64("amount", amount).
+		Bool("removed", removed).
+		Msg("GMS 回补下注统计成功")
+
+	return nil
+}
+
+// checkBettingRound checks that roundID is the current round of the table and still accepts bets
+func checkBettingRound(stateMachine *machine.StateMachine, roundID string) error {
+	currentRound := stateMachine.GetCurrentRound()
+	if currentRound.RoundID == "" || currentRound.RoundID != roundID {
+		return machine.ErrRoundMismatch
+	}
+	if !stateMachine.CanAcceptBet() {
+		return machine.ErrNotBetting
+	}
+	return nil
 }
 
 // GetPlayerBets returns player bets for a specific round and user
@@ -193,7 +601,9 @@ func (uc *GMSUseCase) GetPlayerBets(ctx context.Context, roundID string, userID
 	return []domain.PlayerBet{}, nil
 }
 
-// RegisterEventHandler registers an additional event handler
+// RegisterEventHandler registers an additional event handler on every table
 func (uc *GMSUseCase) RegisterEventHandler(handler machine.EventHandler) {
-	uc.stateMachine.RegisterEventHandler(handler)
+	for _, stateMachine := range uc.Tables() {
+		stateMachine.RegisterEventHandler(handler)
+	}
 }
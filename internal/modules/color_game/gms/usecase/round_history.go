@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	"github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	"github.com/frankieli/game_product/pkg/logger"
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+)
+
+const (
+	// RoadmapSize is how many finished rounds the roadmap cache keeps per table
+	RoadmapSize = 100
+	// RoadmapRefresh is how often the cache is reloaded from the round history, GMS replicas that do not
+	// run the rounds only see the results of the leader through the database
+	RoadmapRefresh = 10 * time.Second
+
+	roadmapDefaultLimit = 20
+	roundListDefault    = 100
+	roundListMax        = 500
+)
+
+// roadmap is the cached results roadmap of a table, newest first
+type roadmap struct {
+	entries  []domain.RoadmapEntry
+	loadedAt time.Time // last reload from the round history, zero until the first one
+}
+
+// add inserts an entry in end time order, a round already in the roadmap is replaced
+func (r *roadmap) add(entry domain.RoadmapEntry) {
+	entries := make([]domain.RoadmapEntry, 0, len(r.entries)+1)
+	entries = append(entries, entry)
+	for _, cached := range r.entries {
+		if cached.RoundID != entry.RoundID {
+			entries = append(entries, cached)
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].EndTime.After(entries[j].EndTime)
+	})
+	if len(entries) > RoadmapSize {
+		entries = entries[:RoadmapSize]
+	}
+	r.entries = entries
+}
+
+// recordRoadmap adds a round that just ended (RESULT) or was voided to the roadmap of its table
+func (uc *GMSUseCase) recordRoadmap(event machine.GameEvent) {
+	entry := domain.RoadmapEntry{RoundID: event.RoundID, TableID: event.TableID, EndTime: event.Time}
+	switch event.Type {
+	case pbColorGame.ColorGameState_GAME_STATE_RESULT:
+		entry.Dice = event.Data.([]domain.Color)
+	case pbColorGame.ColorGameState_GAME_STATE_VOIDED:
+		entry.Voided = true
+	default:
+		return
+	}
+
+	uc.roadmapMu.Lock()
+	defer uc.roadmapMu.Unlock()
+	uc.roadmapOf(event.TableID).add(entry)
+}
+
+// roadmapOf returns the roadmap of a table, the caller holds roadmapMu
+func (uc *GMSUseCase) roadmapOf(tableID string) *roadmap {
+	r, ok := uc.roadmaps[tableID]
+	if !ok {
+		r = &roadmap{}
+		uc.roadmaps[tableID] = r
+	}
+	return r
+}
+
+// GetRoundHistory returns the last limit finished rounds of a table with the color frequencies drawn in them.
+// The roadmap is served from memory, it is reloaded from the round history on first use and every RoadmapRefresh.
+func (uc *GMSUseCase) GetRoundHistory(ctx context.Context, tableID string, limit int) (*domain.RoundHistory, error) {
+	stateMachine, err := uc.table(tableID)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = roadmapDefaultLimit
+	}
+	if limit > RoadmapSize {
+		limit = RoadmapSize
+	}
+
+	uc.mu.RLock()
+	paytable := uc.paytable
+	uc.mu.RUnlock()
+
+	tableID = stateMachine.TableID
+	now := stateMachine.Clock().Now()
+	uc.roadmapMu.Lock()
+	r := uc.roadmapOf(tableID)
+	reload := uc.gameRoundRepo != nil && (r.loadedAt.IsZero() || now.Sub(r.loadedAt) >= RoadmapRefresh)
+	if reload {
+		r.loadedAt = now
+	}
+	uc.roadmapMu.Unlock()
+	if reload {
+		uc.reloadRoadmap(ctx, tableID)
+	}
+
+	uc.roadmapMu.Lock()
+	entries := r.entries
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	rounds := make([]domain.RoadmapEntry, len(entries))
+	copy(rounds, entries)
+	uc.roadmapMu.Unlock()
+
+	colors := make([]domain.Color, 0, len(paytable.Entries))
+	for _, entry := range paytable.Entries {
+		colors = append(colors, entry.Color)
+	}
+	return &domain.RoundHistory{
+		TableID: tableID,
+		Rounds:  rounds,
+		Stats:   domain.ColorStats(rounds, colors),
+	}, nil
+}
+
+// reloadRoadmap merges the last rounds of the round history into the roadmap of a table.
+// A failed read keeps serving the cached rounds and is retried on the next refresh.
+func (uc *GMSUseCase) reloadRoadmap(ctx context.Context, tableID string) {
+	rounds, err := uc.gameRoundRepo.ListRecent(ctx, tableID, RoadmapSize)
+	if err != nil {
+		logger.Warn(ctx).Err(err).Str("table_id", tableID).Msg("⚠️ [GMS] Failed to load roadmap")
+		return
+	}
+
+	uc.roadmapMu.Lock()
+	defer uc.roadmapMu.Unlock()
+	r := uc.roadmapOf(tableID)
+	for _, round := range rounds {
+		entry, ok := domain.RoadmapEntryOf(round)
+		if !ok {
+			logger.Warn(ctx).Str("round_id", round.RoundID).Str("result", round.Result).Msg("⚠️ [GMS] Skipping round without readable result")
+			continue
+		}
+		r.add(entry)
+	}
+}
+
+// GetRound returns a persisted round (support lookup)
+func (uc *GMSUseCase) GetRound(ctx context.Context, roundID string) (*domain.GameRound, error) {
+	if uc.gameRoundRepo == nil {
+		return nil, fmt.Errorf("game round repository not configured")
+	}
+
+	round, err := uc.gameRoundRepo.GetByRoundID(ctx, roundID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get round: %w", err)
+	}
+	if round == nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrRoundNotFound, roundID)
+	}
+	return round, nil
+}
+
+// ListRounds lists the rounds started in [from, to), newest first, an empty tableID lists every table.
+// A zero to is now and a zero from is a day before to.
+func (uc *GMSUseCase) ListRounds(ctx context.Context, tableID string, from, to time.Time, limit int) ([]*domain.GameRound, error) {
+	if uc.gameRoundRepo == nil {
+		return nil, fmt.Errorf("game round repository not configured")
+	}
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-24 * time.Hour)
+	}
+	if limit <= 0 {
+		limit = roundListDefault
+	}
+	if limit > roundListMax {
+		limit = roundListMax
+	}
+
+	rounds, err := uc.gameRoundRepo.ListByTimeRange(ctx, tableID, from, to, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rounds: %w", err)
+	}
+	return rounds, nil
+}
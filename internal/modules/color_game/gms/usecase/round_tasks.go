@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	"github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	"github.com/frankieli/game_product/pkg/logger"
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+)
+
+// roundTasks runs the database writes and GS notifications of one table in event order.
+// They run off the event delivery goroutine, a slow database or GS never holds back the broadcasts of the next events.
+type roundTasks struct {
+	pending []func()
+	running bool
+}
+
+// runRoundTask queues task after the earlier tasks of the table, a worker runs them while any is pending
+func (uc *GMSUseCase) runRoundTask(tableID string, task func()) {
+	uc.roundTaskWg.Add(1)
+
+	uc.roundTaskMu.Lock()
+	defer uc.roundTaskMu.Unlock()
+
+	tasks, ok := uc.roundTasks[tableID]
+	if !ok {
+		tasks = &roundTasks{}
+		uc.roundTasks[tableID] = tasks
+	}
+	tasks.pending = append(tasks.pending, task)
+	if !tasks.running {
+		tasks.running = true
+		go uc.drainRoundTasks(tableID, tasks)
+	}
+}
+
+// drainRoundTasks runs the pending tasks of a table until none is left
+func (uc *GMSUseCase) drainRoundTasks(tableID string, tasks *roundTasks) {
+	for {
+		uc.roundTaskMu.Lock()
+		if len(tasks.pending) == 0 {
+			tasks.running = false
+			uc.roundTaskMu.Unlock()
+			return
+		}
+		task := tasks.pending[0]
+		tasks.pending[0] = nil
+		tasks.pending = tasks.pending[1:]
+		uc.roundTaskMu.Unlock()
+
+		func() {
+			defer uc.roundTaskWg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					logger.Error(context.Background()).
+						Interface("panic", r).
+						Str("table_id", tableID).
+						Msg("🔥 [GMS] Round task panic recovered")
+				}
+			}()
+			task()
+		}()
+	}
+}
+
+// waitRoundTasks waits until the queued tasks of every table ran
+func (uc *GMSUseCase) waitRoundTasks(timeout time.Duration) error {
+	done := make(chan struct{})
+	go func() {
+		uc.roundTaskWg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-time.After(timeout):
+		return fmt.Errorf("round tasks did not finish within %s", timeout)
+	}
+}
+
+// persistRoundEvent records a round event in game_rounds
+func (uc *GMSUseCase) persistRoundEvent(event machine.GameEvent) {
+	if uc.gameRoundRepo == nil {
+		return
+	}
+
+	ctx := context.Background()
+	switch event.Type {
+	case pbColorGame.ColorGameState_GAME_STATE_ROUND_STARTED:
+		uc.gameRoundRepo.Create(ctx, &domain.GameRound{
+			RoundID:   event.RoundID,
+			GameCode:  "color_game",
+			TableID:   event.TableID,
+			Status:    domain.RoundStatusInProgress,
+			StartTime: event.Time,
+
+			ServerSeedHash: event.ServerSeedHash,
+			ClientSeed:     event.ClientSeed,
+		})
+
+	case pbColorGame.ColorGameState_GAME_STATE_RESULT:
+		endTime := event.Time
+
+		stats, err := uc.statsRepo().Get(ctx, event.RoundID)
+		if err != nil {
+			logger.Error(ctx).Err(err).Str("round_id", event.RoundID).Msg("❌ [GMS] Failed to get round stats")
+			stats = &domain.RoundStats{}
+		}
+
+		err = uc.gameRoundRepo.UpdateResult(ctx, event.RoundID, domain.FormatResult(event.Data.([]domain.Color)), &endTime, stats.TotalBets, stats.TotalPlayers, float64(stats.TotalAmount))
+		if err != nil {
+			logger.Error(ctx).Err(err).Str("round_id", event.RoundID).Msg("❌ [GMS] Failed to update round result")
+		} else {
+			// The stats are persisted with the result, evict them
+			uc.evictRoundStats(ctx, event.RoundID)
+		}
+
+		// Reveal the server seed so that players can verify the result
+		if event.ServerSeed != "" {
+			if err := uc.gameRoundRepo.RevealServerSeed(ctx, event.RoundID, event.ServerSeed); err != nil {
+				logger.Error(ctx).Err(err).Str("round_id", event.RoundID).Msg("❌ [GMS] Failed to reveal server seed")
+			}
+		}
+
+	case pbColorGame.ColorGameState_GAME_STATE_VOIDED:
+		endTime := event.Time
+		if err := uc.gameRoundRepo.MarkVoided(ctx, event.RoundID, &endTime); err != nil {
+			logger.Error(ctx).Err(err).Str("round_id", event.RoundID).Msg("❌ [GMS] Failed to mark round voided")
+		}
+		uc.evictRoundStats(ctx, event.RoundID)
+	}
+}
+
+// notifyGS sends a result (to settle) or a void (to refund) to GS
+func (uc *GMSUseCase) notifyGS(event machine.GameEvent) {
+	uc.mu.RLock()
+	gsService := uc.gsBroadcaster
+	uc.mu.RUnlock()
+	if gsService == nil {
+		return
+	}
+
+	switch event.Type {
+	case pbColorGame.ColorGameState_GAME_STATE_RESULT:
+		dice := event.Data.([]domain.Color)
+		req := &pbColorGame.ColorGameRoundResultReq{
+			TableId: event.TableID,
+			RoundId: event.RoundID,
+			Result:  dice[0],
+			Dice:    dice,
+		}
+		_, _ = gsService.RoundResult(context.Background(), req) // Ignore response for now as it's fire-and-forget
+
+	case pbColorGame.ColorGameState_GAME_STATE_VOIDED:
+		// Voided round - GS refunds every bet of the round
+		reason, _ := event.Data.(string)
+		req := &pbColorGame.ColorGameVoidRoundReq{
+			TableId: event.TableID,
+			RoundId: event.RoundID,
+			Reason:  reason,
+		}
+		_, _ = gsService.VoidRound(context.Background(), req)
+	}
+}
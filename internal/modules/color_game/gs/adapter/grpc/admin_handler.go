@@ -0,0 +1,188 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
+	"github.com/frankieli/game_product/pkg/logger"
+	pb "github.com/frankieli/game_product/shared/proto/colorgame"
+	pbCommon "github.com/frankieli/game_product/shared/proto/common"
+)
+
+// AdminHandler implements the gRPC server for GS operator controls
+type AdminHandler struct {
+	pb.UnimplementedColorGameGSAdminServiceServer
+	gsUC *usecase.GSUseCase
+}
+
+// NewAdminHandler creates a new gRPC admin handler
+func NewAdminHandler(gsUC *usecase.GSUseCase) *AdminHandler {
+	return &AdminHandler{
+		gsUC: gsUC,
+	}
+}
+
+// ListCompensations implements the ListCompensations RPC
+func (h *AdminHandler) ListCompensations(ctx context.Context, req *pb.ColorGameListCompensationsReq) (*pb.ColorGameListCompensationsRsp, error) {
+	status := domain.BetIntentStatusCompensating
+	if req.Status != "" {
+		parsed, ok := domain.ParseBetIntentStatus(strings.ToUpper(req.Status))
+		if !ok {
+			return &pb.ColorGameListCompensationsRsp{
+				ErrorCode: pbCommon.ErrorCode_INVALID_PARAMS,
+				Error:     fmt.Sprintf("invalid status: %s", req.Status),
+			}, nil
+		}
+		status = parsed
+	}
+
+	intents, err := h.gsUC.ListCompensations(ctx, status, int(req.Limit))
+	if err != nil {
+		return &pb.ColorGameListCompensationsRsp{
+			ErrorCode: pbCommon.ErrorCode_INTERNAL_ERROR,
+			Error:     err.Error(),
+		}, nil
+	}
+
+	compensations := make([]*pb.ColorGameCompensation, 0, len(intents))
+	for _, intent := range intents {
+		compensations = append(compensations, toCompensation(intent))
+	}
+	return &pb.ColorGameListCompensationsRsp{
+		ErrorCode:     pbCommon.ErrorCode_SUCCESS,
+		Compensations: compensations,
+	}, nil
+}
+
+// RetryCompensation implements the RetryCompensation RPC
+func (h *AdminHandler) RetryCompensation(ctx context.Context, req *pb.ColorGameRetryCompensationReq) (*pb.ColorGameRetryCompensationRsp, error) {
+	logger.Info(ctx).
+		Str("tx_id", req.TxId).
+		Str("operator", req.Operator).
+		Msg("RetryCompensation RPC called")
+
+	intent, err := h.gsUC.RetryCompensation(ctx, req.TxId, req.Operator)
+	if err != nil {
+		code := pbCommon.ErrorCode_INTERNAL_ERROR
+		if errors.Is(err, domain.ErrBetIntentNotFound) {
+			code = pbCommon.ErrorCode_NOT_FOUND
+		}
+		return &pb.ColorGameRetryCompensationRsp{
+			ErrorCode: code,
+			Error:     err.Error(),
+		}, nil
+	}
+
+	return &pb.ColorGameRetryCompensationRsp{
+		ErrorCode:    pbCommon.ErrorCode_SUCCESS,
+		Compensation: toCompensation(intent),
+	}, nil
+}
+
+// GetSettlement implements the GetSettlement RPC
+func (h *AdminHandler) GetSettlement(ctx context.Context, req *pb.ColorGameGetSettlementReq) (*pb.ColorGameGetSettlementRsp, error) {
+	if req.RoundId == "" {
+		return &pb.ColorGameGetSettlementRsp{
+			ErrorCode: pbCommon.ErrorCode_INVALID_PARAMS,
+			Error:     "round_id is required",
+		}, nil
+	}
+
+	settlement, err := h.gsUC.GetSettlement(ctx, req.RoundId)
+	if err != nil {
+		code := pbCommon.ErrorCode_INTERNAL_ERROR
+		if errors.Is(err, domain.ErrSettlementNotFound) {
+			code = pbCommon.ErrorCode_NOT_FOUND
+		}
+		return &pb.ColorGameGetSettlementRsp{
+			ErrorCode: code,
+			Error:     err.Error(),
+		}, nil
+	}
+
+	return &pb.ColorGameGetSettlementRsp{
+		ErrorCode:  pbCommon.ErrorCode_SUCCESS,
+		Settlement: toRoundSettlement(settlement),
+	}, nil
+}
+
+// ListSettlements implements the ListSettlements RPC
+func (h *AdminHandler) ListSettlements(ctx context.Context, req *pb.ColorGameListSettlementsReq) (*pb.ColorGameListSettlementsRsp, error) {
+	status := domain.SettlementStatusSettling
+	if req.Status != "" {
+		parsed, ok := domain.ParseSettlementStatus(strings.ToUpper(req.Status))
+		if !ok {
+			return &pb.ColorGameListSettlementsRsp{
+				ErrorCode: pbCommon.ErrorCode_INVALID_PARAMS,
+				Error:     fmt.Sprintf("invalid status: %s", req.Status),
+			}, nil
+		}
+		status = parsed
+	}
+
+	settlements, err := h.gsUC.ListSettlements(ctx, status, int(req.Limit))
+	if err != nil {
+		return &pb.ColorGameListSettlementsRsp{
+			ErrorCode: pbCommon.ErrorCode_INTERNAL_ERROR,
+			Error:     err.Error(),
+		}, nil
+	}
+
+	rsp := &pb.ColorGameListSettlementsRsp{
+		ErrorCode:   pbCommon.ErrorCode_SUCCESS,
+		Settlements: make([]*pb.ColorGameRoundSettlement, 0, len(settlements)),
+	}
+	for _, settlement := range settlements {
+		rsp.Settlements = append(rsp.Settlements, toRoundSettlement(settlement))
+	}
+	return rsp, nil
+}
+
+// SearchBetHistory implements the SearchBetHistory RPC, an operator may search the orders of every player
+func (h *AdminHandler) SearchBetHistory(ctx context.Context, req *pb.ColorGameGetBetHistoryReq) (*pb.ColorGameGetBetHistoryRsp, error) {
+	page, err := h.gsUC.GetBetHistory(ctx, toBetHistoryQuery(req))
+	return toBetHistoryRsp(page, err), nil
+}
+
+// toRoundSettlement converts a settlement checkpoint to its proto message
+func toRoundSettlement(settlement *domain.RoundSettlement) *pb.ColorGameRoundSettlement {
+	msg := &pb.ColorGameRoundSettlement{
+		RoundId:     settlement.RoundID,
+		TableId:     settlement.TableID,
+		Status:      settlement.Status.String(),
+		Dice:        domain.DecodeDice(settlement.Dice),
+		VoidReason:  settlement.VoidReason,
+		Owner:       settlement.Owner,
+		LeaseUntil:  settlement.LeaseUntil.Unix(),
+		Batches:     int32(settlement.Batches),
+		BetCount:    int32(settlement.BetCount),
+		TotalPayout: settlement.TotalPayout,
+		Attempts:    int32(settlement.Attempts),
+		LastError:   settlement.LastError,
+		CreatedAt:   settlement.CreatedAt.Unix(),
+	}
+	if settlement.FinishedAt != nil {
+		msg.FinishedAt = settlement.FinishedAt.Unix()
+	}
+	return msg
+}
+
+// toCompensation converts a bet intent to its proto message
+func toCompensation(intent *domain.BetIntent) *pb.ColorGameCompensation {
+	return &pb.ColorGameCompensation{
+		TxId:        intent.TxID,
+		RoundId:     intent.RoundID,
+		UserId:      intent.UserID,
+		Color:       intent.Color,
+		Amount:      intent.Amount,
+		Status:      intent.Status.String(),
+		Attempts:    int32(intent.Attempts),
+		LastError:   intent.LastError,
+		NextRetryAt: intent.NextRetryAt.Unix(),
+		CreatedAt:   intent.CreatedAt.Unix(),
+	}
+}
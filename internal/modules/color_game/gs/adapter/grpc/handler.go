@@ -4,6 +4,10 @@ package grpc
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
 	"github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
 	"github.com/frankieli/game_product/pkg/logger"
 	pb "github.com/frankieli/game_product/shared/proto/colorgame"
@@ -25,10 +29,10 @@ func NewHandler(gsUC *usecase.GSUseCase) *Handler {
 
 // PlaceBet implements the PlaceBet RPC
 func (h *Handler) PlaceBet(ctx context.Context, req *pb.ColorGamePlaceBetReq) (*pb.ColorGamePlaceBetRsp, error) {
-	bet, err := h.gsUC.PlaceBet(ctx, req.UserId, req.Color, req.Amount)
+	bet, err := h.gsUC.PlaceBet(ctx, req.UserId, req.TableId, req.Color, req.Amount, req.IdempotencyKey)
 	if err != nil {
 		return &pb.ColorGamePlaceBetRsp{
-			ErrorCode: pbCommon.ErrorCode_INTERNAL_ERROR, // TODO: Map specific errors
+			ErrorCode: toErrorCode(err),
 			Error:     err.Error(),
 		}, nil
 	}
@@ -38,12 +42,110 @@ func (h *Handler) PlaceBet(ctx context.Context, req *pb.ColorGamePlaceBetReq) (*
 	}, nil
 }
 
+// CancelBet implements the CancelBet RPC
+func (h *Handler) CancelBet(ctx context.Context, req *pb.ColorGameCancelBetReq) (*pb.ColorGameCancelBetRsp, error) {
+	cancellation, err := h.gsUC.CancelBet(ctx, req.UserId, req.TableId, req.Color, req.Amount, req.IdempotencyKey)
+	if err != nil {
+		return &pb.ColorGameCancelBetRsp{
+			ErrorCode: toErrorCode(err),
+			Error:     err.Error(),
+		}, nil
+	}
+
+	return &pb.ColorGameCancelBetRsp{
+		ErrorCode:       pbCommon.ErrorCode_SUCCESS,
+		BetId:           cancellation.BetID,
+		RefundAmount:    cancellation.Refund,
+		RemainingAmount: cancellation.Remaining,
+	}, nil
+}
+
+// PlaceBetSlip implements the PlaceBetSlip RPC
+func (h *Handler) PlaceBetSlip(ctx context.Context, req *pb.ColorGamePlaceBetSlipReq) (*pb.ColorGamePlaceBetSlipRsp, error) {
+	lines := make([]domain.BetLine, len(req.Lines))
+	for i, line := range req.Lines {
+		lines[i] = domain.BetLine{Color: line.Color, Amount: line.Amount}
+	}
+
+	bets, err := h.gsUC.PlaceBetSlip(ctx, req.UserId, req.TableId, lines, req.IdempotencyKey)
+	if err != nil {
+		return &pb.ColorGamePlaceBetSlipRsp{
+			ErrorCode: toErrorCode(err),
+			Error:     err.Error(),
+		}, nil
+	}
+
+	return &pb.ColorGamePlaceBetSlipRsp{
+		ErrorCode: pbCommon.ErrorCode_SUCCESS,
+		Lines:     toBetLines(bets),
+	}, nil
+}
+
+// Rebet implements the Rebet RPC
+func (h *Handler) Rebet(ctx context.Context, req *pb.ColorGameRebetReq) (*pb.ColorGameRebetRsp, error) {
+	bets, err := h.gsUC.Rebet(ctx, req.UserId, req.TableId, max(req.Multiplier, 1), req.IdempotencyKey)
+	if err != nil {
+		return &pb.ColorGameRebetRsp{
+			ErrorCode: toErrorCode(err),
+			Error:     err.Error(),
+		}, nil
+	}
+
+	return &pb.ColorGameRebetRsp{
+		ErrorCode: pbCommon.ErrorCode_SUCCESS,
+		Lines:     toBetLines(bets),
+	}, nil
+}
+
+// StartAutoBet implements the StartAutoBet RPC
+func (h *Handler) StartAutoBet(ctx context.Context, req *pb.ColorGameStartAutoBetReq) (*pb.ColorGameStartAutoBetRsp, error) {
+	autoBet, err := h.gsUC.StartAutoBet(ctx, req.UserId, req.TableId, max(req.Multiplier, 1), int(req.Rounds), req.StopLoss, req.StopWin)
+	if err != nil {
+		return &pb.ColorGameStartAutoBetRsp{
+			ErrorCode: toErrorCode(err),
+			Error:     err.Error(),
+		}, nil
+	}
+
+	rsp := &pb.ColorGameStartAutoBetRsp{
+		ErrorCode:  pbCommon.ErrorCode_SUCCESS,
+		RoundsLeft: int32(autoBet.RoundsLeft),
+	}
+	for _, line := range autoBet.Lines {
+		rsp.Lines = append(rsp.Lines, &pb.ColorGameBetLine{Color: line.Color, Amount: line.Amount})
+	}
+	return rsp, nil
+}
+
+// StopAutoBet implements the StopAutoBet RPC
+func (h *Handler) StopAutoBet(ctx context.Context, req *pb.ColorGameStopAutoBetReq) (*pb.ColorGameStopAutoBetRsp, error) {
+	if err := h.gsUC.StopAutoBet(ctx, req.UserId); err != nil {
+		return &pb.ColorGameStopAutoBetRsp{
+			ErrorCode: toErrorCode(err),
+			Error:     err.Error(),
+		}, nil
+	}
+	return &pb.ColorGameStopAutoBetRsp{ErrorCode: pbCommon.ErrorCode_SUCCESS}, nil
+}
+
+// GetBetHistory implements the GetBetHistory RPC, a player only sees their own orders
+func (h *Handler) GetBetHistory(ctx context.Context, req *pb.ColorGameGetBetHistoryReq) (*pb.ColorGameGetBetHistoryRsp, error) {
+	if req.UserId <= 0 {
+		return &pb.ColorGameGetBetHistoryRsp{
+			ErrorCode: pbCommon.ErrorCode_INVALID_PARAMS,
+			Error:     "user_id is required",
+		}, nil
+	}
+	page, err := h.gsUC.GetBetHistory(ctx, toBetHistoryQuery(req))
+	return toBetHistoryRsp(page, err), nil
+}
+
 // GetState implements the GetState RPC
 func (h *Handler) GetState(ctx context.Context, req *pb.ColorGameGetStateReq) (*pb.ColorGameGetStateRsp, error) {
-	state, err := h.gsUC.GetCurrentRound(ctx, req.UserId)
+	state, err := h.gsUC.GetCurrentRound(ctx, req.UserId, req.TableId)
 	if err != nil {
 		return &pb.ColorGameGetStateRsp{
-			ErrorCode: pbCommon.ErrorCode_INTERNAL_ERROR,
+			ErrorCode: toErrorCode(err),
 		}, nil
 	}
 
@@ -61,24 +163,143 @@ func (h *Handler) GetState(ctx context.Context, req *pb.ColorGameGetStateReq) (*
 	}, nil
 }
 
-// RoundResult implements the RoundResult RPC
+// RoundResult implements the RoundResult RPC.
+// The round is claimed before the response and settled in the background, a GS crash leaves the claim to be resumed.
 func (h *Handler) RoundResult(ctx context.Context, req *pb.ColorGameRoundResultReq) (*pb.ColorGameRoundResultRsp, error) {
-	// For gRPC, we just forward to the use case logic directly or via a service method
-	// Since GSUseCase doesn't have a direct RoundResult method that takes proto, we might need to adapt here
-	// But wait, the local handler implements it by calling SettleRound asynchronously.
-	// We should probably do similar logic here or delegate to a common service implementation.
-	// However, the gRPC handler is an adapter for incoming requests.
-	// The RoundResult is a notification from GMS.
-
-	// Run settlement asynchronously
-	// Note: In a real gRPC handler, we might want to return immediately.
-	go func() {
-		if err := h.gsUC.SettleRound(ctx, req.RoundId, req.Result); err != nil {
-			logger.ErrorGlobal().Err(err).Str("round_id", req.RoundId).Msg("Settlement failed")
-		}
-	}()
+	if err := h.gsUC.ScheduleSettlement(ctx, req.TableId, req.RoundId, domain.ResultDice(req.Result, req.Dice)); err != nil {
+		logger.Error(ctx).Err(err).Str("round_id", req.RoundId).Msg("Failed to schedule settlement")
+		return &pb.ColorGameRoundResultRsp{
+			ErrorCode: pbCommon.ErrorCode_INTERNAL_ERROR,
+			Error:     err.Error(),
+		}, nil
+	}
 
 	return &pb.ColorGameRoundResultRsp{
 		ErrorCode: pbCommon.ErrorCode_SUCCESS,
 	}, nil
 }
+
+// VoidRound implements the VoidRound RPC, the refund is claimed before the response and processed in the background
+func (h *Handler) VoidRound(ctx context.Context, req *pb.ColorGameVoidRoundReq) (*pb.ColorGameVoidRoundRsp, error) {
+	if err := h.gsUC.ScheduleVoid(ctx, req.TableId, req.RoundId, req.Reason); err != nil {
+		logger.Error(ctx).Err(err).Str("round_id", req.RoundId).Msg("Failed to schedule void refund")
+		return &pb.ColorGameVoidRoundRsp{
+			ErrorCode: pbCommon.ErrorCode_INTERNAL_ERROR,
+			Error:     err.Error(),
+		}, nil
+	}
+
+	return &pb.ColorGameVoidRoundRsp{
+		ErrorCode: pbCommon.ErrorCode_SUCCESS,
+	}, nil
+}
+
+// CloseBetting implements the CloseBetting RPC, bets of the round can no longer be cancelled once it returns
+func (h *Handler) CloseBetting(ctx context.Context, req *pb.ColorGameCloseBettingReq) (*pb.ColorGameCloseBettingRsp, error) {
+	if err := h.gsUC.CloseBetting(ctx, req.TableId, req.RoundId); err != nil {
+		logger.Error(ctx).Err(err).Str("round_id", req.RoundId).Msg("Failed to close betting")
+		return &pb.ColorGameCloseBettingRsp{
+			ErrorCode: pbCommon.ErrorCode_INTERNAL_ERROR,
+			Error:     err.Error(),
+		}, nil
+	}
+
+	return &pb.ColorGameCloseBettingRsp{
+		ErrorCode: pbCommon.ErrorCode_SUCCESS,
+	}, nil
+}
+
+// toBetLines returns the line of each bet of a slip, bets[i].Amount is the total stake of the bet on its color
+func toBetLines(bets []*domain.Bet) []*pb.ColorGameBetLine {
+	lines := make([]*pb.ColorGameBetLine, 0, len(bets))
+	for _, bet := range bets {
+		lines = append(lines, &pb.ColorGameBetLine{Color: bet.Color, Amount: bet.Amount, BetId: bet.BetID})
+	}
+	return lines
+}
+
+// toBetHistoryQuery converts a bet history request to its use case query
+func toBetHistoryQuery(req *pb.ColorGameGetBetHistoryReq) domain.BetHistoryQuery {
+	query := domain.BetHistoryQuery{
+		UserID:   req.UserId,
+		RoundID:  req.RoundId,
+		GameCode: req.GameCode,
+		Cursor:   req.Cursor,
+		Limit:    int(req.Limit),
+	}
+	if req.From > 0 {
+		query.From = time.Unix(req.From, 0)
+	}
+	if req.To > 0 {
+		query.To = time.Unix(req.To, 0)
+	}
+	return query
+}
+
+// toBetHistoryRsp converts a page of bet records to the bet history response
+func toBetHistoryRsp(page *domain.BetHistoryPage, err error) *pb.ColorGameGetBetHistoryRsp {
+	if err != nil {
+		code := pbCommon.ErrorCode_INTERNAL_ERROR
+		if errors.Is(err, domain.ErrInvalidBetHistoryQuery) {
+			code = pbCommon.ErrorCode_INVALID_PARAMS
+		}
+		return &pb.ColorGameGetBetHistoryRsp{
+			ErrorCode: code,
+			Error:     err.Error(),
+		}
+	}
+
+	rsp := &pb.ColorGameGetBetHistoryRsp{
+		ErrorCode:  pbCommon.ErrorCode_SUCCESS,
+		Records:    make([]*pb.ColorGameBetRecord, 0, len(page.Records)),
+		NextCursor: page.NextCursor,
+	}
+	for _, record := range page.Records {
+		order := record.Order
+		msg := &pb.ColorGameBetRecord{
+			BetId:      order.OrderID,
+			UserId:     order.UserID,
+			RoundId:    order.RoundID,
+			GameCode:   order.GameCode,
+			Color:      pb.ColorGameReward(pb.ColorGameReward_value[order.BetArea]),
+			Amount:     int64(order.Amount),
+			Payout:     int64(order.Payout),
+			Status:     order.Status.String(),
+			Dice:       record.Dice,
+			VoidReason: record.VoidReason,
+			CreatedAt:  order.CreatedAt.Unix(),
+		}
+		if order.SettledAt != nil {
+			msg.SettledAt = order.SettledAt.Unix()
+		}
+		rsp.Records = append(rsp.Records, msg)
+	}
+	return rsp
+}
+
+// toErrorCode maps usecase errors to error codes, an unknown table is NOT_FOUND
+func toErrorCode(err error) pbCommon.ErrorCode {
+	switch {
+	case errors.Is(err, domain.ErrTableNotFound):
+		return pbCommon.ErrorCode_NOT_FOUND
+	case errors.Is(err, domain.ErrRoundNotActive):
+		return pbCommon.ErrorCode_ROUND_NOT_ACTIVE
+	case errors.Is(err, domain.ErrInvalidBetOption):
+		return pbCommon.ErrorCode_INVALID_BET_OPTION
+	case errors.Is(err, domain.ErrInvalidBetAmount):
+		return pbCommon.ErrorCode_INVALID_BET_AMOUNT
+	case errors.Is(err, domain.ErrBetLimitExceeded):
+		return pbCommon.ErrorCode_BET_LIMIT_EXCEEDED
+	case errors.Is(err, domain.ErrExposureLimitExceeded):
+		return pbCommon.ErrorCode_EXPOSURE_LIMIT_EXCEEDED
+	case errors.Is(err, domain.ErrInvalidRequestKey):
+		return pbCommon.ErrorCode_INVALID_PARAMS
+	case errors.Is(err, domain.ErrRequestInProgress), errors.Is(err, domain.ErrBetChanged):
+		return pbCommon.ErrorCode_REQUEST_IN_PROGRESS
+	case errors.Is(err, domain.ErrBetNotFound), errors.Is(err, domain.ErrNoBetsToRepeat):
+		return pbCommon.ErrorCode_NOT_FOUND
+	case errors.Is(err, domain.ErrInvalidAutoBet):
+		return pbCommon.ErrorCode_INVALID_PARAMS
+	}
+	return pbCommon.ErrorCode_INTERNAL_ERROR
+}
@@ -11,6 +11,14 @@ import (
 // Color represents a game color (same as GMS)
 type Color = pbColorGame.ColorGameReward
 
+// ResultDice returns every die of a round result, GMS versions without dice only send the first color
+func ResultDice(result Color, dice []Color) []Color {
+	if len(dice) > 0 {
+		return dice
+	}
+	return []Color{result}
+}
+
 // Bet represents a player's bet
 type Bet struct {
 	BetID   string
@@ -19,6 +27,24 @@ type Bet struct {
 	Color   Color
 	Amount  int64
 	Time    time.Time
+
+	// TxIDs are the wallet transactions that deducted the stake, one per PlaceBet merged into this bet.
+	// A voided round rolls back each of them.
+	TxIDs []string
+
+	// RefundTxIDs are the deposits that refunded the stakes the player cancelled (see CancelTxID).
+	// A voided round refunds the remaining Amount instead of rolling back TxIDs.
+	RefundTxIDs []string
+
+	// SlipID is set when the bet was placed by a bet slip, whose transaction deducted the stakes of every line at once.
+	// A voided round refunds Amount instead of rolling back the shared transaction.
+	SlipID string
+}
+
+// SharesStake reports whether the wallet transactions of the bet do not match its Amount (cancelled stakes,
+// or a transaction shared with the other lines of a bet slip), a void then cannot simply roll them back
+func (b *Bet) SharesStake() bool {
+	return len(b.RefundTxIDs) > 0 || b.SlipID != ""
 }
 
 var (
@@ -37,18 +63,49 @@ func initSnowflake() {
 	}
 }
 
-// NewBet creates a new bet
-func NewBet(roundID string, userID int64, color Color, amount int64) *Bet {
+// NewBet creates a new bet placed at placedAt, txID is the wallet transaction that deducted the stake
+func NewBet(betID string, roundID string, userID int64, color Color, amount int64, txID string, placedAt time.Time) *Bet {
 	return &Bet{
-		BetID:   generateBetID(),
+		BetID:   betID,
 		RoundID: roundID,
 		UserID:  userID,
 		Color:   color,
 		Amount:  amount,
-		Time:    time.Now(),
+		Time:    placedAt,
+		TxIDs:   []string{txID},
 	}
 }
 
+// NewBetID generates the ID of a new bet
+func NewBetID() string {
+	return generateBetID()
+}
+
+// BetTxID is the wallet transaction of the stake a PlaceBet request adds to a bet.
+// It is derived from the bet and the request key, a replayed request never deducts twice.
+func BetTxID(betID string, requestKey string) string {
+	return "bet-" + betID + "-" + requestKey
+}
+
+// CancelTxID is the wallet transaction refunding the stake a CancelBet request takes back from a bet
+func CancelTxID(betID string, requestKey string) string {
+	return "cancel-" + betID + "-" + requestKey
+}
+
+// VoidTxID is the wallet transaction refunding the stake of a voided bet that shares its deductions (see Bet.SharesStake)
+func VoidTxID(betID string) string {
+	return "void-" + betID
+}
+
+// BetCancellation is the outcome of a CancelBet request
+type BetCancellation struct {
+	BetID     string
+	RoundID   string
+	Color     Color
+	Refund    int64 // Stake refunded by the request
+	Remaining int64 // Stake left on the bet, 0 when the bet was cancelled
+}
+
 func generateBetID() string {
 	once.Do(initSnowflake)
 	return node.Generate().String()
@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// BetHistoryQuery selects the bet orders a player or an operator looks up
+type BetHistoryQuery struct {
+	UserID   int64     // 0 = every player (operators only)
+	RoundID  string    // Every order of the round, the other filters except UserID are ignored and the result is one page
+	GameCode string    // Empty = every game
+	From     time.Time // Zero = no lower bound
+	To       time.Time // Zero = no upper bound
+	Cursor   string    // NextCursor of the previous page, empty for the first page
+	Limit    int       // Orders per page, 0 = default
+}
+
+// BetRecord is a bet order with the result of its round
+type BetRecord struct {
+	Order      *BetOrder
+	Dice       []Color // Drawn colors, empty until the round is drawn and when it was voided
+	VoidReason string  // Set when the round was voided and the stake refunded
+}
+
+// BetHistoryPage is one page of bet records, newest first
+type BetHistoryPage struct {
+	Records    []*BetRecord
+	NextCursor string // Empty on the last page
+}
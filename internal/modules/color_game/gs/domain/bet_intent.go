@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// BetIntentStatus is the saga state of a stake deduction
+type BetIntentStatus int
+
+const (
+	BetIntentStatusPending      BetIntentStatus = 0 // 錢包扣款中，下注尚未記錄
+	BetIntentStatusPlaced       BetIntentStatus = 1 // 下注寫入中，成功後刪除
+	BetIntentStatusCompensating BetIntentStatus = 2 // 下注失敗，等待錢包回滾
+	BetIntentStatusCompensated  BetIntentStatus = 3 // 已回滾 (保留作為稽核記錄)
+)
+
+// String returns the status name shown to operators
+func (s BetIntentStatus) String() string {
+	switch s {
+	case BetIntentStatusPending:
+		return "PENDING"
+	case BetIntentStatusPlaced:
+		return "PLACED"
+	case BetIntentStatusCompensating:
+		return "COMPENSATING"
+	case BetIntentStatusCompensated:
+		return "COMPENSATED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ParseBetIntentStatus parses a status name as returned by String
+func ParseBetIntentStatus(name string) (BetIntentStatus, bool) {
+	for status := BetIntentStatusPending; status <= BetIntentStatusCompensated; status++ {
+		if status.String() == name {
+			return status, true
+		}
+	}
+	return 0, false
+}
+
+// BetIntent is a stake deduction in flight, recorded before the wallet is charged.
+// It is deleted once the bet is saved; a bet that could not be placed is compensated by rolling back
+// the wallet transaction, retried with backoff until the wallet accepts the rollback.
+type BetIntent struct {
+	TxID        string          `gorm:"primaryKey;type:varchar(64)" json:"tx_id"` // Wallet transaction of the deduction
+	RoundID     string          `gorm:"type:varchar(64);not null" json:"round_id"`
+	UserID      int64           `gorm:"not null;index:idx_bet_intents_user_id" json:"user_id"`
+	Color       Color           `gorm:"type:int;not null" json:"color"`
+	Amount      int64           `gorm:"not null" json:"amount"`
+	Status      BetIntentStatus `gorm:"type:int;not null;default:0;index:idx_bet_intents_status_next_retry_at,priority:1" json:"status"`
+	Attempts    int             `gorm:"not null;default:0" json:"attempts"`                                                  // Rollback attempts
+	LastError   string          `gorm:"type:text" json:"last_error"`                                                         // Error of the last failed step
+	NextRetryAt time.Time       `gorm:"not null;index:idx_bet_intents_status_next_retry_at,priority:2" json:"next_retry_at"` // When the worker picks the intent up
+	CreatedAt   time.Time       `gorm:"not null" json:"created_at"`
+	UpdatedAt   time.Time       `gorm:"not null" json:"updated_at"`
+}
+
+// TableName overrides the table name
+func (BetIntent) TableName() string {
+	return "bet_intents"
+}
+
+// BetIntentRepository stores bet intents durably, shared by every GS instance
+type BetIntentRepository interface {
+	// Create records a new intent
+	Create(ctx context.Context, intent *BetIntent) error
+
+	// Get returns an intent, nil when it does not exist
+	Get(ctx context.Context, txID string) (*BetIntent, error)
+
+	// Transition atomically moves an intent from one status to another, false when it is no longer in status from.
+	// PlaceBet and the compensation worker race through it, so only one of them owns an intent.
+	Transition(ctx context.Context, txID string, from BetIntentStatus, to BetIntentStatus) (bool, error)
+
+	// Update saves the status, attempts, last error and next retry of an intent
+	Update(ctx context.Context, intent *BetIntent) error
+
+	// Delete removes the intent of a placed bet
+	Delete(ctx context.Context, txID string) error
+
+	// ListDue returns intents that are not compensated and whose NextRetryAt is not after now, oldest first
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*BetIntent, error)
+
+	// List returns the intents in a status, oldest first (operators)
+	List(ctx context.Context, status BetIntentStatus, limit int) ([]*BetIntent, error)
+}
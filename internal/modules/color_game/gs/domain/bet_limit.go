@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+)
+
+// BetLimits are the stake limits of a table, zero disables a limit
+type BetLimits struct {
+	MinBet      int64 // Minimum stake of one PlaceBet (at least 1)
+	MaxBet      int64 // Maximum stake of one PlaceBet
+	MaxRoundBet int64 // Maximum a player stakes in a round, all colors together
+	MaxColorBet int64 // Maximum a player stakes on one color in a round
+	MaxExposure int64 // Maximum potential payout of a color in a round, all players together (house liability)
+}
+
+// CheckAmount validates the stake of one PlaceBet, the round limits are enforced by the BetLimitRepository
+func (l BetLimits) CheckAmount(amount int64) error {
+	if amount <= 0 || amount < l.MinBet {
+		return fmt.Errorf("%w: %d is below the minimum bet %d", ErrInvalidBetAmount, amount, max(l.MinBet, 1))
+	}
+	if l.MaxBet > 0 && amount > l.MaxBet {
+		return fmt.Errorf("%w: %d is above the maximum bet %d", ErrInvalidBetAmount, amount, l.MaxBet)
+	}
+	return nil
+}
+
+// BetReservation is what a bet adds to the round totals: its stake and its maximum payout
+type BetReservation struct {
+	RoundID string
+	UserID  int64
+	Color   Color
+	Amount  int64
+	Payout  int64
+}
+
+// BetLimitRepository keeps the per round totals the limits are checked against.
+// It is shared by every GS instance so that concurrent bets cannot overshoot a limit.
+type BetLimitRepository interface {
+	// Reserve atomically checks the round limits and adds the bet to the totals.
+	// Returns ErrBetLimitExceeded or ErrExposureLimitExceeded without changing anything when a limit would be exceeded.
+	Reserve(ctx context.Context, reservation BetReservation, limits BetLimits) error
+
+	// Release removes a reserved bet that was not placed (e.g. the wallet deduction failed)
+	Release(ctx context.Context, reservation BetReservation) error
+
+	// Delete evicts the totals of a settled or voided round
+	Delete(ctx context.Context, roundID string) error
+}
@@ -1,30 +1,111 @@
 package domain
 
-import "time"
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // BetOrderStatus defines the status of a bet order
 type BetOrderStatus int
 
 const (
-	BetOrderStatusPending BetOrderStatus = 0 // 待結算
-	BetOrderStatusSettled BetOrderStatus = 1 // 已結算
+	BetOrderStatusPending   BetOrderStatus = 0 // 待結算
+	BetOrderStatusSettled   BetOrderStatus = 1 // 已結算
+	BetOrderStatusRefunded  BetOrderStatus = 2 // 已退款 (回合作廢)
+	BetOrderStatusCancelled BetOrderStatus = 3 // 已取消 (下注期間由玩家取消)
 )
 
+// String returns the status name shown to players and operators
+func (s BetOrderStatus) String() string {
+	switch s {
+	case BetOrderStatusPending:
+		return "PENDING"
+	case BetOrderStatusSettled:
+		return "SETTLED"
+	case BetOrderStatusRefunded:
+		return "REFUNDED"
+	case BetOrderStatusCancelled:
+		return "CANCELLED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
 // BetOrder represents a player's bet order record
 type BetOrder struct {
 	OrderID   string         `gorm:"primaryKey;type:varchar(64)" json:"order_id"`
-	UserID    int64          `gorm:"not null;index:idx_bet_orders_user_id" json:"user_id"`
-	RoundID   string         `gorm:"type:varchar(64);not null;index:idx_bet_orders_round_id" json:"round_id"`
+	UserID    int64          `gorm:"not null;index:idx_bet_orders_user_id;index:idx_bet_orders_user_id_created_at,priority:1;index:idx_bet_orders_user_id_round_id,priority:1" json:"user_id"`
+	RoundID   string         `gorm:"type:varchar(64);not null;index:idx_bet_orders_round_id;index:idx_bet_orders_user_id_round_id,priority:2" json:"round_id"`
 	GameCode  string         `gorm:"type:varchar(32);not null;index:idx_bet_orders_game_code" json:"game_code"`
 	BetArea   string         `gorm:"type:varchar(512);not null" json:"bet_area"` // Bet area/zone (e.g., "red" for color game, "player" for baccarat)
 	Amount    float64        `gorm:"type:decimal(18,2);not null" json:"amount"`
 	Payout    float64        `gorm:"type:decimal(18,2);not null;default:0" json:"payout"`
 	Status    BetOrderStatus `gorm:"type:int;not null;default:0;index:idx_bet_orders_status" json:"status"`
-	CreatedAt time.Time      `gorm:"not null;index:idx_bet_orders_created_at" json:"created_at"`
+	TxIDs     string         `gorm:"type:text;not null;default:''" json:"tx_ids"` // Wallet transactions of the stake, comma separated (see JoinTxIDs)
+	CreatedAt time.Time      `gorm:"not null;index:idx_bet_orders_created_at;index:idx_bet_orders_user_id_created_at,priority:2" json:"created_at"`
 	SettledAt *time.Time     `json:"settled_at"`
+	FlaggedAt *time.Time     `json:"flagged_at"` // Set by reconciliation when the order stayed pending too many rounds
 }
 
 // TableName overrides the table name
 func (BetOrder) TableName() string {
 	return "bet_orders"
 }
+
+// JoinTxIDs stores the wallet transactions of a bet in BetOrder.TxIDs
+func JoinTxIDs(txIDs []string) string {
+	return strings.Join(txIDs, ",")
+}
+
+// HasTxID reports whether txID is one of the wallet transactions of the order
+func (o *BetOrder) HasTxID(txID string) bool {
+	for _, id := range strings.Split(o.TxIDs, ",") {
+		if id == txID {
+			return true
+		}
+	}
+	return false
+}
+
+// BetOrderFilter selects the bet orders of a history query, zero fields match every order
+type BetOrderFilter struct {
+	UserID   int64
+	GameCode string
+	From     time.Time // CreatedAt at or after From
+	To       time.Time // CreatedAt before To
+}
+
+// BetOrderCursor is the position of the last order of a page, orders are listed newest first
+// (CreatedAt, then OrderID descending) and the next page starts after the cursor
+type BetOrderCursor struct {
+	CreatedAt time.Time
+	OrderID   string
+}
+
+// CursorOf returns the cursor that continues a listing after order
+func CursorOf(order *BetOrder) *BetOrderCursor {
+	return &BetOrderCursor{CreatedAt: order.CreatedAt, OrderID: order.OrderID}
+}
+
+// String encodes the cursor as the opaque next_cursor of a page ("<unix nano>:<order ID>")
+func (c *BetOrderCursor) String() string {
+	return strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + ":" + c.OrderID
+}
+
+// ParseBetOrderCursor decodes a cursor returned by String, an empty value is the first page (nil)
+func ParseBetOrderCursor(value string) (*BetOrderCursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+	nanos, orderID, ok := strings.Cut(value, ":")
+	if !ok || orderID == "" {
+		return nil, errors.New("malformed cursor")
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, errors.New("malformed cursor")
+	}
+	return &BetOrderCursor{CreatedAt: time.Unix(0, n), OrderID: orderID}, nil
+}
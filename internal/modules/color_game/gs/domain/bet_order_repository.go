@@ -1,9 +1,37 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // BetOrderRepository defines the interface for bet order persistence
 type BetOrderRepository interface {
-	// BatchCreate creates multiple bet orders in a single transaction
+	// BatchCreate writes multiple bet orders in a single transaction,
+	// orders already written (pending since placement, or by an interrupted settlement) are updated
 	BatchCreate(ctx context.Context, orders []*BetOrder) error
+
+	// SavePending writes the pending orders of placed bets in a single transaction,
+	// a bet increased by another PlaceBet is updated (amount, tx IDs).
+	// Returns ErrBetChanged without writing any order when one of them is no longer pending (cancelled, settled).
+	SavePending(ctx context.Context, orders []*BetOrder) error
+
+	// ListPending returns pending orders not flagged yet, oldest first
+	ListPending(ctx context.Context, limit int) ([]*BetOrder, error)
+
+	// Flag marks pending orders reconciliation found stale
+	Flag(ctx context.Context, orderIDs []string, flaggedAt time.Time) error
+
+	// ListByUser returns the orders of a player newest first, starting after the cursor (nil for the first page)
+	ListByUser(ctx context.Context, userID int64, cursor *BetOrderCursor, limit int) ([]*BetOrder, error)
+
+	// ListByRound returns every order of a round, oldest first
+	ListByRound(ctx context.Context, roundID string) ([]*BetOrder, error)
+
+	// ListByUserRound returns the orders of a player in a round, oldest first
+	ListByUserRound(ctx context.Context, userID int64, roundID string) ([]*BetOrder, error)
+
+	// Search returns the orders matching the filter (game code, date range, player) newest first,
+	// starting after the cursor (nil for the first page)
+	Search(ctx context.Context, filter BetOrderFilter, cursor *BetOrderCursor, limit int) ([]*BetOrder, error)
 }
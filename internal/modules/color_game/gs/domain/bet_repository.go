@@ -16,14 +16,34 @@ type BetRepository interface {
 	// ClearBets clears all bets for a round
 	ClearBets(ctx context.Context, roundID string) error
 
-	// GetBetsForSettlement retrieves bets for settlement.
-	// For Memory repo: returns all bets.
-	// For Redis repo: pops a batch of bets for concurrent processing.
+	// GetBetsForSettlement retrieves the next bets to settle, they stay queued until AckSettled.
+	// For Memory repo: returns all queued bets.
+	// For Redis repo: returns a batch of one settlement queue shard.
 	GetBetsForSettlement(ctx context.Context, roundID string) ([]*Bet, error)
 
+	// AckSettled removes bets from the settlement queue once their bet orders are persisted,
+	// a GS that crashes before the ack leaves them queued for the GS resuming the settlement
+	AckSettled(ctx context.Context, roundID string, bets []*Bet) error
+
 	// GetUserBet retrieves a specific bet for a user in a round for a specific color
 	GetUserBet(ctx context.Context, roundID string, userID int64, color Color) (*Bet, error)
 
-	// UpdateBetAmount updates the amount of an existing bet
-	UpdateBetAmount(ctx context.Context, bet *Bet, additionalAmount int64) error
+	// UpdateBetAmount adds the stake deducted by wallet transaction txID to an existing bet.
+	// Returns ErrBetChanged without changing anything when the stake changed since it was read.
+	UpdateBetAmount(ctx context.Context, bet *Bet, additionalAmount int64, txID string) error
+
+	// SaveBetSlip atomically saves the bets of a bet slip: created bets are new (queued for settlement),
+	// updated bets replace existing bets whose stake grew from stakes[i].
+	// Returns ErrBetChanged without saving any bet when the stake of an updated bet changed since it was read.
+	SaveBetSlip(ctx context.Context, created []*Bet, updated []*Bet, stakes []int64) error
+
+	// CloseBetting marks the bets of a round as final before they are settled or refunded,
+	// CancelBet rejects them atomically from then on
+	CloseBetting(ctx context.Context, roundID string) error
+
+	// CancelBet takes amount back from a bet whose stake is still stake, refunded by wallet transaction refundTxID.
+	// A bet reduced to zero is removed with its settlement queue and user index entries.
+	// Returns ErrBetChanged without changing anything when the stake changed since it was read,
+	// and ErrRoundNotActive once betting of the round was closed by CloseBetting.
+	CancelBet(ctx context.Context, bet *Bet, stake int64, amount int64, refundTxID string) error
 }
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// MaxRequestKeyLength keeps the wallet transaction of a bet ("bet-" + bet_id + "-" + key) within 64 characters
+const MaxRequestKeyLength = 36
+
+// BetRequest is a PlaceBet request deduplicated by its idempotency key (client supplied, unique per player).
+// A replay of a placed request returns its bet instead of deducting the stake again.
+type BetRequest struct {
+	Key     string `json:"key"`
+	UserID  int64  `json:"user_id"`
+	BetID   string `json:"bet_id"` // Empty while the request is being processed
+	RoundID string `json:"round_id"`
+	Color   Color  `json:"color"`
+	Amount  int64  `json:"amount"` // Stake of this request
+
+	Lines []BetLine `json:"lines,omitempty"` // Lines of a bet slip request, with the bet of each line
+}
+
+// ValidRequestKey reports whether key can be used as an idempotency key: at most MaxRequestKeyLength
+// letters, digits, '-' or '_' (it becomes part of a wallet transaction ID)
+func ValidRequestKey(key string) bool {
+	if key == "" || len(key) > MaxRequestKeyLength {
+		return false
+	}
+	for _, c := range key {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// NewRequestKey generates the key of a request the client sent without one, it is never replayed
+func NewRequestKey() string {
+	return generateBetID()
+}
+
+// BetRequestRepository deduplicates PlaceBet requests, shared by every GS instance
+type BetRequestRepository interface {
+	// Acquire reserves the key of a new request for ttl and returns nil.
+	// When the key was reserved before it returns the stored request, whose BetID is empty while it is still processed.
+	Acquire(ctx context.Context, userID int64, key string, ttl time.Duration) (*BetRequest, error)
+
+	// Complete records the bet placed by a request for ttl, replays return it
+	Complete(ctx context.Context, request *BetRequest, ttl time.Duration) error
+
+	// Release forgets a request that placed no bet, the client may retry it with the same key
+	Release(ctx context.Context, userID int64, key string) error
+}
@@ -0,0 +1,31 @@
+package domain
+
+import "fmt"
+
+// BetLine is one color of a bet slip
+type BetLine struct {
+	Color  Color  `json:"color"`
+	Amount int64  `json:"amount"`           // Stake the slip adds on the color
+	BetID  string `json:"bet_id,omitempty"` // Bet the line was placed on, set once the slip is placed
+}
+
+// ValidateBetLines checks the shape of a bet slip: at least one line and each color at most once
+func ValidateBetLines(lines []BetLine) error {
+	if len(lines) == 0 {
+		return fmt.Errorf("%w: empty bet slip", ErrInvalidBetOption)
+	}
+	seen := make(map[Color]bool, len(lines))
+	for _, line := range lines {
+		if seen[line.Color] {
+			return fmt.Errorf("%w: %s appears twice in the bet slip", ErrInvalidBetOption, line.Color)
+		}
+		seen[line.Color] = true
+	}
+	return nil
+}
+
+// SlipTxID is the wallet transaction deducting the stakes of every line of a bet slip at once.
+// It is derived from the slip and the request key, a replayed request never deducts twice.
+func SlipTxID(slipID string, requestKey string) string {
+	return "slip-" + slipID + "-" + requestKey
+}
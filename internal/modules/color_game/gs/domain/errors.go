@@ -0,0 +1,39 @@
+package domain
+
+import "errors"
+
+// ErrTableNotFound is returned when a request names a table that GMS does not host
+var ErrTableNotFound = errors.New("table not found")
+
+// ErrBetIntentNotFound is returned when an operator names a bet intent that does not exist
+var ErrBetIntentNotFound = errors.New("bet intent not found")
+
+// ErrSettlementNotFound is returned when an operator names a round that has no settlement
+var ErrSettlementNotFound = errors.New("settlement not found")
+
+// ErrSettlementLeaseLost stops a settlement whose lease was taken over by another GS
+var ErrSettlementLeaseLost = errors.New("settlement lease lost")
+
+// ErrBetChanged is returned when a bet was changed by a concurrent request while it was being cancelled
+var ErrBetChanged = errors.New("bet changed concurrently")
+
+// PlaceBet and CancelBet rejections, the adapters map each of them to its error code
+var (
+	ErrRoundNotActive        = errors.New("round is not accepting bets")
+	ErrInvalidBetOption      = errors.New("invalid bet option")
+	ErrInvalidBetAmount      = errors.New("invalid bet amount")
+	ErrBetLimitExceeded      = errors.New("bet limit exceeded")
+	ErrExposureLimitExceeded = errors.New("table exposure limit exceeded")
+	ErrInvalidRequestKey     = errors.New("invalid idempotency key")
+	ErrRequestInProgress     = errors.New("request with the same idempotency key is in progress")
+	ErrBetNotFound           = errors.New("no bet to cancel")
+)
+
+// ErrNoBetsToRepeat is returned by Rebet and StartAutoBet when the player has no settled bet slip
+var ErrNoBetsToRepeat = errors.New("no settled bets to repeat")
+
+// ErrInvalidAutoBet is returned when an auto bet asks for no rounds, too many rounds or negative thresholds
+var ErrInvalidAutoBet = errors.New("invalid auto bet")
+
+// ErrInvalidBetHistoryQuery is returned for a malformed cursor or a date range that ends before it starts
+var ErrInvalidBetHistoryQuery = errors.New("invalid bet history query")
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+)
+
+// PayoutStatus is the delivery state of a settlement payout
+type PayoutStatus int
+
+const (
+	PayoutStatusPending PayoutStatus = 0 // 等待派彩 (失敗後由 Worker 重試)
+	PayoutStatusPaid    PayoutStatus = 1 // 已入帳並通知玩家
+)
+
+// String returns the status name shown to operators
+func (s PayoutStatus) String() string {
+	switch s {
+	case PayoutStatusPending:
+		return "PENDING"
+	case PayoutStatusPaid:
+		return "PAID"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// Payout is the winnings a settled bet owes its player (payout outbox).
+// It is written together with the bet orders of its settlement batch, the deposit is retried with backoff
+// until the wallet accepts it and the player is notified once it lands.
+// The refund of a stake cancelled during betting goes through the outbox as well, it has no dice.
+type Payout struct {
+	TxID        string       `gorm:"primaryKey;type:varchar(64)" json:"tx_id"` // Wallet transaction of the deposit (idempotency key)
+	BetID       string       `gorm:"type:varchar(64);not null" json:"bet_id"`
+	TableID     string       `gorm:"type:varchar(32);not null" json:"table_id"`
+	RoundID     string       `gorm:"type:varchar(64);not null;index:idx_payouts_round_id" json:"round_id"`
+	UserID      int64        `gorm:"not null;index:idx_payouts_user_id" json:"user_id"`
+	Color       Color        `gorm:"type:int;not null" json:"color"`
+	BetAmount   int64        `gorm:"not null" json:"bet_amount"`
+	Amount      int64        `gorm:"not null" json:"amount"`                 // Winnings to deposit
+	Dice        string       `gorm:"type:varchar(128);not null" json:"dice"` // Drawn colors, see EncodeDice (empty for a cancel refund)
+	Status      PayoutStatus `gorm:"type:int;not null;default:0;index:idx_payouts_status_next_retry_at,priority:1" json:"status"`
+	Attempts    int          `gorm:"not null;default:0" json:"attempts"`                                              // Deposit attempts
+	LastError   string       `gorm:"type:text" json:"last_error"`                                                     // Error of the last failed deposit
+	NextRetryAt time.Time    `gorm:"not null;index:idx_payouts_status_next_retry_at,priority:2" json:"next_retry_at"` // When the worker picks the payout up
+	CreatedAt   time.Time    `gorm:"not null" json:"created_at"`
+	UpdatedAt   time.Time    `gorm:"not null" json:"updated_at"`
+	PaidAt      *time.Time   `json:"paid_at"`
+}
+
+// TableName overrides the table name
+func (Payout) TableName() string {
+	return "payouts"
+}
+
+// PayoutTxID is the wallet transaction of the payout of a bet, the same bet is never paid twice
+func PayoutTxID(betID string) string {
+	return "payout-" + betID
+}
+
+// EncodeDice stores drawn colors as a comma separated list of names, e.g. "REWARD_RED,REWARD_RED,REWARD_BLUE"
+func EncodeDice(dice []Color) string {
+	names := make([]string, len(dice))
+	for i, die := range dice {
+		names[i] = die.String()
+	}
+	return strings.Join(names, ",")
+}
+
+// DecodeDice parses colors stored by EncodeDice, unknown names are dropped
+func DecodeDice(value string) []Color {
+	var dice []Color
+	for _, name := range strings.Split(value, ",") {
+		if v, ok := pbColorGame.ColorGameReward_value[name]; ok {
+			dice = append(dice, Color(v))
+		}
+	}
+	return dice
+}
+
+// PayoutRepository stores the payout outbox durably, shared by every GS instance
+type PayoutRepository interface {
+	// CreateWithOrders persists the bet orders of a settlement batch and the payouts they owe in one transaction
+	CreateWithOrders(ctx context.Context, orders []*BetOrder, payouts []*Payout) error
+
+	// Update saves the status, attempts, last error and next retry of a payout
+	Update(ctx context.Context, payout *Payout) error
+
+	// ListDue returns pending payouts whose NextRetryAt is not after now, oldest first
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*Payout, error)
+}
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// LastBetSlip is what a player staked in the last round of theirs that was settled, Rebet places it again
+type LastBetSlip struct {
+	RoundID string
+	Lines   []BetLine // One line per color, in color order
+}
+
+// Scale returns the lines of the slip with every stake multiplied (2 doubles the slip)
+func (s *LastBetSlip) Scale(multiplier int64) []BetLine {
+	lines := make([]BetLine, len(s.Lines))
+	for i, line := range s.Lines {
+		lines[i] = BetLine{Color: line.Color, Amount: line.Amount * multiplier}
+	}
+	return lines
+}
+
+// LastBetSlipRepository remembers the last settled bet slip of each player, shared by every GS instance
+type LastBetSlipRepository interface {
+	// Record adds settled bets of a player in a round to the slip. Bets of another round replace the slip,
+	// a color recorded again (resumed settlement) is overwritten.
+	Record(ctx context.Context, userID int64, roundID string, lines []BetLine) error
+
+	// Get returns the last settled slip of a player, nil when there is none
+	Get(ctx context.Context, userID int64) (*LastBetSlip, error)
+}
+
+// AutoBet repeats a bet slip in every round of a table until its rounds are used up or a stop threshold is reached.
+// The thresholds compare the wallet balance with the balance the auto bet started with.
+type AutoBet struct {
+	ID           string // Tells a restarted auto bet of the player apart from the one it replaced
+	UserID       int64
+	TableID      string
+	Lines        []BetLine // Slip placed in every round
+	RoundsLeft   int       // Rounds still to bet
+	StopLoss     int64     // Stop once the balance dropped by this much, 0 = no threshold
+	StopWin      int64     // Stop once the balance grew by this much, 0 = no threshold
+	StartBalance int64
+	LastRoundID  string // Last round the slip was placed in
+	CreatedAt    time.Time
+}
+
+// StopReason returns why an auto bet must stop at balance, empty while it goes on
+func (a *AutoBet) StopReason(balance int64) string {
+	switch {
+	case a.RoundsLeft <= 0:
+		return "rounds completed"
+	case a.StopLoss > 0 && a.StartBalance-balance >= a.StopLoss:
+		return "loss threshold reached"
+	case a.StopWin > 0 && balance-a.StartBalance >= a.StopWin:
+		return "win threshold reached"
+	}
+	return ""
+}
+
+// AutoBetRepository keeps the auto bets players opted in to, one per player, shared by every GS instance
+type AutoBetRepository interface {
+	// Save creates or replaces the auto bet of a player
+	Save(ctx context.Context, autoBet *AutoBet) error
+
+	// Get returns the auto bet of a player, nil when there is none
+	Get(ctx context.Context, userID int64) (*AutoBet, error)
+
+	// List returns every active auto bet
+	List(ctx context.Context) ([]*AutoBet, error)
+
+	// Advance records that auto bet autoBetID placed its slip in roundID and takes one round off.
+	// It returns false when the round was already recorded or the auto bet was stopped or replaced meanwhile.
+	Advance(ctx context.Context, userID int64, autoBetID string, roundID string) (bool, error)
+
+	// Finish removes auto bet autoBetID of a player, an auto bet that replaced it is kept
+	Finish(ctx context.Context, userID int64, autoBetID string) error
+
+	// Delete stops the auto bet of a player
+	Delete(ctx context.Context, userID int64) error
+}
@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// SettlementStatus is the progress of the settlement (or void refund) of a round
+type SettlementStatus int
+
+const (
+	SettlementStatusSettling SettlementStatus = 0 // 結算中，由持有租約的 GS 處理
+	SettlementStatusSettled  SettlementStatus = 1 // 已完成
+)
+
+// String returns the status name shown to operators
+func (s SettlementStatus) String() string {
+	switch s {
+	case SettlementStatusSettling:
+		return "SETTLING"
+	case SettlementStatusSettled:
+		return "SETTLED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ParseSettlementStatus parses a status name as returned by String
+func ParseSettlementStatus(name string) (SettlementStatus, bool) {
+	for status := SettlementStatusSettling; status <= SettlementStatusSettled; status++ {
+		if status.String() == name {
+			return status, true
+		}
+	}
+	return 0, false
+}
+
+// RoundSettlement is the checkpoint of settling a round: claimed by one GS under a lease,
+// updated after every persisted batch and finished once every bet is settled.
+// A GS that finds an unfinished settlement whose lease expired takes it over and completes it.
+type RoundSettlement struct {
+	RoundID     string           `gorm:"primaryKey;type:varchar(64)" json:"round_id"`
+	TableID     string           `gorm:"type:varchar(32);not null" json:"table_id"`
+	Dice        string           `gorm:"type:varchar(128);not null;default:''" json:"dice"`        // Drawn colors (EncodeDice), empty for a voided round
+	VoidReason  string           `gorm:"type:varchar(255);not null;default:''" json:"void_reason"` // Set when the round is refunded instead of settled
+	Status      SettlementStatus `gorm:"type:int;not null;default:0;index:idx_round_settlements_status_lease_until,priority:1" json:"status"`
+	Owner       string           `gorm:"type:varchar(128);not null" json:"owner"` // GS instance holding the lease
+	LeaseUntil  time.Time        `gorm:"not null;index:idx_round_settlements_status_lease_until,priority:2" json:"lease_until"`
+	Batches     int              `gorm:"not null;default:0" json:"batches"`      // Persisted batches
+	BetCount    int              `gorm:"not null;default:0" json:"bet_count"`    // Settled (or refunded) bets
+	TotalPayout int64            `gorm:"not null;default:0" json:"total_payout"` // Winnings (or refunds) of the settled bets
+	Attempts    int              `gorm:"not null;default:0" json:"attempts"`     // Claims, more than one means the settlement was resumed
+	LastError   string           `gorm:"type:text" json:"last_error"`
+	CreatedAt   time.Time        `gorm:"not null" json:"created_at"`
+	UpdatedAt   time.Time        `gorm:"not null" json:"updated_at"`
+	FinishedAt  *time.Time       `json:"finished_at"`
+}
+
+// TableName overrides the table name
+func (RoundSettlement) TableName() string {
+	return "round_settlements"
+}
+
+// IsVoid reports whether the round is refunded rather than settled
+func (s *RoundSettlement) IsVoid() bool {
+	return s.VoidReason != ""
+}
+
+// SettlementRepository stores settlement checkpoints durably, shared by every GS instance
+type SettlementRepository interface {
+	// Claim takes the lease of a round for owner until leaseUntil. It creates the settlement when it does not exist,
+	// otherwise it only succeeds while the settlement is SETTLING and its lease expired. An active lease is not claimed
+	// again by its owner either, a repeated RESULT must not settle the round twice.
+	// On success settlement is loaded with the stored record (result, progress), false when another GS holds it or it is done.
+	Claim(ctx context.Context, settlement *RoundSettlement, owner string, now time.Time, leaseUntil time.Time) (bool, error)
+
+	// Checkpoint saves the progress of a settlement and extends its lease, false when owner lost the lease
+	Checkpoint(ctx context.Context, settlement *RoundSettlement) (bool, error)
+
+	// Renew extends the lease of a SETTLING settlement still held by owner until leaseUntil, false when owner lost it
+	Renew(ctx context.Context, roundID string, owner string, leaseUntil time.Time) (bool, error)
+
+	// Get returns the settlement of a round, nil when it does not exist
+	Get(ctx context.Context, roundID string) (*RoundSettlement, error)
+
+	// ListExpired returns SETTLING settlements whose lease expired before now, oldest first
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]*RoundSettlement, error)
+
+	// List returns the settlements in a status, newest first (operators)
+	List(ctx context.Context, status SettlementStatus, limit int) ([]*RoundSettlement, error)
+}
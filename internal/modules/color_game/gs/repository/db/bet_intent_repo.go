@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	"gorm.io/gorm"
+)
+
+// BetIntentRepository implements domain.BetIntentRepository using the bet_intents table
+type BetIntentRepository struct {
+	db *gorm.DB
+}
+
+func NewBetIntentRepository(db *gorm.DB) *BetIntentRepository {
+	return &BetIntentRepository{db: db}
+}
+
+func (r *BetIntentRepository) Create(ctx context.Context, intent *domain.BetIntent) error {
+	return r.db.WithContext(ctx).Create(intent).Error
+}
+
+func (r *BetIntentRepository) Get(ctx context.Context, txID string) (*domain.BetIntent, error) {
+	var intent domain.BetIntent
+	err := r.db.WithContext(ctx).Where("tx_id = ?", txID).First(&intent).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &intent, nil
+}
+
+// Transition is a conditional UPDATE, the row count tells whether this caller won the intent
+func (r *BetIntentRepository) Transition(ctx context.Context, txID string, from domain.BetIntentStatus, to domain.BetIntentStatus) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&domain.BetIntent{}).
+		Where("tx_id = ? AND status = ?", txID, from).
+		Updates(map[string]interface{}{"status": to, "updated_at": time.Now()})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *BetIntentRepository) Update(ctx context.Context, intent *domain.BetIntent) error {
+	return r.db.WithContext(ctx).Model(&domain.BetIntent{}).
+		Where("tx_id = ?", intent.TxID).
+		Updates(map[string]interface{}{
+			"status":        intent.Status,
+			"attempts":      intent.Attempts,
+			"last_error":    intent.LastError,
+			"next_retry_at": intent.NextRetryAt,
+			"updated_at":    intent.UpdatedAt,
+		}).Error
+}
+
+func (r *BetIntentRepository) Delete(ctx context.Context, txID string) error {
+	return r.db.WithContext(ctx).Where("tx_id = ?", txID).Delete(&domain.BetIntent{}).Error
+}
+
+func (r *BetIntentRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.BetIntent, error) {
+	var intents []*domain.BetIntent
+	err := r.db.WithContext(ctx).
+		Where("status <> ? AND next_retry_at <= ?", domain.BetIntentStatusCompensated, now).
+		Order("created_at").
+		Limit(limit).
+		Find(&intents).Error
+	return intents, err
+}
+
+func (r *BetIntentRepository) List(ctx context.Context, status domain.BetIntentStatus, limit int) ([]*domain.BetIntent, error) {
+	var intents []*domain.BetIntent
+	err := r.db.WithContext(ctx).
+		Where("status = ?", status).
+		Order("created_at").
+		Limit(limit).
+		Find(&intents).Error
+	return intents, err
+}
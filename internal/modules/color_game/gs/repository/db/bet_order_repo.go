@@ -2,9 +2,11 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type BetOrderRepository struct {
@@ -19,6 +21,105 @@ func (r *BetOrderRepository) BatchCreate(ctx context.Context, orders []*domain.B
 	if len(orders) == 0 {
 		return nil
 	}
-	// UseCase layer handles batching, so we just insert all records here
-	return r.db.WithContext(ctx).Create(&orders).Error
+	// UseCase layer handles batching, so we just upsert all records here.
+	// Pending orders written at placement become settled (refunded), a resumed batch is written again with the same values.
+	return r.db.WithContext(ctx).Clauses(settleOrderConflict).Create(&orders).Error
+}
+
+// settleOrderConflict updates the settlement columns of an existing order
+var settleOrderConflict = clause.OnConflict{
+	Columns:   []clause.Column{{Name: "order_id"}},
+	DoUpdates: clause.AssignmentColumns([]string{"amount", "payout", "status", "tx_ids", "settled_at"}),
+}
+
+// pendingOrderConflict only raises an order that is still pending, a cancelled or settled order is left as it is
+var pendingOrderConflict = clause.OnConflict{
+	Columns:   []clause.Column{{Name: "order_id"}},
+	DoUpdates: clause.AssignmentColumns([]string{"amount", "tx_ids"}),
+	Where: clause.Where{Exprs: []clause.Expression{
+		clause.Eq{Column: clause.Column{Table: "bet_orders", Name: "status"}, Value: domain.BetOrderStatusPending},
+	}},
+}
+
+// SavePending upserts the orders in one INSERT, the orders of a bet slip are written together or not at all.
+// An order the upsert skipped (no longer pending) rolls the whole INSERT back with ErrBetChanged.
+func (r *BetOrderRepository) SavePending(ctx context.Context, orders []*domain.BetOrder) error {
+	if len(orders) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(pendingOrderConflict).Create(&orders)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected != int64(len(orders)) {
+			return domain.ErrBetChanged
+		}
+		return nil
+	})
+}
+
+func (r *BetOrderRepository) ListPending(ctx context.Context, limit int) ([]*domain.BetOrder, error) {
+	var orders []*domain.BetOrder
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND flagged_at IS NULL", domain.BetOrderStatusPending).
+		Order("created_at").
+		Limit(limit).
+		Find(&orders).Error
+	return orders, err
+}
+
+func (r *BetOrderRepository) Flag(ctx context.Context, orderIDs []string, flaggedAt time.Time) error {
+	if len(orderIDs) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Model(&domain.BetOrder{}).
+		Where("order_id IN ? AND status = ?", orderIDs, domain.BetOrderStatusPending).
+		Update("flagged_at", flaggedAt).Error
+}
+
+func (r *BetOrderRepository) ListByUser(ctx context.Context, userID int64, cursor *domain.BetOrderCursor, limit int) ([]*domain.BetOrder, error) {
+	return r.Search(ctx, domain.BetOrderFilter{UserID: userID}, cursor, limit)
+}
+
+func (r *BetOrderRepository) ListByRound(ctx context.Context, roundID string) ([]*domain.BetOrder, error) {
+	var orders []*domain.BetOrder
+	err := r.db.WithContext(ctx).
+		Where("round_id = ?", roundID).
+		Order("created_at, order_id").
+		Find(&orders).Error
+	return orders, err
+}
+
+func (r *BetOrderRepository) ListByUserRound(ctx context.Context, userID int64, roundID string) ([]*domain.BetOrder, error) {
+	var orders []*domain.BetOrder
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND round_id = ?", userID, roundID).
+		Order("created_at, order_id").
+		Find(&orders).Error
+	return orders, err
+}
+
+func (r *BetOrderRepository) Search(ctx context.Context, filter domain.BetOrderFilter, cursor *domain.BetOrderCursor, limit int) ([]*domain.BetOrder, error) {
+	query := r.db.WithContext(ctx).Model(&domain.BetOrder{})
+	if filter.UserID != 0 {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.GameCode != "" {
+		query = query.Where("game_code = ?", filter.GameCode)
+	}
+	if !filter.From.IsZero() {
+		query = query.Where("created_at >= ?", filter.From)
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("created_at < ?", filter.To)
+	}
+	// Keyset pagination: orders sharing the created_at of the cursor continue by order ID
+	if cursor != nil {
+		query = query.Where("(created_at < ? OR (created_at = ? AND order_id < ?))", cursor.CreatedAt, cursor.CreatedAt, cursor.OrderID)
+	}
+
+	var orders []*domain.BetOrder
+	err := query.Order("created_at DESC, order_id DESC").Limit(limit).Find(&orders).Error
+	return orders, err
 }
@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PayoutRepository implements domain.PayoutRepository using the payouts and bet_orders tables
+type PayoutRepository struct {
+	db *gorm.DB
+}
+
+func NewPayoutRepository(db *gorm.DB) *PayoutRepository {
+	return &PayoutRepository{db: db}
+}
+
+// CreateWithOrders inserts the bet orders and the payouts in one transaction, a settled win is never left without its payout.
+// Pending orders are settled in place, payouts of an interrupted settlement are kept as they are (a paid one is not reset).
+func (r *PayoutRepository) CreateWithOrders(ctx context.Context, orders []*domain.BetOrder, payouts []*domain.Payout) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(orders) > 0 {
+			if err := tx.Clauses(settleOrderConflict).Create(&orders).Error; err != nil {
+				return err
+			}
+		}
+		if len(payouts) > 0 {
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&payouts).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *PayoutRepository) Update(ctx context.Context, payout *domain.Payout) error {
+	return r.db.WithContext(ctx).Model(&domain.Payout{}).
+		Where("tx_id = ?", payout.TxID).
+		Updates(map[string]interface{}{
+			"status":        payout.Status,
+			"attempts":      payout.Attempts,
+			"last_error":    payout.LastError,
+			"next_retry_at": payout.NextRetryAt,
+			"updated_at":    payout.UpdatedAt,
+			"paid_at":       payout.PaidAt,
+		}).Error
+}
+
+func (r *PayoutRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.Payout, error) {
+	var payouts []*domain.Payout
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND next_retry_at <= ?", domain.PayoutStatusPending, now).
+		Order("created_at").
+		Limit(limit).
+		Find(&payouts).Error
+	return payouts, err
+}
@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SettlementRepository implements domain.SettlementRepository using the round_settlements table
+type SettlementRepository struct {
+	db *gorm.DB
+}
+
+func NewSettlementRepository(db *gorm.DB) *SettlementRepository {
+	return &SettlementRepository{db: db}
+}
+
+// Claim inserts the settlement or takes over an expired lease with a conditional UPDATE, the row count tells who won.
+// An active lease is never claimed again, not even by its owner: a repeated RESULT must not start a second settlement.
+func (r *SettlementRepository) Claim(ctx context.Context, settlement *domain.RoundSettlement, owner string, now time.Time, leaseUntil time.Time) (bool, error) {
+	claimed := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		created := *settlement
+		created.Status = domain.SettlementStatusSettling
+		created.Owner = owner
+		created.LeaseUntil = leaseUntil
+		created.Attempts = 1
+		created.CreatedAt = now
+		created.UpdatedAt = now
+		inserted := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&created)
+		if inserted.Error != nil {
+			return inserted.Error
+		}
+		if inserted.RowsAffected == 1 {
+			claimed = true
+			*settlement = created
+			return nil
+		}
+
+		result := tx.Model(&domain.RoundSettlement{}).
+			Where("round_id = ? AND status = ? AND lease_until < ?", settlement.RoundID, domain.SettlementStatusSettling, now).
+			Updates(map[string]interface{}{
+				"owner":       owner,
+				"lease_until": leaseUntil,
+				"attempts":    gorm.Expr("attempts + 1"),
+				"updated_at":  now,
+			})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		claimed = true
+		return tx.Where("round_id = ?", settlement.RoundID).First(settlement).Error
+	})
+	return claimed, err
+}
+
+// Checkpoint only updates a settlement still leased by its owner
+func (r *SettlementRepository) Checkpoint(ctx context.Context, settlement *domain.RoundSettlement) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&domain.RoundSettlement{}).
+		Where("round_id = ? AND owner = ? AND status = ?", settlement.RoundID, settlement.Owner, domain.SettlementStatusSettling).
+		Updates(map[string]interface{}{
+			"status":       settlement.Status,
+			"lease_until":  settlement.LeaseUntil,
+			"batches":      settlement.Batches,
+			"bet_count":    settlement.BetCount,
+			"total_payout": settlement.TotalPayout,
+			"last_error":   settlement.LastError,
+			"updated_at":   settlement.UpdatedAt,
+			"finished_at":  settlement.FinishedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// Renew only extends a lease still held by its owner, the progress is left to Checkpoint
+func (r *SettlementRepository) Renew(ctx context.Context, roundID string, owner string, leaseUntil time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&domain.RoundSettlement{}).
+		Where("round_id = ? AND owner = ? AND status = ?", roundID, owner, domain.SettlementStatusSettling).
+		Update("lease_until", leaseUntil)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+func (r *SettlementRepository) Get(ctx context.Context, roundID string) (*domain.RoundSettlement, error) {
+	var settlement domain.RoundSettlement
+	err := r.db.WithContext(ctx).Where("round_id = ?", roundID).First(&settlement).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &settlement, nil
+}
+
+func (r *SettlementRepository) ListExpired(ctx context.Context, now time.Time, limit int) ([]*domain.RoundSettlement, error) {
+	var settlements []*domain.RoundSettlement
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND lease_until < ?", domain.SettlementStatusSettling, now).
+		Order("created_at").
+		Limit(limit).
+		Find(&settlements).Error
+	return settlements, err
+}
+
+func (r *SettlementRepository) List(ctx context.Context, status domain.SettlementStatus, limit int) ([]*domain.RoundSettlement, error) {
+	var settlements []*domain.RoundSettlement
+	err := r.db.WithContext(ctx).
+		Where("status = ?", status).
+		Order("created_at DESC").
+		Limit(limit).
+		Find(&settlements).Error
+	return settlements, err
+}
@@ -0,0 +1,106 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+)
+
+// BetIntentRepository implements domain.BetIntentRepository using memory.
+// Intents are lost on restart, use the DB implementation in production.
+type BetIntentRepository struct {
+	intents map[string]*domain.BetIntent
+	mu      sync.Mutex
+}
+
+// NewBetIntentRepository creates a new memory bet intent repository
+func NewBetIntentRepository() *BetIntentRepository {
+	return &BetIntentRepository{intents: make(map[string]*domain.BetIntent)}
+}
+
+func (r *BetIntentRepository) Create(ctx context.Context, intent *domain.BetIntent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *intent
+	r.intents[intent.TxID] = &stored
+	return nil
+}
+
+func (r *BetIntentRepository) Get(ctx context.Context, txID string) (*domain.BetIntent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	intent, ok := r.intents[txID]
+	if !ok {
+		return nil, nil
+	}
+	copied := *intent
+	return &copied, nil
+}
+
+func (r *BetIntentRepository) Transition(ctx context.Context, txID string, from domain.BetIntentStatus, to domain.BetIntentStatus) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	intent, ok := r.intents[txID]
+	if !ok || intent.Status != from {
+		return false, nil
+	}
+	intent.Status = to
+	intent.UpdatedAt = time.Now()
+	return true, nil
+}
+
+func (r *BetIntentRepository) Update(ctx context.Context, intent *domain.BetIntent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *intent
+	r.intents[intent.TxID] = &stored
+	return nil
+}
+
+func (r *BetIntentRepository) Delete(ctx context.Context, txID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.intents, txID)
+	return nil
+}
+
+func (r *BetIntentRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.BetIntent, error) {
+	return r.list(limit, func(intent *domain.BetIntent) bool {
+		return intent.Status != domain.BetIntentStatusCompensated && !intent.NextRetryAt.After(now)
+	}), nil
+}
+
+func (r *BetIntentRepository) List(ctx context.Context, status domain.BetIntentStatus, limit int) ([]*domain.BetIntent, error) {
+	return r.list(limit, func(intent *domain.BetIntent) bool {
+		return intent.Status == status
+	}), nil
+}
+
+// list returns copies of the matching intents, oldest first
+func (r *BetIntentRepository) list(limit int, match func(*domain.BetIntent) bool) []*domain.BetIntent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	intents := make([]*domain.BetIntent, 0)
+	for _, intent := range r.intents {
+		if match(intent) {
+			copied := *intent
+			intents = append(intents, &copied)
+		}
+	}
+	sort.Slice(intents, func(i, j int) bool {
+		return intents[i].CreatedAt.Before(intents[j].CreatedAt)
+	})
+	if limit > 0 && len(intents) > limit {
+		intents = intents[:limit]
+	}
+	return intents
+}
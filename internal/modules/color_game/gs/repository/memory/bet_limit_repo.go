@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+)
+
+type playerColor struct {
+	userID int64
+	color  domain.Color
+}
+
+type roundLimits struct {
+	playerTotals map[int64]int64        // userID -> stake on the round
+	colorTotals  map[playerColor]int64  // user and color -> stake
+	exposure     map[domain.Color]int64 // color -> potential payout of every bet on it
+}
+
+// BetLimitRepository implements domain.BetLimitRepository using memory.
+// It is only correct for a single GS process, use the Redis implementation for several instances.
+type BetLimitRepository struct {
+	rounds map[string]*roundLimits
+	mu     sync.Mutex
+}
+
+// NewBetLimitRepository creates a new memory bet limit repository
+func NewBetLimitRepository() *BetLimitRepository {
+	return &BetLimitRepository{rounds: make(map[string]*roundLimits)}
+}
+
+func (r *BetLimitRepository) Reserve(ctx context.Context, res domain.BetReservation, limits domain.BetLimits) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	round, ok := r.rounds[res.RoundID]
+	if !ok {
+		round = &roundLimits{
+			playerTotals: make(map[int64]int64),
+			colorTotals:  make(map[playerColor]int64),
+			exposure:     make(map[domain.Color]int64),
+		}
+		r.rounds[res.RoundID] = round
+	}
+
+	key := playerColor{userID: res.UserID, color: res.Color}
+	if limits.MaxRoundBet > 0 && round.playerTotals[res.UserID]+res.Amount > limits.MaxRoundBet {
+		return fmt.Errorf("%w: round total would exceed %d", domain.ErrBetLimitExceeded, limits.MaxRoundBet)
+	}
+	if limits.MaxColorBet > 0 && round.colorTotals[key]+res.Amount > limits.MaxColorBet {
+		return fmt.Errorf("%w: %s total would exceed %d", domain.ErrBetLimitExceeded, res.Color, limits.MaxColorBet)
+	}
+	if limits.MaxExposure > 0 && round.exposure[res.Color]+res.Payout > limits.MaxExposure {
+		return fmt.Errorf("%w: %s payout would exceed %d", domain.ErrExposureLimitExceeded, res.Color, limits.MaxExposure)
+	}
+
+	round.playerTotals[res.UserID] += res.Amount
+	round.colorTotals[key] += res.Amount
+	round.exposure[res.Color] += res.Payout
+	return nil
+}
+
+func (r *BetLimitRepository) Release(ctx context.Context, res domain.BetReservation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	round, ok := r.rounds[res.RoundID]
+	if !ok {
+		return nil
+	}
+	round.playerTotals[res.UserID] -= res.Amount
+	round.colorTotals[playerColor{userID: res.UserID, color: res.Color}] -= res.Amount
+	round.exposure[res.Color] -= res.Payout
+	return nil
+}
+
+func (r *BetLimitRepository) Delete(ctx context.Context, roundID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.rounds, roundID)
+	return nil
+}
@@ -3,6 +3,7 @@ package memory
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
@@ -12,6 +13,7 @@ import (
 type BetRepository struct {
 	bets            map[string][]*domain.Bet // roundID -> bets (History for GetUserBets)
 	settlementQueue map[string][]*domain.Bet // roundID -> bets (Queue for GetBetsForSettlement)
+	closed          map[string]bool          // roundID -> betting closed (CloseBetting)
 	mu              sync.RWMutex
 }
 
@@ -20,6 +22,7 @@ func NewBetRepository() *BetRepository {
 	return &BetRepository{
 		bets:            make(map[string][]*domain.Bet),
 		settlementQueue: make(map[string][]*domain.Bet),
+		closed:          make(map[string]bool),
 	}
 }
 
@@ -75,6 +78,7 @@ func (r *BetRepository) ClearBets(ctx context.Context, roundID string) error {
 
 	delete(r.bets, roundID)
 	delete(r.settlementQueue, roundID)
+	delete(r.closed, roundID)
 	return nil
 }
 
@@ -87,11 +91,32 @@ func (r *BetRepository) GetBetsForSettlement(ctx context.Context, roundID string
 		return nil, nil
 	}
 
-	// Return all bets in the queue and clear the queue
-	// This simulates "popping" all items
-	delete(r.settlementQueue, roundID)
+	// Return all bets in the queue, they are removed by AckSettled
+	return append([]*domain.Bet(nil), bets...), nil
+}
 
-	return bets, nil
+func (r *BetRepository) AckSettled(ctx context.Context, roundID string, bets []*domain.Bet) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	settled := make(map[string]bool, len(bets))
+	for _, bet := range bets {
+		settled[bet.BetID] = true
+	}
+
+	queue := r.settlementQueue[roundID]
+	remaining := make([]*domain.Bet, 0, len(queue))
+	for _, bet := range queue {
+		if !settled[bet.BetID] {
+			remaining = append(remaining, bet)
+		}
+	}
+	if len(remaining) == 0 {
+		delete(r.settlementQueue, roundID)
+	} else {
+		r.settlementQueue[roundID] = remaining
+	}
+	return nil
 }
 
 func (r *BetRepository) GetUserBet(ctx context.Context, roundID string, userID int64, color domain.Color) (*domain.Bet, error) {
@@ -108,12 +133,97 @@ func (r *BetRepository) GetUserBet(ctx context.Context, roundID string, userID i
 	return nil, nil // Not found
 }
 
-func (r *BetRepository) UpdateBetAmount(ctx context.Context, bet *domain.Bet, additionalAmount int64) error {
+func (r *BetRepository) UpdateBetAmount(ctx context.Context, bet *domain.Bet, additionalAmount int64, txID string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	// Update the bet amount (bet is a pointer, so it updates in place)
-	bet.Amount += additionalAmount
+	stored := r.findBet(bet.RoundID, bet.BetID)
+	if stored == nil || stored.Amount != bet.Amount {
+		return domain.ErrBetChanged
+	}
+
+	stored.Amount += additionalAmount
+	stored.TxIDs = append(stored.TxIDs, txID)
+	if stored != bet {
+		bet.Amount = stored.Amount
+		bet.TxIDs = append([]string(nil), stored.TxIDs...)
+	}
+	return nil
+}
+
+func (r *BetRepository) SaveBetSlip(ctx context.Context, created []*domain.Bet, updated []*domain.Bet, stakes []int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 
+	stored := make([]*domain.Bet, len(updated))
+	for i, bet := range updated {
+		stored[i] = r.findBet(bet.RoundID, bet.BetID)
+		if stored[i] == nil || stored[i].Amount != stakes[i] {
+			return domain.ErrBetChanged
+		}
+	}
+	for i, bet := range updated {
+		*stored[i] = *bet
+	}
+	for _, bet := range created {
+		r.bets[bet.RoundID] = append(r.bets[bet.RoundID], bet)
+		r.settlementQueue[bet.RoundID] = append(r.settlementQueue[bet.RoundID], bet)
+	}
 	return nil
 }
+
+func (r *BetRepository) CloseBetting(ctx context.Context, roundID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.closed[roundID] = true
+	return nil
+}
+
+func (r *BetRepository) CancelBet(ctx context.Context, bet *domain.Bet, stake int64, amount int64, refundTxID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed[bet.RoundID] {
+		return fmt.Errorf("%w: betting closed for round %s", domain.ErrRoundNotActive, bet.RoundID)
+	}
+	stored := r.findBet(bet.RoundID, bet.BetID)
+	if stored == nil || stored.Amount != stake {
+		return domain.ErrBetChanged
+	}
+
+	stored.Amount -= amount
+	stored.RefundTxIDs = append(stored.RefundTxIDs, refundTxID)
+	if stored != bet {
+		bet.Amount = stored.Amount
+		bet.RefundTxIDs = append([]string(nil), stored.RefundTxIDs...)
+	}
+	if stored.Amount > 0 {
+		return nil
+	}
+
+	r.bets[bet.RoundID] = removeBet(r.bets[bet.RoundID], bet.BetID)
+	r.settlementQueue[bet.RoundID] = removeBet(r.settlementQueue[bet.RoundID], bet.BetID)
+	return nil
+}
+
+// findBet returns the stored bet betID of a round, nil when it is not stored, the caller holds mu
+func (r *BetRepository) findBet(roundID string, betID string) *domain.Bet {
+	for _, bet := range r.bets[roundID] {
+		if bet.BetID == betID {
+			return bet
+		}
+	}
+	return nil
+}
+
+// removeBet returns bets without the bet betID
+func removeBet(bets []*domain.Bet, betID string) []*domain.Bet {
+	remaining := make([]*domain.Bet, 0, len(bets))
+	for _, bet := range bets {
+		if bet.BetID != betID {
+			remaining = append(remaining, bet)
+		}
+	}
+	return remaining
+}
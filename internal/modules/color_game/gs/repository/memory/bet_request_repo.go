@@ -0,0 +1,67 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+)
+
+type requestKey struct {
+	userID int64
+	key    string
+}
+
+type storedRequest struct {
+	request   domain.BetRequest
+	expiresAt time.Time
+}
+
+// BetRequestRepository implements domain.BetRequestRepository using memory.
+// It is only correct for a single GS process, use the Redis implementation for several instances.
+type BetRequestRepository struct {
+	requests map[requestKey]*storedRequest
+	mu       sync.Mutex
+}
+
+// NewBetRequestRepository creates a new memory bet request repository
+func NewBetRequestRepository() *BetRequestRepository {
+	return &BetRequestRepository{requests: make(map[requestKey]*storedRequest)}
+}
+
+func (r *BetRequestRepository) Acquire(ctx context.Context, userID int64, key string, ttl time.Duration) (*domain.BetRequest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	id := requestKey{userID: userID, key: key}
+	if stored, ok := r.requests[id]; ok && now.Before(stored.expiresAt) {
+		request := stored.request
+		return &request, nil
+	}
+	r.requests[id] = &storedRequest{
+		request:   domain.BetRequest{Key: key, UserID: userID},
+		expiresAt: now.Add(ttl),
+	}
+	return nil, nil
+}
+
+func (r *BetRequestRepository) Complete(ctx context.Context, request *domain.BetRequest, ttl time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests[requestKey{userID: request.UserID, key: request.Key}] = &storedRequest{
+		request:   *request,
+		expiresAt: time.Now().Add(ttl),
+	}
+	return nil
+}
+
+func (r *BetRequestRepository) Release(ctx context.Context, userID int64, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.requests, requestKey{userID: userID, key: key})
+	return nil
+}
@@ -0,0 +1,74 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+)
+
+// PayoutRepository implements domain.PayoutRepository using memory.
+// Bet orders are forwarded to the bet order repository, payouts are lost on restart (use the DB implementation in production).
+type PayoutRepository struct {
+	betOrderRepo domain.BetOrderRepository
+	payouts      map[string]*domain.Payout
+	mu           sync.Mutex
+}
+
+// NewPayoutRepository creates a new memory payout repository, betOrderRepo may be nil
+func NewPayoutRepository(betOrderRepo domain.BetOrderRepository) *PayoutRepository {
+	return &PayoutRepository{
+		betOrderRepo: betOrderRepo,
+		payouts:      make(map[string]*domain.Payout),
+	}
+}
+
+func (r *PayoutRepository) CreateWithOrders(ctx context.Context, orders []*domain.BetOrder, payouts []*domain.Payout) error {
+	if r.betOrderRepo != nil && len(orders) > 0 {
+		if err := r.betOrderRepo.BatchCreate(ctx, orders); err != nil {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, payout := range payouts {
+		if _, exists := r.payouts[payout.TxID]; exists {
+			continue // Written by an interrupted settlement
+		}
+		stored := *payout
+		r.payouts[payout.TxID] = &stored
+	}
+	return nil
+}
+
+func (r *PayoutRepository) Update(ctx context.Context, payout *domain.Payout) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *payout
+	r.payouts[payout.TxID] = &stored
+	return nil
+}
+
+func (r *PayoutRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*domain.Payout, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	payouts := make([]*domain.Payout, 0)
+	for _, payout := range r.payouts {
+		if payout.Status == domain.PayoutStatusPending && !payout.NextRetryAt.After(now) {
+			copied := *payout
+			payouts = append(payouts, &copied)
+		}
+	}
+	sort.Slice(payouts, func(i, j int) bool {
+		return payouts[i].CreatedAt.Before(payouts[j].CreatedAt)
+	})
+	if limit > 0 && len(payouts) > limit {
+		payouts = payouts[:limit]
+	}
+	return payouts, nil
+}
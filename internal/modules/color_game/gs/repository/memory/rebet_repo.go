@@ -0,0 +1,141 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+)
+
+// LastBetSlipRepository implements domain.LastBetSlipRepository using memory.
+// It is only correct for a single GS process, use the Redis implementation for several instances.
+type LastBetSlipRepository struct {
+	slips map[int64]*domain.LastBetSlip
+	mu    sync.Mutex
+}
+
+// NewLastBetSlipRepository creates a new memory last bet slip repository
+func NewLastBetSlipRepository() *LastBetSlipRepository {
+	return &LastBetSlipRepository{slips: make(map[int64]*domain.LastBetSlip)}
+}
+
+func (r *LastBetSlipRepository) Record(ctx context.Context, userID int64, roundID string, lines []domain.BetLine) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	slip, ok := r.slips[userID]
+	if !ok || slip.RoundID != roundID {
+		slip = &domain.LastBetSlip{RoundID: roundID}
+		r.slips[userID] = slip
+	}
+	for _, line := range lines {
+		replaced := false
+		for i := range slip.Lines {
+			if slip.Lines[i].Color == line.Color {
+				slip.Lines[i].Amount = line.Amount
+				replaced = true
+			}
+		}
+		if !replaced {
+			slip.Lines = append(slip.Lines, domain.BetLine{Color: line.Color, Amount: line.Amount})
+		}
+	}
+	sort.Slice(slip.Lines, func(i, j int) bool { return slip.Lines[i].Color < slip.Lines[j].Color })
+	return nil
+}
+
+func (r *LastBetSlipRepository) Get(ctx context.Context, userID int64) (*domain.LastBetSlip, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	slip, ok := r.slips[userID]
+	if !ok {
+		return nil, nil
+	}
+	return &domain.LastBetSlip{
+		RoundID: slip.RoundID,
+		Lines:   append([]domain.BetLine(nil), slip.Lines...),
+	}, nil
+}
+
+// AutoBetRepository implements domain.AutoBetRepository using memory.
+// It is only correct for a single GS process, use the Redis implementation for several instances.
+type AutoBetRepository struct {
+	autoBets map[int64]domain.AutoBet
+	mu       sync.Mutex
+}
+
+// NewAutoBetRepository creates a new memory auto bet repository
+func NewAutoBetRepository() *AutoBetRepository {
+	return &AutoBetRepository{autoBets: make(map[int64]domain.AutoBet)}
+}
+
+func (r *AutoBetRepository) Save(ctx context.Context, autoBet *domain.AutoBet) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored := *autoBet
+	stored.Lines = append([]domain.BetLine(nil), autoBet.Lines...)
+	r.autoBets[autoBet.UserID] = stored
+	return nil
+}
+
+func (r *AutoBetRepository) Get(ctx context.Context, userID int64) (*domain.AutoBet, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.autoBets[userID]
+	if !ok {
+		return nil, nil
+	}
+	autoBet := stored
+	autoBet.Lines = append([]domain.BetLine(nil), stored.Lines...)
+	return &autoBet, nil
+}
+
+func (r *AutoBetRepository) List(ctx context.Context) ([]*domain.AutoBet, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	autoBets := make([]*domain.AutoBet, 0, len(r.autoBets))
+	for _, stored := range r.autoBets {
+		autoBet := stored
+		autoBet.Lines = append([]domain.BetLine(nil), stored.Lines...)
+		autoBets = append(autoBets, &autoBet)
+	}
+	sort.Slice(autoBets, func(i, j int) bool { return autoBets[i].UserID < autoBets[j].UserID })
+	return autoBets, nil
+}
+
+func (r *AutoBetRepository) Advance(ctx context.Context, userID int64, autoBetID string, roundID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	autoBet, ok := r.autoBets[userID]
+	if !ok || autoBet.ID != autoBetID || autoBet.LastRoundID == roundID {
+		return false, nil
+	}
+	autoBet.LastRoundID = roundID
+	autoBet.RoundsLeft--
+	r.autoBets[userID] = autoBet
+	return true, nil
+}
+
+func (r *AutoBetRepository) Finish(ctx context.Context, userID int64, autoBetID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if autoBet, ok := r.autoBets[userID]; ok && autoBet.ID == autoBetID {
+		delete(r.autoBets, userID)
+	}
+	return nil
+}
+
+func (r *AutoBetRepository) Delete(ctx context.Context, userID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.autoBets, userID)
+	return nil
+}
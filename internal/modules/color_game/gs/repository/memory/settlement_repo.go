@@ -0,0 +1,128 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+)
+
+// SettlementRepository implements domain.SettlementRepository using memory.
+// Checkpoints are lost on restart, use the DB implementation in production.
+type SettlementRepository struct {
+	settlements map[string]*domain.RoundSettlement
+	mu          sync.Mutex
+}
+
+// NewSettlementRepository creates a new memory settlement repository
+func NewSettlementRepository() *SettlementRepository {
+	return &SettlementRepository{settlements: make(map[string]*domain.RoundSettlement)}
+}
+
+func (r *SettlementRepository) Claim(ctx context.Context, settlement *domain.RoundSettlement, owner string, now time.Time, leaseUntil time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.settlements[settlement.RoundID]
+	if !ok {
+		stored = settlement
+		stored.Status = domain.SettlementStatusSettling
+		stored.CreatedAt = now
+	} else if stored.Status != domain.SettlementStatusSettling || !stored.LeaseUntil.Before(now) {
+		// Done, or leased: an active lease is not claimed again, not even by its owner
+		return false, nil
+	}
+
+	claimed := *stored
+	claimed.Owner = owner
+	claimed.LeaseUntil = leaseUntil
+	claimed.Attempts++
+	claimed.UpdatedAt = now
+	r.settlements[settlement.RoundID] = &claimed
+
+	*settlement = claimed
+	return true, nil
+}
+
+func (r *SettlementRepository) Checkpoint(ctx context.Context, settlement *domain.RoundSettlement) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.settlements[settlement.RoundID]
+	if !ok || stored.Owner != settlement.Owner || stored.Status != domain.SettlementStatusSettling {
+		return false, nil
+	}
+	saved := *settlement
+	r.settlements[settlement.RoundID] = &saved
+	return true, nil
+}
+
+func (r *SettlementRepository) Renew(ctx context.Context, roundID string, owner string, leaseUntil time.Time) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.settlements[roundID]
+	if !ok || stored.Owner != owner || stored.Status != domain.SettlementStatusSettling {
+		return false, nil
+	}
+	renewed := *stored
+	renewed.LeaseUntil = leaseUntil
+	r.settlements[roundID] = &renewed
+	return true, nil
+}
+
+func (r *SettlementRepository) Get(ctx context.Context, roundID string) (*domain.RoundSettlement, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	settlement, ok := r.settlements[roundID]
+	if !ok {
+		return nil, nil
+	}
+	copied := *settlement
+	return &copied, nil
+}
+
+func (r *SettlementRepository) ListExpired(ctx context.Context, now time.Time, limit int) ([]*domain.RoundSettlement, error) {
+	settlements := r.list(func(s *domain.RoundSettlement) bool {
+		return s.Status == domain.SettlementStatusSettling && s.LeaseUntil.Before(now)
+	})
+	sort.Slice(settlements, func(i, j int) bool {
+		return settlements[i].CreatedAt.Before(settlements[j].CreatedAt)
+	})
+	return truncateSettlements(settlements, limit), nil
+}
+
+func (r *SettlementRepository) List(ctx context.Context, status domain.SettlementStatus, limit int) ([]*domain.RoundSettlement, error) {
+	settlements := r.list(func(s *domain.RoundSettlement) bool {
+		return s.Status == status
+	})
+	sort.Slice(settlements, func(i, j int) bool {
+		return settlements[i].CreatedAt.After(settlements[j].CreatedAt)
+	})
+	return truncateSettlements(settlements, limit), nil
+}
+
+// list returns copies of the matching settlements
+func (r *SettlementRepository) list(match func(*domain.RoundSettlement) bool) []*domain.RoundSettlement {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	settlements := make([]*domain.RoundSettlement, 0)
+	for _, settlement := range r.settlements {
+		if match(settlement) {
+			copied := *settlement
+			settlements = append(settlements, &copied)
+		}
+	}
+	return settlements
+}
+
+func truncateSettlements(settlements []*domain.RoundSettlement, limit int) []*domain.RoundSettlement {
+	if limit > 0 && len(settlements) > limit {
+		return settlements[:limit]
+	}
+	return settlements
+}
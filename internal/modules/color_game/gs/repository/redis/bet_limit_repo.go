@@ -0,0 +1,97 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	"github.com/redis/go-redis/v9"
+)
+
+// betLimitTTL evicts rounds that were never settled nor voided
+const betLimitTTL = 24 * time.Hour
+
+// reserveScript checks every limit and increments the totals in one step, so concurrent bets from
+// several GS instances cannot overshoot a limit.
+// KEYS[1] totals hash; ARGV: player field, color field, exposure field, amount, payout,
+// max round bet, max color bet, max exposure, ttl seconds.
+// Returns 0 when reserved, 1, 2 or 3 when the round, color or exposure limit would be exceeded.
+var reserveScript = redis.NewScript(`
+local amount = tonumber(ARGV[4])
+local payout = tonumber(ARGV[5])
+local limits = {tonumber(ARGV[6]), tonumber(ARGV[7]), tonumber(ARGV[8])}
+local added = {amount, amount, payout}
+for i = 1, 3 do
+	local total = tonumber(redis.call('HGET', KEYS[1], ARGV[i]) or '0')
+	if limits[i] > 0 and total + added[i] > limits[i] then
+		return i
+	end
+end
+for i = 1, 3 do
+	redis.call('HINCRBY', KEYS[1], ARGV[i], added[i])
+end
+redis.call('EXPIRE', KEYS[1], ARGV[9])
+return 0
+`)
+
+// BetLimitRepository implements domain.BetLimitRepository using Redis, shared by every GS instance.
+// The totals of a round are one hash: player:<user>, color:<user>:<color> and exposure:<color>.
+type BetLimitRepository struct {
+	rdb *redis.Client
+}
+
+// NewBetLimitRepository creates a new Redis bet limit repository
+func NewBetLimitRepository(rdb *redis.Client) *BetLimitRepository {
+	return &BetLimitRepository{rdb: rdb}
+}
+
+func betLimitKey(roundID string) string {
+	return "gs_bet_limits:{" + roundID + "}"
+}
+
+// betLimitFields returns the player, player color and exposure fields of a reservation
+func betLimitFields(res domain.BetReservation) []string {
+	user := strconv.FormatInt(res.UserID, 10)
+	color := strconv.Itoa(int(res.Color))
+	return []string{"player:" + user, "color:" + user + ":" + color, "exposure:" + color}
+}
+
+func (r *BetLimitRepository) Reserve(ctx context.Context, res domain.BetReservation, limits domain.BetLimits) error {
+	fields := betLimitFields(res)
+	code, err := reserveScript.Run(ctx, r.rdb, []string{betLimitKey(res.RoundID)},
+		fields[0], fields[1], fields[2], res.Amount, res.Payout,
+		limits.MaxRoundBet, limits.MaxColorBet, limits.MaxExposure, int(betLimitTTL.Seconds()),
+	).Int()
+	if err != nil {
+		return err
+	}
+
+	switch code {
+	case 0:
+		return nil
+	case 1:
+		return fmt.Errorf("%w: round total would exceed %d", domain.ErrBetLimitExceeded, limits.MaxRoundBet)
+	case 2:
+		return fmt.Errorf("%w: %s total would exceed %d", domain.ErrBetLimitExceeded, res.Color, limits.MaxColorBet)
+	default:
+		return fmt.Errorf("%w: %s payout would exceed %d", domain.ErrExposureLimitExceeded, res.Color, limits.MaxExposure)
+	}
+}
+
+func (r *BetLimitRepository) Release(ctx context.Context, res domain.BetReservation) error {
+	key := betLimitKey(res.RoundID)
+	fields := betLimitFields(res)
+	_, err := r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HIncrBy(ctx, key, fields[0], -res.Amount)
+		pipe.HIncrBy(ctx, key, fields[1], -res.Amount)
+		pipe.HIncrBy(ctx, key, fields[2], -res.Payout)
+		return nil
+	})
+	return err
+}
+
+func (r *BetLimitRepository) Delete(ctx context.Context, roundID string) error {
+	return r.rdb.Del(ctx, betLimitKey(roundID)).Err()
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"math/rand"
 	"time"
 
 	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
@@ -139,20 +138,178 @@ func (r *BetRepository) GetUserBet(ctx context.Context, roundID string, userID i
 	return &bet, nil
 }
 
-// UpdateBetAmount updates the amount of an existing bet
-func (r *BetRepository) UpdateBetAmount(ctx context.Context, bet *domain.Bet, additionalAmount int64) error {
-	// Update amount in memory object
-	bet.Amount += additionalAmount
+// updateBetScript replaces a bet only if its stake was not changed since it was read, a bet cancelled meanwhile
+// stays cancelled.
+// KEYS: bet_data. ARGV: bet ID, expected stake, new JSON.
+var updateBetScript = redis.NewScript(`
+local data = redis.call('HGET', KEYS[1], ARGV[1])
+if not data or cjson.decode(data).Amount ~= tonumber(ARGV[2]) then
+	return 0
+end
+redis.call('HSET', KEYS[1], ARGV[1], ARGV[3])
+return 1
+`)
+
+// UpdateBetAmount adds the stake deducted by wallet transaction txID to an existing bet.
+// Returns ErrBetChanged without changing anything when the stake changed since it was read.
+func (r *BetRepository) UpdateBetAmount(ctx context.Context, bet *domain.Bet, additionalAmount int64, txID string) error {
+	updated := *bet
+	updated.Amount += additionalAmount
+	updated.TxIDs = append(append([]string(nil), bet.TxIDs...), txID)
 
 	// Serialize updated bet
-	data, err := json.Marshal(bet)
+	data, err := json.Marshal(&updated)
 	if err != nil {
 		return err
 	}
 
-	// Update in Redis Hash
 	dataKey := fmt.Sprintf("bet_data:%s", bet.RoundID)
-	return r.rdb.HSet(ctx, dataKey, bet.BetID, data).Err()
+	changed, err := updateBetScript.Run(ctx, r.rdb, []string{dataKey}, bet.BetID, bet.Amount, data).Int()
+	if err != nil {
+		return err
+	}
+	if changed == 0 {
+		return domain.ErrBetChanged
+	}
+
+	bet.Amount = updated.Amount
+	bet.TxIDs = updated.TxIDs
+	return nil
+}
+
+// saveBetSlipScript saves the bets of a slip of one player in one round: it checks first that every updated bet
+// still has its expected stake and writes nothing otherwise.
+// KEYS: bet_data, settlement_queue shard, user_index. ARGV: TTL in seconds, number of updated bets, then
+// bet ID, expected stake and new JSON of each updated bet, then bet ID, JSON and color of each created bet.
+var saveBetSlipScript = redis.NewScript(`
+local updated = tonumber(ARGV[2])
+for i = 3, 2 + updated * 3, 3 do
+	local data = redis.call('HGET', KEYS[1], ARGV[i])
+	if not data or cjson.decode(data).Amount ~= tonumber(ARGV[i + 1]) then
+		return 0
+	end
+end
+for i = 3, 2 + updated * 3, 3 do
+	redis.call('HSET', KEYS[1], ARGV[i], ARGV[i + 2])
+end
+for i = 3 + updated * 3, #ARGV, 3 do
+	redis.call('HSET', KEYS[1], ARGV[i], ARGV[i + 1])
+	redis.call('RPUSH', KEYS[2], ARGV[i])
+	redis.call('HSET', KEYS[3], ARGV[i + 2], ARGV[i])
+end
+for _, key in ipairs(KEYS) do
+	redis.call('EXPIRE', key, ARGV[1])
+end
+return 1
+`)
+
+// SaveBetSlip saves every bet of a bet slip in one script, stakes are the stakes the updated bets were read with
+func (r *BetRepository) SaveBetSlip(ctx context.Context, created []*domain.Bet, updated []*domain.Bet, stakes []int64) error {
+	var first *domain.Bet
+	switch {
+	case len(updated) > 0:
+		first = updated[0]
+	case len(created) > 0:
+		first = created[0]
+	default:
+		return nil
+	}
+
+	args := []interface{}{int64(r.ttl / time.Second), len(updated)}
+	for i, bet := range updated {
+		data, err := json.Marshal(bet)
+		if err != nil {
+			return err
+		}
+		args = append(args, bet.BetID, stakes[i], data)
+	}
+	for _, bet := range created {
+		data, err := json.Marshal(bet)
+		if err != nil {
+			return err
+		}
+		args = append(args, bet.BetID, data, bet.Color.String())
+	}
+
+	// The bets of a slip share the round and the player, hence the keys
+	keys := []string{
+		fmt.Sprintf("bet_data:%s", first.RoundID),
+		fmt.Sprintf("settlement_queue:%s:%d", first.RoundID, first.UserID%ShardCount),
+		fmt.Sprintf("user_index:%s:%d", first.RoundID, first.UserID),
+	}
+	saved, err := saveBetSlipScript.Run(ctx, r.rdb, keys, args...).Int()
+	if err != nil {
+		return err
+	}
+	if saved == 0 {
+		return domain.ErrBetChanged
+	}
+	return nil
+}
+
+// CloseBetting marks the bets of a round as final, CancelBet rejects them from now on
+func (r *BetRepository) CloseBetting(ctx context.Context, roundID string) error {
+	return r.rdb.Set(ctx, fmt.Sprintf("betting_closed:%s", roundID), 1, r.ttl).Err()
+}
+
+// cancelBetScript updates (or removes) a bet only if betting of its round is not closed and its stake was not
+// changed since it was read.
+// KEYS: bet_data, settlement_queue shard, user_index, betting_closed. ARGV: bet ID, expected stake,
+// new JSON ("" removes the bet), color. Returns -1 when betting closed and 0 when the stake changed.
+var cancelBetScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[4]) == 1 then
+	return -1
+end
+local data = redis.call('HGET', KEYS[1], ARGV[1])
+if not data or cjson.decode(data).Amount ~= tonumber(ARGV[2]) then
+	return 0
+end
+if ARGV[3] == '' then
+	redis.call('HDEL', KEYS[1], ARGV[1])
+	redis.call('LREM', KEYS[2], 1, ARGV[1])
+	redis.call('HDEL', KEYS[3], ARGV[4])
+else
+	redis.call('HSET', KEYS[1], ARGV[1], ARGV[3])
+end
+return 1
+`)
+
+// CancelBet takes amount back from a bet atomically, a bet reduced to zero leaves the data hash, its settlement
+// queue shard and the user index
+func (r *BetRepository) CancelBet(ctx context.Context, bet *domain.Bet, stake int64, amount int64, refundTxID string) error {
+	updated := *bet
+	updated.Amount = stake - amount
+	updated.RefundTxIDs = append(append([]string(nil), bet.RefundTxIDs...), refundTxID)
+
+	data := ""
+	if updated.Amount > 0 {
+		encoded, err := json.Marshal(&updated)
+		if err != nil {
+			return err
+		}
+		data = string(encoded)
+	}
+
+	keys := []string{
+		fmt.Sprintf("bet_data:%s", bet.RoundID),
+		fmt.Sprintf("settlement_queue:%s:%d", bet.RoundID, bet.UserID%ShardCount),
+		fmt.Sprintf("user_index:%s:%d", bet.RoundID, bet.UserID),
+		fmt.Sprintf("betting_closed:%s", bet.RoundID),
+	}
+	result, err := cancelBetScript.Run(ctx, r.rdb, keys, bet.BetID, stake, data, bet.Color.String()).Int()
+	if err != nil {
+		return err
+	}
+	switch result {
+	case -1:
+		return fmt.Errorf("%w: betting closed for round %s", domain.ErrRoundNotActive, bet.RoundID)
+	case 0:
+		return domain.ErrBetChanged
+	}
+
+	bet.Amount = updated.Amount
+	bet.RefundTxIDs = updated.RefundTxIDs
+	return nil
 }
 
 // ClearBets clears all bets for a round
@@ -161,6 +318,7 @@ func (r *BetRepository) ClearBets(ctx context.Context, roundID string) error {
 
 	// Delete bet data
 	pipe.Del(ctx, fmt.Sprintf("bet_data:%s", roundID))
+	pipe.Del(ctx, fmt.Sprintf("betting_closed:%s", roundID))
 
 	// Delete queues
 	for i := 0; i < ShardCount; i++ {
@@ -174,20 +332,14 @@ func (r *BetRepository) ClearBets(ctx context.Context, roundID string) error {
 	return err
 }
 
-// GetBetsForSettlement retrieves bets for settlement
+// GetBetsForSettlement reads a batch of bets from the first non-empty settlement queue shard.
+// The bet IDs are not popped: they stay queued until AckSettled, so a GS crashing mid-settlement loses no bet.
 func (r *BetRepository) GetBetsForSettlement(ctx context.Context, roundID string) ([]*domain.Bet, error) {
-	startShard := rand.Intn(ShardCount)
-
-	for i := 0; i < ShardCount; i++ {
-		shardID := (startShard + i) % ShardCount
+	for shardID := 0; shardID < ShardCount; shardID++ {
 		queueKey := fmt.Sprintf("settlement_queue:%s:%d", roundID, shardID)
 
-		// Pop bet IDs
-		betIDs, err := r.rdb.LPopCount(ctx, queueKey, 100).Result()
+		betIDs, err := r.rdb.LRange(ctx, queueKey, 0, 99).Result()
 		if err != nil {
-			if err == redis.Nil {
-				continue
-			}
 			return nil, err
 		}
 
@@ -200,17 +352,18 @@ func (r *BetRepository) GetBetsForSettlement(ctx context.Context, roundID string
 			}
 
 			bets := make([]*domain.Bet, 0, len(dataList))
-			for _, data := range dataList {
-				if data == nil {
-					continue
-				}
+			for i, data := range dataList {
 				var bet domain.Bet
-				if strData, ok := data.(string); ok {
-					if err := json.Unmarshal([]byte(strData), &bet); err != nil {
-						continue
-					}
-					bets = append(bets, &bet)
+				strData, ok := data.(string)
+				if !ok || json.Unmarshal([]byte(strData), &bet) != nil {
+					// Unreadable entry, drop it from the queue or it would be read forever
+					r.rdb.LRem(ctx, queueKey, 1, betIDs[i])
+					continue
 				}
+				bets = append(bets, &bet)
+			}
+			if len(bets) == 0 {
+				continue
 			}
 			return bets, nil
 		}
@@ -218,3 +371,18 @@ func (r *BetRepository) GetBetsForSettlement(ctx context.Context, roundID string
 
 	return make([]*domain.Bet, 0), nil
 }
+
+// AckSettled removes settled bet IDs from their settlement queue shards
+func (r *BetRepository) AckSettled(ctx context.Context, roundID string, bets []*domain.Bet) error {
+	if len(bets) == 0 {
+		return nil
+	}
+
+	pipe := r.rdb.Pipeline()
+	for _, bet := range bets {
+		queueKey := fmt.Sprintf("settlement_queue:%s:%d", roundID, bet.UserID%ShardCount)
+		pipe.LRem(ctx, queueKey, 1, bet.BetID)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
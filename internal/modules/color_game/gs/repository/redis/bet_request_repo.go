@@ -0,0 +1,63 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	"github.com/redis/go-redis/v9"
+)
+
+// BetRequestRepository implements domain.BetRequestRepository using Redis, shared by every GS instance.
+// A request is one key set with SETNX: empty while it is processed, the JSON of the request once its bet is placed.
+type BetRequestRepository struct {
+	rdb *redis.Client
+}
+
+// NewBetRequestRepository creates a new Redis bet request repository
+func NewBetRequestRepository(rdb *redis.Client) *BetRequestRepository {
+	return &BetRequestRepository{rdb: rdb}
+}
+
+func betRequestKey(userID int64, key string) string {
+	return "gs_bet_requests:" + strconv.FormatInt(userID, 10) + ":" + key
+}
+
+func (r *BetRequestRepository) Acquire(ctx context.Context, userID int64, key string, ttl time.Duration) (*domain.BetRequest, error) {
+	redisKey := betRequestKey(userID, key)
+	acquired, err := r.rdb.SetNX(ctx, redisKey, "", ttl).Result()
+	if err != nil {
+		return nil, err
+	}
+	if acquired {
+		return nil, nil
+	}
+
+	value, err := r.rdb.Get(ctx, redisKey).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+	request := &domain.BetRequest{Key: key, UserID: userID}
+	if value == "" {
+		return request, nil // Still processed (or expired in between, the client retries)
+	}
+	if err := json.Unmarshal([]byte(value), request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+func (r *BetRequestRepository) Complete(ctx context.Context, request *domain.BetRequest, ttl time.Duration) error {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	return r.rdb.Set(ctx, betRequestKey(request.UserID, request.Key), data, ttl).Err()
+}
+
+func (r *BetRequestRepository) Release(ctx context.Context, userID int64, key string) error {
+	return r.rdb.Del(ctx, betRequestKey(userID, key)).Err()
+}
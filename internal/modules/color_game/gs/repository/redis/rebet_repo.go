@@ -0,0 +1,195 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	"github.com/redis/go-redis/v9"
+)
+
+// lastBetSlipTTL forgets the slip of a player who has not bet for a while
+const lastBetSlipTTL = 7 * 24 * time.Hour
+
+// recordSlipScript replaces the slip of another round and sets the stake of each color in one step.
+// KEYS[1] slip hash; ARGV: round ID, ttl seconds, then color and amount pairs.
+var recordSlipScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], 'round') ~= ARGV[1] then
+	redis.call('DEL', KEYS[1])
+	redis.call('HSET', KEYS[1], 'round', ARGV[1])
+end
+for i = 3, #ARGV, 2 do
+	redis.call('HSET', KEYS[1], ARGV[i], ARGV[i + 1])
+end
+redis.call('EXPIRE', KEYS[1], ARGV[2])
+return 0
+`)
+
+// LastBetSlipRepository implements domain.LastBetSlipRepository using Redis, shared by every GS instance.
+// The slip of a player is one hash: round and the stake of each color keyed by its number.
+type LastBetSlipRepository struct {
+	rdb *redis.Client
+}
+
+// NewLastBetSlipRepository creates a new Redis last bet slip repository
+func NewLastBetSlipRepository(rdb *redis.Client) *LastBetSlipRepository {
+	return &LastBetSlipRepository{rdb: rdb}
+}
+
+func lastBetSlipKey(userID int64) string {
+	return "gs_last_bet_slip:" + strconv.FormatInt(userID, 10)
+}
+
+func (r *LastBetSlipRepository) Record(ctx context.Context, userID int64, roundID string, lines []domain.BetLine) error {
+	args := []interface{}{roundID, int(lastBetSlipTTL.Seconds())}
+	for _, line := range lines {
+		args = append(args, strconv.Itoa(int(line.Color)), line.Amount)
+	}
+	return recordSlipScript.Run(ctx, r.rdb, []string{lastBetSlipKey(userID)}, args...).Err()
+}
+
+func (r *LastBetSlipRepository) Get(ctx context.Context, userID int64) (*domain.LastBetSlip, error) {
+	fields, err := r.rdb.HGetAll(ctx, lastBetSlipKey(userID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if fields["round"] == "" {
+		return nil, nil
+	}
+
+	slip := &domain.LastBetSlip{RoundID: fields["round"]}
+	for field, value := range fields {
+		if field == "round" {
+			continue
+		}
+		color, err := strconv.Atoi(field)
+		if err != nil {
+			continue
+		}
+		amount, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		slip.Lines = append(slip.Lines, domain.BetLine{Color: domain.Color(color), Amount: amount})
+	}
+	sort.Slice(slip.Lines, func(i, j int) bool { return slip.Lines[i].Color < slip.Lines[j].Color })
+	return slip, nil
+}
+
+// autoBetsKey is the hash of every active auto bet, keyed by user ID
+const autoBetsKey = "gs_auto_bets"
+
+// finishAutoBetScript deletes an auto bet unless the player replaced it.
+// KEYS[1] auto bets hash; ARGV: user ID, auto bet ID.
+var finishAutoBetScript = redis.NewScript(`
+local data = redis.call('HGET', KEYS[1], ARGV[1])
+if data and cjson.decode(data).ID == ARGV[2] then
+	return redis.call('HDEL', KEYS[1], ARGV[1])
+end
+return 0
+`)
+
+// AutoBetRepository implements domain.AutoBetRepository using Redis, shared by every GS instance.
+// Every auto bet is the JSON of a field of one hash.
+type AutoBetRepository struct {
+	rdb *redis.Client
+}
+
+// NewAutoBetRepository creates a new Redis auto bet repository
+func NewAutoBetRepository(rdb *redis.Client) *AutoBetRepository {
+	return &AutoBetRepository{rdb: rdb}
+}
+
+func (r *AutoBetRepository) Save(ctx context.Context, autoBet *domain.AutoBet) error {
+	data, err := json.Marshal(autoBet)
+	if err != nil {
+		return err
+	}
+	return r.rdb.HSet(ctx, autoBetsKey, strconv.FormatInt(autoBet.UserID, 10), data).Err()
+}
+
+func (r *AutoBetRepository) Get(ctx context.Context, userID int64) (*domain.AutoBet, error) {
+	data, err := r.rdb.HGet(ctx, autoBetsKey, strconv.FormatInt(userID, 10)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var autoBet domain.AutoBet
+	if err := json.Unmarshal([]byte(data), &autoBet); err != nil {
+		return nil, err
+	}
+	return &autoBet, nil
+}
+
+func (r *AutoBetRepository) List(ctx context.Context) ([]*domain.AutoBet, error) {
+	values, err := r.rdb.HGetAll(ctx, autoBetsKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	autoBets := make([]*domain.AutoBet, 0, len(values))
+	for _, data := range values {
+		var autoBet domain.AutoBet
+		if err := json.Unmarshal([]byte(data), &autoBet); err != nil {
+			return nil, err
+		}
+		autoBets = append(autoBets, &autoBet)
+	}
+	sort.Slice(autoBets, func(i, j int) bool { return autoBets[i].UserID < autoBets[j].UserID })
+	return autoBets, nil
+}
+
+// Advance updates the auto bet in an optimistic transaction, the GS instances running the same auto bet
+// record each round once
+func (r *AutoBetRepository) Advance(ctx context.Context, userID int64, autoBetID string, roundID string) (bool, error) {
+	field := strconv.FormatInt(userID, 10)
+	for attempt := 0; attempt < 3; attempt++ {
+		advanced := false
+		err := r.rdb.Watch(ctx, func(tx *redis.Tx) error {
+			data, err := tx.HGet(ctx, autoBetsKey, field).Result()
+			if errors.Is(err, redis.Nil) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			var autoBet domain.AutoBet
+			if err := json.Unmarshal([]byte(data), &autoBet); err != nil {
+				return err
+			}
+			if autoBet.ID != autoBetID || autoBet.LastRoundID == roundID {
+				return nil
+			}
+			autoBet.LastRoundID = roundID
+			autoBet.RoundsLeft--
+			updated, err := json.Marshal(&autoBet)
+			if err != nil {
+				return err
+			}
+			_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+				pipe.HSet(ctx, autoBetsKey, field, updated)
+				return nil
+			})
+			advanced = err == nil
+			return err
+		}, autoBetsKey)
+		if errors.Is(err, redis.TxFailedErr) {
+			continue // Another auto bet changed meanwhile
+		}
+		return advanced, err
+	}
+	return false, redis.TxFailedErr
+}
+
+func (r *AutoBetRepository) Finish(ctx context.Context, userID int64, autoBetID string) error {
+	return finishAutoBetScript.Run(ctx, r.rdb, []string{autoBetsKey}, strconv.FormatInt(userID, 10), autoBetID).Err()
+}
+
+func (r *AutoBetRepository) Delete(ctx context.Context, userID int64) error {
+	return r.rdb.HDel(ctx, autoBetsKey, strconv.FormatInt(userID, 10)).Err()
+}
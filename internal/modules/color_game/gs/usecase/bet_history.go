@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	"github.com/frankieli/game_product/pkg/logger"
+)
+
+const (
+	betHistoryDefaultLimit = 20
+	betHistoryMaxLimit     = 100
+)
+
+// GetBetHistory returns a page of bet orders, newest first, each with the result of its round and its payout.
+// Players query their own orders (query.UserID set), operators may search every player by game and date range.
+func (uc *GSUseCase) GetBetHistory(ctx context.Context, query domain.BetHistoryQuery) (*domain.BetHistoryPage, error) {
+	if !query.From.IsZero() && !query.To.IsZero() && query.To.Before(query.From) {
+		return nil, fmt.Errorf("%w: range ends before it starts", domain.ErrInvalidBetHistoryQuery)
+	}
+	limit := query.Limit
+	if limit <= 0 {
+		limit = betHistoryDefaultLimit
+	}
+	if limit > betHistoryMaxLimit {
+		limit = betHistoryMaxLimit
+	}
+
+	page := &domain.BetHistoryPage{}
+	var orders []*domain.BetOrder
+	if query.RoundID != "" {
+		var err error
+		if query.UserID != 0 {
+			orders, err = uc.betOrderRepo.ListByUserRound(ctx, query.UserID, query.RoundID)
+		} else {
+			orders, err = uc.betOrderRepo.ListByRound(ctx, query.RoundID)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list round bet orders: %w", err)
+		}
+	} else {
+		cursor, err := domain.ParseBetOrderCursor(query.Cursor)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrInvalidBetHistoryQuery, err)
+		}
+
+		// One more order than the page tells whether another page follows
+		filter := domain.BetOrderFilter{UserID: query.UserID, GameCode: query.GameCode, From: query.From, To: query.To}
+		if filter == (domain.BetOrderFilter{UserID: query.UserID}) && query.UserID != 0 {
+			orders, err = uc.betOrderRepo.ListByUser(ctx, query.UserID, cursor, limit+1)
+		} else {
+			orders, err = uc.betOrderRepo.Search(ctx, filter, cursor, limit+1)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list bet orders: %w", err)
+		}
+		if len(orders) > limit {
+			orders = orders[:limit]
+			page.NextCursor = domain.CursorOf(orders[limit-1]).String()
+		}
+	}
+
+	results := uc.roundResults(ctx, orders)
+	page.Records = make([]*domain.BetRecord, 0, len(orders))
+	for _, order := range orders {
+		record := &domain.BetRecord{Order: order}
+		if settlement := results[order.RoundID]; settlement != nil {
+			record.Dice = domain.DecodeDice(settlement.Dice)
+			record.VoidReason = settlement.VoidReason
+		}
+		page.Records = append(page.Records, record)
+	}
+	return page, nil
+}
+
+// roundResults returns the settlement of each round of the orders, a round not drawn yet has none.
+// A round whose settlement cannot be read is shown without result rather than failing the history.
+func (uc *GSUseCase) roundResults(ctx context.Context, orders []*domain.BetOrder) map[string]*domain.RoundSettlement {
+	results := make(map[string]*domain.RoundSettlement)
+	for _, order := range orders {
+		if _, ok := results[order.RoundID]; ok {
+			continue
+		}
+		settlement, err := uc.settlementRepo.Get(ctx, order.RoundID)
+		if err != nil {
+			logger.Warn(ctx).Err(err).Str("round_id", order.RoundID).Msg("查询回合结果失败")
+		}
+		results[order.RoundID] = settlement
+	}
+	return results
+}
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	"github.com/frankieli/game_product/pkg/logger"
+)
+
+// betRequestTTL is how long a placed request is remembered, clients retry long before
+const betRequestTTL = 24 * time.Hour
+
+// SetBetRequestRepository replaces the in-memory request deduplication (production uses Redis)
+func (uc *GSUseCase) SetBetRequestRepository(repo domain.BetRequestRepository) {
+	uc.betRequestRepo = repo
+}
+
+// acquireRequest reserves the idempotency key of a new PlaceBet request. It returns the bet of a replayed request,
+// ErrRequestInProgress while the first attempt is still running. The key is held for betIntentTimeout only,
+// a GS dying before the bet is placed does not block the key for long.
+func (uc *GSUseCase) acquireRequest(ctx context.Context, userID int64, requestKey string) (*domain.Bet, error) {
+	if !domain.ValidRequestKey(requestKey) {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidRequestKey, requestKey)
+	}
+
+	request, err := uc.betRequestRepo.Acquire(ctx, userID, requestKey, betIntentTimeout)
+	if err != nil {
+		logger.Error(ctx).Err(err).Str("request_key", requestKey).Msg("检查重复下注请求失败")
+		return nil, fmt.Errorf("failed to acquire request key: %w", err)
+	}
+	if request == nil {
+		return nil, nil
+	}
+	if request.BetID == "" {
+		logger.Warn(ctx).Str("request_key", requestKey).Msg("重复下注请求处理中")
+		return nil, fmt.Errorf("%w: %s", domain.ErrRequestInProgress, requestKey)
+	}
+
+	logger.Info(ctx).
+		Str("request_key", requestKey).
+		Str("bet_id", request.BetID).
+		Msg("重复下注请求，返回原注单")
+	return uc.replayedBet(ctx, request), nil
+}
+
+// replayedBet returns the bet a request placed: the open bet (with the stakes added since) or, once the round
+// is settled, the bet as the request left it
+func (uc *GSUseCase) replayedBet(ctx context.Context, request *domain.BetRequest) *domain.Bet {
+	bet, err := uc.betRepo.GetUserBet(ctx, request.RoundID, request.UserID, request.Color)
+	if err == nil && bet != nil && bet.BetID == request.BetID {
+		return bet
+	}
+	return &domain.Bet{
+		BetID:   request.BetID,
+		RoundID: request.RoundID,
+		UserID:  request.UserID,
+		Color:   request.Color,
+		Amount:  request.Amount,
+		TxIDs:   []string{domain.BetTxID(request.BetID, request.Key)},
+	}
+}
+
+// completeRequest remembers the bet a request placed, replays return it
+func (uc *GSUseCase) completeRequest(ctx context.Context, request *domain.BetRequest) {
+	if err := uc.betRequestRepo.Complete(ctx, request, betRequestTTL); err != nil {
+		// The bet stands, a replay arriving after betIntentTimeout is no longer recognised
+		logger.Warn(ctx).Err(err).Str("request_key", request.Key).Msg("记录下注请求失败")
+	}
+}
+
+// releaseRequest forgets a request that placed no bet, the client may retry it
+func (uc *GSUseCase) releaseRequest(ctx context.Context, userID int64, requestKey string) {
+	if err := uc.betRequestRepo.Release(ctx, userID, requestKey); err != nil {
+		logger.Warn(ctx).Err(err).Str("request_key", requestKey).Msg("释放下注请求失败")
+	}
+}
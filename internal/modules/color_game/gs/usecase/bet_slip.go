@@ -0,0 +1,312 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	"github.com/frankieli/game_product/pkg/logger"
+	"github.com/frankieli/game_product/pkg/service"
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+)
+
+// PlaceBetSlip places a bet on several colors of the current round of a table at once (empty tableID = default table).
+// The slip is validated as a whole, its stakes are deducted in one wallet transaction and its bets are saved in one
+// repository write: either every line is placed or none. It returns the bet of each line, in the order of lines.
+// requestKey deduplicates retries like PlaceBet (empty = not deduplicated).
+func (uc *GSUseCase) PlaceBetSlip(ctx context.Context, userID int64, tableID string, lines []domain.BetLine, requestKey string) ([]*domain.Bet, error) {
+	ctx = logger.WithFields(ctx, map[string]interface{}{
+		"user_id":  userID,
+		"table_id": tableID,
+	})
+
+	logger.Info(ctx).
+		Int("lines", len(lines)).
+		Str("request_key", requestKey).
+		Msg("批量下注请求开始")
+
+	// 1. Deduplicate the request by its idempotency key, a replay returns the bets it placed
+	placed := false
+	if requestKey == "" {
+		requestKey = domain.NewRequestKey()
+	} else {
+		replayed, err := uc.acquireSlipRequest(ctx, userID, requestKey)
+		if err != nil || replayed != nil {
+			return replayed, err
+		}
+		defer func() {
+			if !placed {
+				uc.releaseRequest(ctx, userID, slipRequestKey(requestKey))
+			}
+		}()
+	}
+
+	// 2. One round lookup for the whole slip
+	roundRsp, err := uc.getCurrentRound(ctx, userID, tableID)
+	if err != nil {
+		logger.Error(ctx).Err(err).Msg("获取当前回合失败")
+		return nil, fmt.Errorf("failed to get current round: %w", err)
+	}
+	ctx = logger.WithFields(ctx, map[string]interface{}{
+		"round_id": roundRsp.RoundId,
+	})
+
+	// 3. Validate every line before anything is reserved or deducted
+	if roundRsp.State != pbColorGame.ColorGameState_GAME_STATE_BETTING {
+		logger.Warn(ctx).
+			Str("round_state", roundRsp.State.String()).
+			Msg("当前状态不接受下注")
+		return nil, fmt.Errorf("%w: %s", domain.ErrRoundNotActive, roundRsp.State)
+	}
+	if err := domain.ValidateBetLines(lines); err != nil {
+		logger.Warn(ctx).Err(err).Msg("无效的批量下注")
+		return nil, err
+	}
+	total := int64(0)
+	for _, line := range lines {
+		if !uc.paytable.Contains(line.Color) {
+			logger.Warn(ctx).Str("color", line.Color.String()).Msg("无效的颜色")
+			return nil, fmt.Errorf("%w: %s", domain.ErrInvalidBetOption, line.Color)
+		}
+		if err := uc.betLimits.CheckAmount(line.Amount); err != nil {
+			logger.Warn(ctx).Err(err).Str("color", line.Color.String()).Int64("amount", line.Amount).Msg("下注金额无效")
+			return nil, err
+		}
+		total += line.Amount
+	}
+
+	// 4. Each line adds to the player's bet on its color, or creates one
+	existing := make([]*domain.Bet, len(lines))
+	for i, line := range lines {
+		existing[i], err = uc.betRepo.GetUserBet(ctx, roundRsp.RoundId, userID, line.Color)
+		if err != nil {
+			logger.Error(ctx).Err(err).Msg("检查现有下注失败")
+			return nil, fmt.Errorf("failed to check existing bet: %w", err)
+		}
+	}
+
+	// 5. Reserve every line against the round limits, a line over a limit rejects the slip
+	reservations := make([]domain.BetReservation, 0, len(lines))
+	for _, line := range lines {
+		reservation := domain.BetReservation{
+			RoundID: roundRsp.RoundId,
+			UserID:  userID,
+			Color:   line.Color,
+			Amount:  line.Amount,
+			Payout:  uc.paytable.MaxWinAmount(line.Color, line.Amount),
+		}
+		if err := uc.betLimitRepo.Reserve(ctx, reservation, uc.betLimits); err != nil {
+			logger.Warn(ctx).
+				Err(err).
+				Str("color", line.Color.String()).
+				Int64("amount", line.Amount).
+				Msg("超过下注限额")
+			uc.releaseReservations(ctx, reservations)
+			return nil, err
+		}
+		reservations = append(reservations, reservation)
+	}
+
+	// 6. Record the bet intent of the slip, the saga rolls the deduction back when the slip cannot be placed
+	slipID := domain.NewBetID()
+	txID := domain.SlipTxID(slipID, requestKey)
+	now := uc.clock.Now()
+	intent := &domain.BetIntent{
+		TxID:        txID,
+		RoundID:     roundRsp.RoundId,
+		UserID:      userID,
+		Color:       lines[0].Color,
+		Amount:      total,
+		Status:      domain.BetIntentStatusPending,
+		NextRetryAt: now.Add(betIntentTimeout),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := uc.betIntentRepo.Create(ctx, intent); err != nil {
+		logger.Error(ctx).Err(err).Msg("记录下注意图失败")
+		uc.releaseReservations(ctx, reservations)
+		return nil, fmt.Errorf("failed to record bet intent: %w", err)
+	}
+
+	// 7. Deduct the whole slip in one wallet transaction
+	if _, err := uc.walletSvc.PlaceBet(ctx, userID, total, roundRsp.RoundId, txID); err != nil {
+		logger.Error(ctx).Err(err).Int64("amount", total).Msg("钱包扣款失败")
+		if errors.Is(err, service.ErrWalletRejected) {
+			uc.releaseReservations(ctx, reservations)
+			_ = uc.betIntentRepo.Delete(ctx, txID)
+		} else {
+			uc.abortSlip(ctx, intent, reservations, err)
+		}
+		return nil, fmt.Errorf("failed to deduct from wallet: %w", err)
+	}
+
+	// 8. Record every line in GMS
+	for _, line := range lines {
+		_, err := uc.gmsService.RecordBet(ctx, &pbColorGame.ColorGameRecordBetReq{
+			TableId: roundRsp.TableId,
+			RoundId: roundRsp.RoundId,
+			UserId:  userID,
+			Color:   line.Color,
+			Amount:  line.Amount,
+		})
+		if err != nil {
+			logger.Error(ctx).Err(err).Str("color", line.Color.String()).Msg("GMS 记录下注失败")
+			uc.abortSlip(ctx, intent, reservations, err)
+			return nil, fmt.Errorf("failed to record bet in GMS: %w", err)
+		}
+	}
+
+	// 9. Claim the intent, unless the compensation worker already took it over
+	won, err := uc.betIntentRepo.Transition(ctx, txID, domain.BetIntentStatusPending, domain.BetIntentStatusPlaced)
+	if err != nil {
+		logger.Error(ctx).Err(err).Msg("更新下注意图失败")
+		uc.abortSlip(ctx, intent, reservations, err)
+		return nil, fmt.Errorf("failed to claim bet intent: %w", err)
+	}
+	if !won {
+		logger.Error(ctx).Str("tx_id", txID).Msg("下注逾时，已由补偿流程回滚")
+		uc.releaseReservations(ctx, reservations)
+		return nil, fmt.Errorf("bet slip %s timed out and is being compensated", txID)
+	}
+
+	// 10. Build the bet of each line and persist them as pending bet orders in one transaction
+	bets := make([]*domain.Bet, len(lines))
+	var created, updated []*domain.Bet
+	var stakes []int64
+	for i, line := range lines {
+		if existing[i] != nil {
+			bet := *existing[i]
+			bet.Amount += line.Amount
+			bet.TxIDs = append(append([]string(nil), bet.TxIDs...), txID)
+			bet.SlipID = slipID
+			bets[i] = &bet
+			updated = append(updated, &bet)
+			stakes = append(stakes, existing[i].Amount)
+			continue
+		}
+		bet := domain.NewBet(domain.NewBetID(), roundRsp.RoundId, userID, line.Color, line.Amount, txID, now)
+		bet.SlipID = slipID
+		bets[i] = bet
+		created = append(created, bet)
+	}
+	orders := make([]*domain.BetOrder, len(bets))
+	raised := make([]bool, len(bets))
+	for i, bet := range bets {
+		orders[i] = &domain.BetOrder{
+			OrderID:   bet.BetID,
+			UserID:    userID,
+			RoundID:   roundRsp.RoundId,
+			GameCode:  "color_game",
+			BetArea:   bet.Color.String(),
+			Amount:    float64(bet.Amount),
+			Status:    domain.BetOrderStatusPending,
+			TxIDs:     domain.JoinTxIDs(bet.TxIDs),
+			CreatedAt: bet.Time,
+		}
+		raised[i] = existing[i] != nil
+	}
+	if uc.betOrderRepo != nil {
+		if err := uc.betOrderRepo.SavePending(ctx, orders); err != nil {
+			logger.Error(ctx).Err(err).Str("slip_id", slipID).Msg("保存待结算注单失败")
+			uc.abortSlip(ctx, intent, reservations, err)
+			return nil, fmt.Errorf("failed to save pending bet orders: %w", err)
+		}
+	}
+
+	// 11. Save every bet of the slip in one write
+	if err := uc.betRepo.SaveBetSlip(ctx, created, updated, stakes); err != nil {
+		logger.Error(ctx).Err(err).Str("slip_id", slipID).Msg("保存批量下注失败")
+		uc.revertPendingOrders(ctx, orders, raised)
+		uc.abortSlip(ctx, intent, reservations, err)
+		return nil, fmt.Errorf("failed to save bet slip: %w", err)
+	}
+
+	// 12. The slip is placed, the intent is done and replays of the request return its bets
+	placed = true
+	if err := uc.betIntentRepo.Delete(ctx, txID); err != nil {
+		logger.Warn(ctx).Err(err).Str("tx_id", txID).Msg("删除下注意图失败")
+	}
+	placedLines := make([]domain.BetLine, len(lines))
+	for i, line := range lines {
+		placedLines[i] = domain.BetLine{Color: line.Color, Amount: line.Amount, BetID: bets[i].BetID}
+	}
+	uc.completeRequest(ctx, &domain.BetRequest{
+		Key:     slipRequestKey(requestKey),
+		UserID:  userID,
+		BetID:   slipID,
+		RoundID: roundRsp.RoundId,
+		Amount:  total,
+		Lines:   placedLines,
+	})
+
+	logger.Info(ctx).
+		Str("slip_id", slipID).
+		Int("lines", len(lines)).
+		Int64("total_amount", total).
+		Msg("批量下注成功")
+
+	return bets, nil
+}
+
+// abortSlip frees the limits of a slip that could not be placed and compensates its stakes
+func (uc *GSUseCase) abortSlip(ctx context.Context, intent *domain.BetIntent, reservations []domain.BetReservation, cause error) {
+	uc.releaseReservations(ctx, reservations)
+	uc.compensate(ctx, intent, cause)
+}
+
+// releaseReservations gives back the limits reserved by the lines of a slip that was not placed
+func (uc *GSUseCase) releaseReservations(ctx context.Context, reservations []domain.BetReservation) {
+	for _, reservation := range reservations {
+		uc.releaseReservation(ctx, reservation)
+	}
+}
+
+// slipRequestKey keeps the idempotency keys of PlaceBetSlip apart from those of PlaceBet
+func slipRequestKey(requestKey string) string {
+	return "slip-" + requestKey
+}
+
+// acquireSlipRequest reserves the idempotency key of a new PlaceBetSlip request, it returns the bets of a replayed
+// request and ErrRequestInProgress while the first attempt is still running
+func (uc *GSUseCase) acquireSlipRequest(ctx context.Context, userID int64, requestKey string) ([]*domain.Bet, error) {
+	if !domain.ValidRequestKey(requestKey) {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidRequestKey, requestKey)
+	}
+
+	request, err := uc.betRequestRepo.Acquire(ctx, userID, slipRequestKey(requestKey), betIntentTimeout)
+	if err != nil {
+		logger.Error(ctx).Err(err).Str("request_key", requestKey).Msg("检查重复下注请求失败")
+		return nil, fmt.Errorf("failed to acquire request key: %w", err)
+	}
+	if request == nil {
+		return nil, nil
+	}
+	if request.BetID == "" {
+		logger.Warn(ctx).Str("request_key", requestKey).Msg("重复下注请求处理中")
+		return nil, fmt.Errorf("%w: %s", domain.ErrRequestInProgress, requestKey)
+	}
+
+	logger.Info(ctx).
+		Str("request_key", requestKey).
+		Str("slip_id", request.BetID).
+		Msg("重复批量下注请求，返回原注单")
+
+	bets := make([]*domain.Bet, len(request.Lines))
+	for i, line := range request.Lines {
+		bet, err := uc.betRepo.GetUserBet(ctx, request.RoundID, userID, line.Color)
+		if err != nil || bet == nil || bet.BetID != line.BetID {
+			bet = &domain.Bet{
+				BetID:   line.BetID,
+				RoundID: request.RoundID,
+				UserID:  userID,
+				Color:   line.Color,
+				Amount:  line.Amount,
+				TxIDs:   []string{domain.SlipTxID(request.BetID, requestKey)},
+				SlipID:  request.BetID,
+			}
+		}
+		bets[i] = bet
+	}
+	return bets, nil
+}
@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	"github.com/frankieli/game_product/pkg/logger"
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	pbCommon "github.com/frankieli/game_product/shared/proto/common"
+)
+
+// CancelBet cancels (amount 0 or the whole stake) or reduces the bet of a player on color in the current round of a table.
+// GMS only accepts the cancellation while betting is open and the bet repository rejects it atomically once GMS
+// closed betting of the round (CloseBetting when the betting phase ends, again when the settlement starts), so the
+// limits and the refund are only given back for a bet that is really cancelled. The refund is recorded in the payout outbox together with the bet order and deposited right away.
+// requestKey deduplicates retries like PlaceBet (empty = not deduplicated).
+func (uc *GSUseCase) CancelBet(ctx context.Context, userID int64, tableID string, color domain.Color, amount int64, requestKey string) (*domain.BetCancellation, error) {
+	ctx = logger.WithFields(ctx, map[string]interface{}{
+		"user_id":  userID,
+		"table_id": tableID,
+	})
+
+	logger.Info(ctx).
+		Str("color", color.String()).
+		Int64("amount", amount).
+		Str("request_key", requestKey).
+		Msg("取消下注请求开始")
+
+	// 1. Deduplicate the request by its idempotency key, a replay returns the first cancellation
+	cancelled := false
+	if requestKey == "" {
+		requestKey = domain.NewRequestKey()
+	} else {
+		replayed, err := uc.acquireCancelRequest(ctx, userID, requestKey)
+		if err != nil || replayed != nil {
+			return replayed, err
+		}
+		defer func() {
+			if !cancelled {
+				uc.releaseRequest(ctx, userID, cancelRequestKey(requestKey))
+			}
+		}()
+	}
+
+	// 2. Only bets of a round that is still betting can be cancelled
+	roundRsp, err := uc.getCurrentRound(ctx, userID, tableID)
+	if err != nil {
+		logger.Error(ctx).Err(err).Msg("获取当前回合失败")
+		return nil, fmt.Errorf("failed to get current round: %w", err)
+	}
+	ctx = logger.WithFields(ctx, map[string]interface{}{
+		"round_id": roundRsp.RoundId,
+	})
+	if roundRsp.State != pbColorGame.ColorGameState_GAME_STATE_BETTING {
+		logger.Warn(ctx).
+			Str("round_state", roundRsp.State.String()).
+			Msg("当前状态不接受取消下注")
+		return nil, fmt.Errorf("%w: %s", domain.ErrRoundNotActive, roundRsp.State)
+	}
+
+	// 3. Validate the amount against the bet, a reduced bet must still meet the minimum bet
+	bet, err := uc.betRepo.GetUserBet(ctx, roundRsp.RoundId, userID, color)
+	if err != nil {
+		logger.Error(ctx).Err(err).Msg("查询下注失败")
+		return nil, fmt.Errorf("failed to get bet: %w", err)
+	}
+	if bet == nil {
+		logger.Warn(ctx).Str("color", color.String()).Msg("没有可取消的下注")
+		return nil, fmt.Errorf("%w: %s", domain.ErrBetNotFound, color)
+	}
+	stake := bet.Amount
+	if amount == 0 {
+		amount = stake
+	}
+	remaining := stake - amount
+	if amount < 0 || remaining < 0 {
+		return nil, fmt.Errorf("%w: cannot cancel %d of a bet of %d", domain.ErrInvalidBetAmount, amount, stake)
+	}
+	if remaining > 0 && remaining < max(uc.betLimits.MinBet, 1) {
+		return nil, fmt.Errorf("%w: %d left is below the minimum bet %d", domain.ErrInvalidBetAmount, remaining, uc.betLimits.MinBet)
+	}
+
+	// 4. Take the stake back from the GMS round stats, GMS rejects it atomically once betting closed
+	removed := remaining == 0
+	if err := uc.revokeBet(ctx, roundRsp.TableId, roundRsp.RoundId, userID, color, amount, removed, false); err != nil {
+		return nil, err
+	}
+
+	// 5. Update the bet, unless a concurrent request changed it since it was read or betting closed since step 4
+	refundTxID := domain.CancelTxID(bet.BetID, requestKey)
+	if err := uc.betRepo.CancelBet(ctx, bet, stake, amount, refundTxID); err != nil {
+		logger.Error(ctx).Err(err).Str("bet_id", bet.BetID).Msg("更新下注失败")
+		uc.restoreRoundStats(ctx, roundRsp.TableId, roundRsp.RoundId, userID, color, amount, removed)
+		return nil, fmt.Errorf("failed to cancel bet %s: %w", bet.BetID, err)
+	}
+
+	// 6. Give the cancelled stake back to the round limits
+	uc.releaseReservation(ctx, domain.BetReservation{
+		RoundID: roundRsp.RoundId,
+		UserID:  userID,
+		Color:   color,
+		Amount:  amount,
+		Payout:  uc.paytable.MaxWinAmount(color, amount),
+	})
+
+	// 7. Record the refund with the bet order, then deposit it (a failed deposit is retried by the payout worker)
+	cancelled = true
+	uc.refundCancelledStake(ctx, roundRsp.TableId, bet, stake, amount, refundTxID)
+
+	result := &domain.BetCancellation{
+		BetID:     bet.BetID,
+		RoundID:   roundRsp.RoundId,
+		Color:     color,
+		Refund:    amount,
+		Remaining: remaining,
+	}
+	uc.completeRequest(ctx, &domain.BetRequest{
+		Key:     cancelRequestKey(requestKey),
+		UserID:  userID,
+		BetID:   bet.BetID,
+		RoundID: roundRsp.RoundId,
+		Color:   color,
+		Amount:  amount,
+	})
+
+	logger.Info(ctx).
+		Str("bet_id", bet.BetID).
+		Int64("refund_amount", amount).
+		Int64("remaining_amount", remaining).
+		Msg("取消下注成功")
+
+	return result, nil
+}
+
+// revokeBet asks GMS to take a cancelled stake back from the round stats (restore puts it back)
+func (uc *GSUseCase) revokeBet(ctx context.Context, tableID string, roundID string, userID int64, color domain.Color, amount int64, removed bool, restore bool) error {
+	rsp, err := uc.gmsService.RevokeBet(ctx, &pbColorGame.ColorGameRevokeBetReq{
+		TableId: tableID,
+		RoundId: roundID,
+		UserId:  userID,
+		Color:   color,
+		Amount:  amount,
+		Removed: removed,
+		Restore: restore,
+	})
+	if err != nil {
+		logger.Error(ctx).Err(err).Msg("GMS 取消下注失败")
+		return fmt.Errorf("failed to revoke bet in GMS: %w", err)
+	}
+
+	switch rsp.ErrorCode {
+	case pbCommon.ErrorCode_SUCCESS:
+		return nil
+	case pbCommon.ErrorCode_ROUND_NOT_ACTIVE:
+		logger.Warn(ctx).Str("error", rsp.Error).Msg("下注已截止，不接受取消下注")
+		return fmt.Errorf("%w: %s", domain.ErrRoundNotActive, rsp.Error)
+	default:
+		return fmt.Errorf("GMS returned %s: %s", rsp.ErrorCode, rsp.Error)
+	}
+}
+
+// restoreRoundStats puts a stake back into the GMS round stats when the bet could not be cancelled after all.
+// The bet usually failed because betting just closed, GMS restores the stake without checking the phase.
+func (uc *GSUseCase) restoreRoundStats(ctx context.Context, tableID string, roundID string, userID int64, color domain.Color, amount int64, removed bool) {
+	if err := uc.revokeBet(ctx, tableID, roundID, userID, color, amount, removed, true); err != nil {
+		logger.Warn(ctx).Err(err).Int64("amount", amount).Msg("回补 GMS 下注统计失败")
+	}
+}
+
+// refundCancelledStake records the refund of a cancelled stake in the payout outbox together with the bet order
+// (Pending with the remaining stake, or Cancelled) and deposits it
+func (uc *GSUseCase) refundCancelledStake(ctx context.Context, tableID string, bet *domain.Bet, stake int64, amount int64, refundTxID string) {
+	now := uc.clock.Now()
+	order := &domain.BetOrder{
+		OrderID:   bet.BetID,
+		UserID:    bet.UserID,
+		RoundID:   bet.RoundID,
+		GameCode:  "color_game",
+		BetArea:   bet.Color.String(),
+		Amount:    float64(bet.Amount),
+		Status:    domain.BetOrderStatusPending,
+		TxIDs:     domain.JoinTxIDs(bet.TxIDs),
+		CreatedAt: bet.Time,
+	}
+	if bet.Amount == 0 {
+		order.Amount = float64(stake)
+		order.Status = domain.BetOrderStatusCancelled
+		order.SettledAt = &now
+	}
+
+	// A refund has no dice, the player is not sent a settlement once it lands
+	payout := &domain.Payout{
+		TxID:        refundTxID,
+		BetID:       bet.BetID,
+		TableID:     tableID,
+		RoundID:     bet.RoundID,
+		UserID:      bet.UserID,
+		Color:       bet.Color,
+		BetAmount:   stake,
+		Amount:      amount,
+		Status:      domain.PayoutStatusPending,
+		NextRetryAt: now.Add(payoutTimeout),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := uc.payoutRepo.CreateWithOrders(ctx, []*domain.BetOrder{order}, []*domain.Payout{payout}); err != nil {
+		// The bet is already cancelled, the refund is still deposited
+		logger.Error(ctx).
+			Err(err).
+			Str("bet_id", bet.BetID).
+			Str("tx_id", refundTxID).
+			Int64("refund_amount", amount).
+			Msg("保存取消退款失败")
+	}
+	uc.deliverPayout(ctx, payout)
+}
+
+// cancelRequestKey keeps the idempotency keys of CancelBet apart from those of PlaceBet
+func cancelRequestKey(requestKey string) string {
+	return "cancel-" + requestKey
+}
+
+// acquireCancelRequest reserves the idempotency key of a new CancelBet request, it returns the outcome of a replayed
+// request and ErrRequestInProgress while the first attempt is still running
+func (uc *GSUseCase) acquireCancelRequest(ctx context.Context, userID int64, requestKey string) (*domain.BetCancellation, error) {
+	if !domain.ValidRequestKey(requestKey) {
+		return nil, fmt.Errorf("%w: %q", domain.ErrInvalidRequestKey, requestKey)
+	}
+
+	request, err := uc.betRequestRepo.Acquire(ctx, userID, cancelRequestKey(requestKey), betIntentTimeout)
+	if err != nil {
+		logger.Error(ctx).Err(err).Str("request_key", requestKey).Msg("检查重复取消请求失败")
+		return nil, fmt.Errorf("failed to acquire request key: %w", err)
+	}
+	if request == nil {
+		return nil, nil
+	}
+	if request.BetID == "" {
+		logger.Warn(ctx).Str("request_key", requestKey).Msg("重复取消请求处理中")
+		return nil, fmt.Errorf("%w: %s", domain.ErrRequestInProgress, requestKey)
+	}
+
+	logger.Info(ctx).
+		Str("request_key", requestKey).
+		Str("bet_id", request.BetID).
+		Msg("重复取消请求，返回原结果")
+
+	result := &domain.BetCancellation{
+		BetID:   request.BetID,
+		RoundID: request.RoundID,
+		Color:   request.Color,
+		Refund:  request.Amount,
+	}
+	bet, err := uc.betRepo.GetUserBet(ctx, request.RoundID, userID, request.Color)
+	if err == nil && bet != nil && bet.BetID == request.BetID {
+		result.Remaining = bet.Amount
+	}
+	return result, nil
+}
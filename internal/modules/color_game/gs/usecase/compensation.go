@@ -0,0 +1,227 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	"github.com/frankieli/game_product/pkg/logger"
+	"github.com/frankieli/game_product/pkg/service"
+)
+
+const (
+	// betIntentTimeout is how long PlaceBet may take, an older pending intent belongs to a GS that died halfway
+	betIntentTimeout = time.Minute
+
+	compensationBatchSize = 100
+
+	// Failed wallet calls (rollbacks, payouts) are retried from retryMinBackoff, doubling up to retryMaxBackoff
+	retryMinBackoff = time.Second
+	retryMaxBackoff = 5 * time.Minute
+)
+
+// SetBetIntentRepository replaces the in-memory bet intents (production uses the bet_intents table)
+func (uc *GSUseCase) SetBetIntentRepository(repo domain.BetIntentRepository) {
+	uc.betIntentRepo = repo
+}
+
+// retryBackoff doubles the retry delay with every failed attempt, up to retryMaxBackoff
+func retryBackoff(attempts int) time.Duration {
+	backoff := retryMinBackoff
+	for i := 1; i < attempts && backoff < retryMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, retryMaxBackoff)
+}
+
+// compensate rolls back the stake of a bet that could not be placed.
+// A failed rollback stays COMPENSATING and is retried by the compensation worker.
+func (uc *GSUseCase) compensate(ctx context.Context, intent *domain.BetIntent, cause error) {
+	intent.Status = domain.BetIntentStatusCompensating
+	intent.LastError = cause.Error()
+	uc.rollbackIntent(ctx, intent)
+}
+
+// rollbackIntent calls the wallet Rollback for a COMPENSATING intent and saves the outcome
+func (uc *GSUseCase) rollbackIntent(ctx context.Context, intent *domain.BetIntent) {
+	intent.Attempts++
+	now := uc.clock.Now()
+	intent.UpdatedAt = now
+
+	_, err := uc.walletSvc.Rollback(ctx, intent.TxID, "compensate:"+intent.RoundID)
+	switch {
+	case err == nil, errors.Is(err, service.ErrTransactionNotFound):
+		// Nothing was deducted when the wallet does not know the transaction
+		intent.Status = domain.BetIntentStatusCompensated
+		logger.Info(ctx).
+			Str("tx_id", intent.TxID).
+			Int64("user_id", intent.UserID).
+			Int64("amount", intent.Amount).
+			Int("attempts", intent.Attempts).
+			Msg("下注补偿完成，已回滚扣款")
+	default:
+		intent.LastError = err.Error()
+		intent.NextRetryAt = now.Add(retryBackoff(intent.Attempts))
+		logger.Error(ctx).
+			Err(err).
+			Str("tx_id", intent.TxID).
+			Int64("user_id", intent.UserID).
+			Int64("amount", intent.Amount).
+			Int("attempts", intent.Attempts).
+			Time("next_retry_at", intent.NextRetryAt).
+			Msg("下注补偿失败，稍后重试")
+	}
+
+	if err := uc.betIntentRepo.Update(ctx, intent); err != nil {
+		logger.Error(ctx).Err(err).Str("tx_id", intent.TxID).Msg("保存下注补偿状态失败")
+	}
+}
+
+// RunCompensations processes the due bet intents once, returns how many were compensated.
+//   - COMPENSATING: the rollback is retried
+//   - PENDING past betIntentTimeout: the GS placing it died, the stake is rolled back
+//   - PLACED past betIntentTimeout: the GS died while saving the bet. The intent is deleted when the bet exists,
+//     otherwise it is left to operators (RetryCompensation), the bet may already be settled.
+func (uc *GSUseCase) RunCompensations(ctx context.Context) (int, error) {
+	intents, err := uc.betIntentRepo.ListDue(ctx, uc.clock.Now(), compensationBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list due bet intents: %w", err)
+	}
+
+	compensated := 0
+	for _, intent := range intents {
+		switch intent.Status {
+		case domain.BetIntentStatusPending:
+			won, err := uc.betIntentRepo.Transition(ctx, intent.TxID, domain.BetIntentStatusPending, domain.BetIntentStatusCompensating)
+			if err != nil || !won {
+				continue // PlaceBet finished meanwhile, or another worker took it
+			}
+			uc.compensate(ctx, intent, fmt.Errorf("bet not placed within %s", betIntentTimeout))
+
+		case domain.BetIntentStatusPlaced:
+			uc.checkPlacedIntent(ctx, intent)
+			continue
+
+		case domain.BetIntentStatusCompensating:
+			uc.rollbackIntent(ctx, intent)
+		}
+
+		if intent.Status == domain.BetIntentStatusCompensated {
+			compensated++
+		}
+	}
+	return compensated, nil
+}
+
+// checkPlacedIntent deletes the intent of a saved bet, or flags it for operators
+func (uc *GSUseCase) checkPlacedIntent(ctx context.Context, intent *domain.BetIntent) {
+	bet, err := uc.betRepo.GetUserBet(ctx, intent.RoundID, intent.UserID, intent.Color)
+	if err == nil && bet != nil {
+		for _, txID := range bet.TxIDs {
+			if txID == intent.TxID {
+				_ = uc.betIntentRepo.Delete(ctx, intent.TxID)
+				return
+			}
+		}
+	}
+
+	intent.LastError = "bet not found after placement, verify the round before RetryCompensation"
+	intent.UpdatedAt = uc.clock.Now()
+	intent.NextRetryAt = intent.UpdatedAt.Add(retryMaxBackoff)
+	logger.Error(ctx).
+		Str("tx_id", intent.TxID).
+		Str("round_id", intent.RoundID).
+		Int64("user_id", intent.UserID).
+		Int64("amount", intent.Amount).
+		Msg("下注中断且找不到注单，需人工确认")
+	if err := uc.betIntentRepo.Update(ctx, intent); err != nil {
+		logger.Error(ctx).Err(err).Str("tx_id", intent.TxID).Msg("保存下注补偿状态失败")
+	}
+}
+
+// revertIntentOrders takes a compensated stake out of the pending bet orders PlaceBet wrote for it before it stopped
+func (uc *GSUseCase) revertIntentOrders(ctx context.Context, intent *domain.BetIntent) {
+	if uc.betOrderRepo == nil {
+		return
+	}
+	userOrders, err := uc.betOrderRepo.ListByUserRound(ctx, intent.UserID, intent.RoundID)
+	if err != nil {
+		logger.Error(ctx).Err(err).Str("tx_id", intent.TxID).Msg("查询补偿注单失败")
+		return
+	}
+
+	var orders []*domain.BetOrder
+	var raised []bool
+	for _, order := range userOrders {
+		if order.Status == domain.BetOrderStatusPending && order.HasTxID(intent.TxID) {
+			orders = append(orders, order)
+			raised = append(raised, order.TxIDs != intent.TxID)
+		}
+	}
+	uc.revertPendingOrders(ctx, orders, raised)
+}
+
+// StartCompensationWorker runs RunCompensations every interval until ctx is done
+func (uc *GSUseCase) StartCompensationWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := uc.RunCompensations(ctx); err != nil {
+				logger.Error(ctx).Err(err).Msg("Compensation worker failed")
+			}
+		}
+	}
+}
+
+// ListCompensations returns the bet intents in a status for operators, COMPENSATING ones are stuck rollbacks
+func (uc *GSUseCase) ListCompensations(ctx context.Context, status domain.BetIntentStatus, limit int) ([]*domain.BetIntent, error) {
+	if limit <= 0 {
+		limit = compensationBatchSize
+	}
+	return uc.betIntentRepo.List(ctx, status, limit)
+}
+
+// RetryCompensation rolls back the stake of a bet intent immediately (operators).
+// PENDING and PLACED intents are compensated too, operators use it once they verified the bet was not placed.
+func (uc *GSUseCase) RetryCompensation(ctx context.Context, txID string, operator string) (*domain.BetIntent, error) {
+	intent, err := uc.betIntentRepo.Get(ctx, txID)
+	if err != nil {
+		return nil, err
+	}
+	if intent == nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrBetIntentNotFound, txID)
+	}
+
+	logger.Warn(ctx).
+		Str("tx_id", txID).
+		Str("status", intent.Status.String()).
+		Str("operator", operator).
+		Msg("Operator retries bet compensation")
+
+	switch intent.Status {
+	case domain.BetIntentStatusCompensated:
+		return intent, nil
+	case domain.BetIntentStatusPending, domain.BetIntentStatusPlaced:
+		won, err := uc.betIntentRepo.Transition(ctx, txID, intent.Status, domain.BetIntentStatusCompensating)
+		if err != nil {
+			return nil, err
+		}
+		if !won {
+			return nil, fmt.Errorf("bet intent %s changed meanwhile, retry", txID)
+		}
+		uc.compensate(ctx, intent, fmt.Errorf("compensated by operator %s", operator))
+		if intent.Status == domain.BetIntentStatusCompensated {
+			uc.revertIntentOrders(ctx, intent)
+		}
+	default:
+		uc.rollbackIntent(ctx, intent)
+	}
+	return intent, nil
+}
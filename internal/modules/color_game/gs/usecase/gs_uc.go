@@ -3,14 +3,19 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/repository/memory"
+	"github.com/frankieli/game_product/pkg/clock"
 	"github.com/frankieli/game_product/pkg/logger"
 	"github.com/frankieli/game_product/pkg/service"
 	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
 	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	pbCommon "github.com/frankieli/game_product/shared/proto/common"
 )
 
 // GSUseCase handles player betting logic
@@ -20,6 +25,19 @@ type GSUseCase struct {
 	gmsService         colorgame.GMSService
 	walletSvc          service.WalletService
 	gatewayBroadcaster service.GatewayService
+	clock              clock.Clock
+	paytable           *colorgame.Paytable
+	betLimits          domain.BetLimits
+	betLimitRepo       domain.BetLimitRepository    // round totals the limits are checked against
+	betIntentRepo      domain.BetIntentRepository   // stake deductions in flight (PlaceBet saga)
+	betRequestRepo     domain.BetRequestRepository  // PlaceBet requests by idempotency key
+	payoutRepo         domain.PayoutRepository      // winnings owed by settled bets (payout outbox)
+	settlementRepo     domain.SettlementRepository  // settlement checkpoints, shared by every GS instance
+	lastSlipRepo       domain.LastBetSlipRepository // last settled bet slip of each player (Rebet)
+	autoBetRepo        domain.AutoBetRepository     // auto bets players opted in to
+	instanceID         string                       // owner of the settlements this GS claims
+	settling           map[string]func()            // rounds this GS is settling right now -> stops their lease renewal
+	settlingMu         sync.Mutex
 }
 
 // NewGSUseCase creates a new player use case
@@ -36,23 +54,74 @@ func NewGSUseCase(
 		gmsService:         gmsService,
 		walletSvc:          walletSvc,
 		gatewayBroadcaster: gatewayBroadcaster,
+		clock:              clock.New(),
+		paytable:           colorgame.DefaultPaytable(),
+		betLimits:          domain.BetLimits{MinBet: 1},
+		betLimitRepo:       memory.NewBetLimitRepository(),
+		betIntentRepo:      memory.NewBetIntentRepository(),
+		betRequestRepo:     memory.NewBetRequestRepository(),
+		payoutRepo:         memory.NewPayoutRepository(betOrderRepo),
+		settlementRepo:     memory.NewSettlementRepository(),
+		lastSlipRepo:       memory.NewLastBetSlipRepository(),
+		autoBetRepo:        memory.NewAutoBetRepository(),
+		instanceID:         newInstanceID(),
+		settling:           make(map[string]func()),
 	}
 }
 
-// PlaceBet handles a player placing a bet
-func (uc *GSUseCase) PlaceBet(ctx context.Context, userID int64, color domain.Color, amount int64) (*domain.Bet, error) {
+// SetClock replaces the clock used for bet and settlement timestamps (tests use a fake clock)
+func (uc *GSUseCase) SetClock(c clock.Clock) {
+	uc.clock = c
+}
+
+// SetPaytable sets the colors bets are accepted on and their payouts, must match the paytable GMS draws with
+func (uc *GSUseCase) SetPaytable(paytable *colorgame.Paytable) {
+	uc.paytable = paytable
+}
+
+// SetBetLimits sets the stake limits of every table
+func (uc *GSUseCase) SetBetLimits(limits domain.BetLimits) {
+	uc.betLimits = limits
+}
+
+// SetBetLimitRepository replaces the in-memory round totals (GS instances share a Redis store)
+func (uc *GSUseCase) SetBetLimitRepository(repo domain.BetLimitRepository) {
+	uc.betLimitRepo = repo
+}
+
+// PlaceBet handles a player placing a bet on the current round of a table (empty tableID = default table).
+// requestKey is the idempotency key of the client, a retried request returns the bet it placed (empty = not deduplicated).
+func (uc *GSUseCase) PlaceBet(ctx context.Context, userID int64, tableID string, color domain.Color, amount int64, requestKey string) (*domain.Bet, error) {
 	// Inject UserID into context logger
 	ctx = logger.WithFields(ctx, map[string]interface{}{
-		"user_id": userID,
+		"user_id":  userID,
+		"table_id": tableID,
 	})
 
 	logger.Info(ctx).
 		Str("color", color.String()).
 		Int64("amount", amount).
+		Str("request_key", requestKey).
 		Msg("下注请求开始")
 
-	// 1. Get current round from GMS
-	roundRsp, err := uc.gmsService.GetCurrentRound(ctx, &pbColorGame.ColorGameGetCurrentRoundReq{})
+	// 1. Deduplicate the request by its idempotency key, a replay returns the bet it placed
+	placed := false
+	if requestKey == "" {
+		requestKey = domain.NewRequestKey()
+	} else {
+		replayed, err := uc.acquireRequest(ctx, userID, requestKey)
+		if err != nil || replayed != nil {
+			return replayed, err
+		}
+		defer func() {
+			if !placed {
+				uc.releaseRequest(ctx, userID, requestKey)
+			}
+		}()
+	}
+
+	// 2. Get current round from GMS
+	roundRsp, err := uc.getCurrentRound(ctx, userID, tableID)
 	if err != nil {
 		logger.Error(ctx).
 			Err(err).
@@ -69,30 +138,100 @@ func (uc *GSUseCase) PlaceBet(ctx context.Context, userID int64, color domain.Co
 		Str("round_state", roundRsp.State.String()).
 		Msg("当前回合信息")
 
-	// 2. Validate color
-	if !isValidColor(color) {
+	// 3. Validate round state, color and stake
+	if roundRsp.State != pbColorGame.ColorGameState_GAME_STATE_BETTING {
+		logger.Warn(ctx).
+			Str("round_state", roundRsp.State.String()).
+			Msg("当前状态不接受下注")
+		return nil, fmt.Errorf("%w: %s", domain.ErrRoundNotActive, roundRsp.State)
+	}
+	if !uc.paytable.Contains(color) {
 		logger.Warn(ctx).
 			Str("color", color.String()).
 			Msg("无效的颜色")
-		return nil, fmt.Errorf("invalid color: %s", color)
+		return nil, fmt.Errorf("%w: %s", domain.ErrInvalidBetOption, color)
+	}
+	if err := uc.betLimits.CheckAmount(amount); err != nil {
+		logger.Warn(ctx).
+			Err(err).
+			Int64("amount", amount).
+			Msg("下注金额无效")
+		return nil, err
 	}
 
-	// 3. Deduct from wallet
-	_, err = uc.walletSvc.PlaceBet(ctx, userID, amount, roundRsp.RoundId)
+	// 4. Check if user already placed a bet on this color, the stake is added to it (same bet ID)
+	existingBet, err := uc.betRepo.GetUserBet(ctx, roundRsp.RoundId, userID, color)
+	if err != nil {
+		logger.Error(ctx).Err(err).Msg("检查现有下注失败")
+		return nil, fmt.Errorf("failed to check existing bet: %w", err)
+	}
+	betID := domain.NewBetID()
+	if existingBet != nil {
+		betID = existingBet.BetID
+	}
+
+	// 5. Reserve the stake against the round limits (atomic across GS instances)
+	reservation := domain.BetReservation{
+		RoundID: roundRsp.RoundId,
+		UserID:  userID,
+		Color:   color,
+		Amount:  amount,
+		Payout:  uc.paytable.MaxWinAmount(color, amount),
+	}
+	if err := uc.betLimitRepo.Reserve(ctx, reservation, uc.betLimits); err != nil {
+		logger.Warn(ctx).
+			Err(err).
+			Int64("amount", amount).
+			Msg("超过下注限额")
+		return nil, err
+	}
+
+	// 6. Record the bet intent, the saga rolls the deduction back when the bet cannot be placed
+	txID := domain.BetTxID(betID, requestKey)
+	now := uc.clock.Now()
+	intent := &domain.BetIntent{
+		TxID:        txID,
+		RoundID:     roundRsp.RoundId,
+		UserID:      userID,
+		Color:       color,
+		Amount:      amount,
+		Status:      domain.BetIntentStatusPending,
+		NextRetryAt: now.Add(betIntentTimeout),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := uc.betIntentRepo.Create(ctx, intent); err != nil {
+		logger.Error(ctx).Err(err).Msg("记录下注意图失败")
+		uc.releaseReservation(ctx, reservation)
+		return nil, fmt.Errorf("failed to record bet intent: %w", err)
+	}
+
+	// 7. Deduct from wallet, the transaction ID is kept on the bet so that a void can roll it back
+	_, err = uc.walletSvc.PlaceBet(ctx, userID, amount, roundRsp.RoundId, txID)
 	if err != nil {
 		logger.Error(ctx).
 			Err(err).
 			Int64("amount", amount).
 			Msg("钱包扣款失败")
+		if errors.Is(err, service.ErrWalletRejected) {
+			// Nothing was deducted
+			uc.releaseReservation(ctx, reservation)
+			_ = uc.betIntentRepo.Delete(ctx, txID)
+		} else {
+			// The deduction may have been applied (e.g. the call timed out)
+			uc.abortPlacement(ctx, intent, reservation, err)
+		}
 		return nil, fmt.Errorf("failed to deduct from wallet: %w", err)
 	}
 
 	logger.Debug(ctx).
 		Int64("amount", amount).
+		Str("tx_id", txID).
 		Msg("钱包扣款成功")
 
-	// 4. Record bet in GMS
+	// 8. Record bet in GMS
 	_, err = uc.gmsService.RecordBet(ctx, &pbColorGame.ColorGameRecordBetReq{
+		TableId: roundRsp.TableId,
 		RoundId: roundRsp.RoundId,
 		UserId:  userID,
 		Color:   color,
@@ -102,19 +241,55 @@ func (uc *GSUseCase) PlaceBet(ctx context.Context, userID int64, color domain.Co
 		logger.Error(ctx).
 			Err(err).
 			Msg("GMS 记录下注失败")
-		// TODO: Rollback wallet deduction
+		uc.abortPlacement(ctx, intent, reservation, err)
 		return nil, fmt.Errorf("failed to record bet in GMS: %w", err)
 	}
 
-	// 5. Check if user already placed a bet on this color
-	existingBet, err := uc.betRepo.GetUserBet(ctx, roundRsp.RoundId, userID, color)
+	// 9. Claim the intent, unless the compensation worker already took it over (PlaceBet ran past betIntentTimeout)
+	won, err := uc.betIntentRepo.Transition(ctx, txID, domain.BetIntentStatusPending, domain.BetIntentStatusPlaced)
 	if err != nil {
-		logger.Error(ctx).Err(err).Msg("检查现有下注失败")
-		return nil, fmt.Errorf("failed to check existing bet: %w", err)
+		logger.Error(ctx).Err(err).Msg("更新下注意图失败")
+		uc.abortPlacement(ctx, intent, reservation, err)
+		return nil, fmt.Errorf("failed to claim bet intent: %w", err)
+	}
+	if !won {
+		logger.Error(ctx).Str("tx_id", txID).Msg("下注逾时，已由补偿流程回滚")
+		uc.releaseReservation(ctx, reservation)
+		return nil, fmt.Errorf("bet %s timed out and is being compensated", txID)
 	}
 
+	// 10. Persist the stake as a pending bet order, the durable record of the bet until settlement
 	var bet *domain.Bet
+	order := &domain.BetOrder{
+		OrderID:  betID,
+		UserID:   userID,
+		RoundID:  roundRsp.RoundId,
+		GameCode: "color_game",
+		BetArea:  color.String(),
+		Status:   domain.BetOrderStatusPending,
+	}
+	if existingBet != nil {
+		order.Amount = float64(existingBet.Amount + amount)
+		order.TxIDs = domain.JoinTxIDs(append(append([]string(nil), existingBet.TxIDs...), txID))
+		order.CreatedAt = existingBet.Time
+	} else {
+		bet = domain.NewBet(betID, roundRsp.RoundId, userID, color, amount, txID, uc.clock.Now())
+		order.Amount = float64(bet.Amount)
+		order.TxIDs = domain.JoinTxIDs(bet.TxIDs)
+		order.CreatedAt = bet.Time
+	}
+	if uc.betOrderRepo != nil {
+		if err := uc.betOrderRepo.SavePending(ctx, []*domain.BetOrder{order}); err != nil {
+			logger.Error(ctx).
+				Err(err).
+				Str("bet_id", order.OrderID).
+				Msg("保存待结算注单失败")
+			uc.abortPlacement(ctx, intent, reservation, err)
+			return nil, fmt.Errorf("failed to save pending bet order: %w", err)
+		}
+	}
 
+	// 11. Save the bet for settlement
 	if existingBet != nil {
 		// Update existing bet
 		logger.Info(ctx).
@@ -123,25 +298,42 @@ func (uc *GSUseCase) PlaceBet(ctx context.Context, userID int64, color domain.Co
 			Int64("additional_amount", amount).
 			Msg("更新现有下注金额")
 
-		err = uc.betRepo.UpdateBetAmount(ctx, existingBet, amount)
+		err = uc.betRepo.UpdateBetAmount(ctx, existingBet, amount, txID)
 		if err != nil {
 			logger.Error(ctx).Err(err).Msg("更新下注金额失败")
+			uc.revertPendingOrders(ctx, []*domain.BetOrder{order}, []bool{true})
+			uc.abortPlacement(ctx, intent, reservation, err)
 			return nil, fmt.Errorf("failed to update bet amount: %w", err)
 		}
 		bet = existingBet
 	} else {
 		// Create new bet
-		bet = domain.NewBet(roundRsp.RoundId, userID, color, amount)
 		err = uc.betRepo.SaveBet(ctx, bet)
 		if err != nil {
 			logger.Error(ctx).
 				Err(err).
 				Str("bet_id", bet.BetID).
 				Msg("保存下注记录失败")
+			uc.revertPendingOrders(ctx, []*domain.BetOrder{order}, []bool{false})
+			uc.abortPlacement(ctx, intent, reservation, err)
 			return nil, fmt.Errorf("failed to save bet: %w", err)
 		}
 	}
 
+	// 12. The bet is placed, the intent is done and replays of the request return the bet
+	placed = true
+	if err := uc.betIntentRepo.Delete(ctx, txID); err != nil {
+		logger.Warn(ctx).Err(err).Str("tx_id", txID).Msg("删除下注意图失败")
+	}
+	uc.completeRequest(ctx, &domain.BetRequest{
+		Key:     requestKey,
+		UserID:  userID,
+		BetID:   bet.BetID,
+		RoundID: bet.RoundID,
+		Color:   color,
+		Amount:  amount,
+	})
+
 	logger.Info(ctx).
 		Str("color", color.String()).
 		Int64("total_amount", bet.Amount).
@@ -151,15 +343,87 @@ func (uc *GSUseCase) PlaceBet(ctx context.Context, userID int64, color domain.Co
 	return bet, nil
 }
 
-// GetCurrentRound gets the current round info with player's bets
-func (uc *GSUseCase) GetCurrentRound(ctx context.Context, userID int64) (map[string]interface{}, error) {
+// abortPlacement frees the limits of a bet that could not be placed and compensates its stake
+func (uc *GSUseCase) abortPlacement(ctx context.Context, intent *domain.BetIntent, reservation domain.BetReservation, cause error) {
+	uc.releaseReservation(ctx, reservation)
+	uc.compensate(ctx, intent, cause)
+}
+
+// revertPendingOrders takes the stakes of a placement that was aborted out of the pending bet orders written for it.
+// The order of a bet that was never saved is cancelled, the order of a raised bet (raised[i]) is written back with
+// the stake the bet has now. An order whose bet is gone was cancelled by CancelBet, which records it.
+func (uc *GSUseCase) revertPendingOrders(ctx context.Context, orders []*domain.BetOrder, raised []bool) {
+	if uc.betOrderRepo == nil || len(orders) == 0 {
+		return
+	}
+
+	now := uc.clock.Now()
+	reverted := make([]*domain.BetOrder, 0, len(orders))
+	for i, order := range orders {
+		revert := *order
+		if !raised[i] {
+			revert.Status = domain.BetOrderStatusCancelled
+			revert.SettledAt = &now
+			reverted = append(reverted, &revert)
+			continue
+		}
+
+		color := domain.Color(pbColorGame.ColorGameReward_value[order.BetArea])
+		bet, err := uc.betRepo.GetUserBet(ctx, order.RoundID, order.UserID, color)
+		if err != nil || bet == nil || bet.BetID != order.OrderID {
+			logger.Warn(ctx).Err(err).Str("bet_id", order.OrderID).Msg("注单对应的下注不存在，略过回滚")
+			continue
+		}
+		revert.Amount = float64(bet.Amount)
+		revert.TxIDs = domain.JoinTxIDs(bet.TxIDs)
+		reverted = append(reverted, &revert)
+	}
+
+	if err := uc.betOrderRepo.BatchCreate(ctx, reverted); err != nil {
+		// Reconciliation flags the orders left pending
+		logger.Error(ctx).Err(err).Int("count", len(reverted)).Msg("回滚待结算注单失败")
+	}
+}
+
+// releaseReservation gives back the limits reserved by a bet that was not placed
+func (uc *GSUseCase) releaseReservation(ctx context.Context, reservation domain.BetReservation) {
+	if err := uc.betLimitRepo.Release(ctx, reservation); err != nil {
+		logger.Error(ctx).Err(err).Msg("释放下注限额失败")
+	}
+}
+
+// getCurrentRound asks GMS for the current round of a table
+func (uc *GSUseCase) getCurrentRound(ctx context.Context, userID int64, tableID string) (*pbColorGame.ColorGameGetCurrentRoundRsp, error) {
 	roundRsp, err := uc.gmsService.GetCurrentRound(ctx, &pbColorGame.ColorGameGetCurrentRoundReq{
-		UserId: userID,
+		UserId:  userID,
+		TableId: tableID,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	switch roundRsp.ErrorCode {
+	case pbCommon.ErrorCode_SUCCESS:
+		return roundRsp, nil
+	case pbCommon.ErrorCode_NOT_FOUND:
+		return nil, fmt.Errorf("%w: %s", domain.ErrTableNotFound, tableID)
+	default:
+		return nil, fmt.Errorf("GMS returned %s", roundRsp.ErrorCode)
+	}
+}
+
+// GetRoundHistory returns the results roadmap of a table, GMS caches the roadmap and GS only relays it
+func (uc *GSUseCase) GetRoundHistory(ctx context.Context, req *pbColorGame.ColorGameGetRoundHistoryReq) (*pbColorGame.ColorGameGetRoundHistoryRsp, error) {
+	return uc.gmsService.GetRoundHistory(ctx, req)
+}
+
+// GetCurrentRound gets the current round info of a table with player's bets
+func (uc *GSUseCase) GetCurrentRound(ctx context.Context, userID int64, tableID string) (map[string]interface{}, error) {
+	roundRsp, err := uc.getCurrentRound(ctx, userID, tableID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get player's bets for this round
 	playerBets, err := uc.betRepo.GetUserBets(ctx, roundRsp.RoundId, userID)
 	if err != nil {
@@ -181,119 +445,147 @@ func (uc *GSUseCase) GetCurrentRound(ctx context.Context, userID int64) (map[str
 		})
 	}
 
-	return map[string]interface{}{
+	state := map[string]interface{}{
+		"table_id":    roundRsp.TableId,
 		"round_id":    roundRsp.RoundId,
 		"state":       roundRsp.State.String(),
 		"betting_end": time.Unix(roundRsp.BettingEndTimestamp, 0),
 		"player_bets": bets,
-	}, nil
+	}
+
+	// The auto bet of the player, so that a reconnecting client shows it
+	if autoBet, err := uc.autoBetRepo.Get(ctx, userID); err == nil && autoBet != nil {
+		state["auto_bet"] = map[string]interface{}{
+			"table_id":    autoBet.TableID,
+			"rounds_left": autoBet.RoundsLeft,
+			"stop_loss":   autoBet.StopLoss,
+			"stop_win":    autoBet.StopWin,
+		}
+	}
+	return state, nil
+}
+
+// SettleRound settles a round of a table (claim → persist → pay), dice holds every drawn color (one in single draw mode).
+// A round already settled, or being settled by another GS, is skipped.
+func (uc *GSUseCase) SettleRound(ctx context.Context, tableID string, roundID string, dice []domain.Color) error {
+	if len(dice) == 0 {
+		return fmt.Errorf("round %s has no result", roundID)
+	}
+
+	settlement := &domain.RoundSettlement{
+		RoundID: roundID,
+		TableID: tableID,
+		Dice:    domain.EncodeDice(dice),
+	}
+	claimed, err := uc.claimSettlement(ctx, settlement)
+	if err != nil || !claimed {
+		return err
+	}
+	defer uc.releaseSettlement(roundID)
+	return uc.settle(ctx, settlement)
 }
 
-// SettleRound processes settlement for a round
-func (uc *GSUseCase) SettleRound(ctx context.Context, roundID string, winningColor domain.Color) error {
+// settle persists and pays the queued bets of a claimed round batch by batch, checkpointing after each batch
+func (uc *GSUseCase) settle(ctx context.Context, settlement *domain.RoundSettlement) error {
+	tableID := settlement.TableID
+	roundID := settlement.RoundID
+	dice := domain.DecodeDice(settlement.Dice)
+	if len(dice) == 0 {
+		return fmt.Errorf("round %s has no result", roundID)
+	}
+	winningColor := dice[0]
+	resumed := settlement.Attempts > 1
+
 	startTime := time.Now()
-	logger.Info(ctx).Str("round_id", roundID).Str("winning_color", winningColor.String()).Msg("Starting settlement")
+	logger.Info(ctx).Str("table_id", tableID).Str("round_id", roundID).Str("winning_color", winningColor.String()).Int("dice", len(dice)).Bool("resumed", resumed).Msg("Starting settlement")
 
 	// Batch processing configuration
 	const batchSize = 500
 
-	var allBetOrders []*domain.BetOrder
 	totalProcessed := 0
-	batchNumber := 0
+	winCount := 0
 
-	// 1. Process bets in batches
+	// 1. Process bets in batches, each batch is persisted before it leaves the settlement queue
 	for {
 		bets, err := uc.betRepo.GetBetsForSettlement(ctx, roundID)
 		if err != nil {
+			uc.failSettlement(ctx, settlement, err)
 			return fmt.Errorf("failed to get bets for settlement: %w", err)
 		}
 		if len(bets) == 0 {
 			break // No more bets to process
 		}
 
-		// Collect bets into batches
-		var currentBatch []*domain.BetOrder
-		var currentBets []*domain.Bet
-		now := time.Now()
-
-		for _, bet := range bets {
-			winAmount := calculateWin(bet, winningColor)
-
-			// Create bet order record
-			betOrder := &domain.BetOrder{
-				OrderID:   bet.BetID,
-				UserID:    bet.UserID,
-				RoundID:   roundID,
-				GameCode:  "color_game",
-				BetArea:   bet.Color.String(),
-				Amount:    float64(bet.Amount),
-				Payout:    float64(winAmount),
-				Status:    domain.BetOrderStatusSettled,
-				CreatedAt: bet.Time,
-				SettledAt: &now,
-			}
-
-			currentBatch = append(currentBatch, betOrder)
-			currentBets = append(currentBets, bet)
-			allBetOrders = append(allBetOrders, betOrder)
-
-			// When batch is full, process it
-			if len(currentBatch) >= batchSize {
-				batchNumber++
-				if err := uc.processBatch(ctx, roundID, winningColor, currentBatch, currentBets, batchNumber); err != nil {
-					return err
+		now := uc.clock.Now()
+		for start := 0; start < len(bets); start += batchSize {
+			batch := bets[start:min(start+batchSize, len(bets))]
+
+			// Create bet order records
+			betOrders := make([]*domain.BetOrder, 0, len(batch))
+			payout := int64(0)
+			for _, bet := range batch {
+				winAmount := uc.paytable.WinAmount(bet.Color, bet.Amount, dice)
+				betOrders = append(betOrders, &domain.BetOrder{
+					OrderID:   bet.BetID,
+					UserID:    bet.UserID,
+					RoundID:   roundID,
+					GameCode:  "color_game",
+					BetArea:   bet.Color.String(),
+					Amount:    float64(bet.Amount),
+					Payout:    float64(winAmount),
+					Status:    domain.BetOrderStatusSettled,
+					TxIDs:     domain.JoinTxIDs(bet.TxIDs),
+					CreatedAt: bet.Time,
+					SettledAt: &now,
+				})
+				payout += winAmount
+				if winAmount > 0 {
+					winCount++
 				}
-				totalProcessed += len(currentBatch)
-				currentBatch = nil
-				currentBets = nil
 			}
-		}
 
-		// Process remaining items in the last batch
-		if len(currentBatch) > 0 {
-			batchNumber++
-			if err := uc.processBatch(ctx, roundID, winningColor, currentBatch, currentBets, batchNumber); err != nil {
+			if err := uc.processBatch(ctx, tableID, roundID, dice, betOrders, batch, settlement.Batches+1, resumed); err != nil {
+				uc.failSettlement(ctx, settlement, err)
 				return err
 			}
-			totalProcessed += len(currentBatch)
+			if err := uc.checkpointSettlement(ctx, settlement, batch, payout); err != nil {
+				return err
+			}
+			totalProcessed += len(batch)
 		}
 	}
 
 	// Explicitly clear bets for Memory repo safety (idempotent for Redis)
 	_ = uc.betRepo.ClearBets(ctx, roundID)
-
-	// Log settlement summary
-	totalBets := len(allBetOrders)
-	totalPayout := int64(0)
-	winCount := 0
-	for _, order := range allBetOrders {
-		totalPayout += int64(order.Payout)
-		if order.Payout > 0 {
-			winCount++
-		}
+	_ = uc.betLimitRepo.Delete(ctx, roundID)
+	if err := uc.finishSettlement(ctx, settlement); err != nil {
+		return err
 	}
 
+	// Log settlement summary
 	logger.Info(ctx).
 		Str("round_id", roundID).
 		Str("winning_color", winningColor.String()).
-		Int("total_bets", totalBets).
+		Int("total_bets", settlement.BetCount).
 		Int("total_processed", totalProcessed).
-		Int("batches", batchNumber).
+		Int("batches", settlement.Batches).
 		Int("win_count", winCount).
-		Int("lose_count", totalBets-winCount).
-		Int64("total_payout", totalPayout).
+		Int("lose_count", totalProcessed-winCount).
+		Int64("total_payout", settlement.TotalPayout).
 		Dur("duration_ms", time.Since(startTime)).
 		Msg("Settlement completed successfully")
 
-	// Broadcast settlement result to all online players
+	// Broadcast settlement result to all players at the table
 	// Note: Players who bet will receive TWO notifications:
 	//   1. Personal notification with their bet details (sent in processBatch)
 	//   2. This broadcast notification (for consistency with non-bettors)
 	// Frontend should handle deduplication by checking if bet_id is present
 	if uc.gatewayBroadcaster != nil {
-		uc.gatewayBroadcaster.Broadcast(ctx, "color_game", &pbColorGame.ColorGameSettlementBRC{
+		uc.gatewayBroadcaster.BroadcastToTable(ctx, "color_game", tableID, &pbColorGame.ColorGameSettlementBRC{
+			TableId:      tableID,
 			RoundId:      roundID,
 			WinningColor: winningColor,
+			Dice:         dice,
 			BetId:        "",
 			BetColor:     pbColorGame.ColorGameReward_REWARD_UNSPECIFIED,
 			BetAmount:    0,
@@ -303,18 +595,283 @@ func (uc *GSUseCase) SettleRound(ctx context.Context, roundID string, winningCol
 		logger.Debug(ctx).
 			Str("round_id", roundID).
 			Str("winning_color", winningColor.String()).
-			Msg("Broadcasted settlement result to all players")
+			Msg("Broadcasted settlement result to table players")
 	}
 
 	return nil
 }
 
-// processBatch processes a batch of bet orders: write to DB, then handle wallet and notifications
-func (uc *GSUseCase) processBatch(ctx context.Context, roundID string, winningColor domain.Color, betOrders []*domain.BetOrder, bets []*domain.Bet, batchNum int) error {
-	// 1. Write batch to database
-	if uc.betOrderRepo != nil && len(betOrders) > 0 {
+// VoidRound refunds every bet of a round of a table that GMS abandoned without a result.
+// Each stake is returned by rolling back its original wallet transaction, refunded bets are persisted
+// as Refunded bet orders and each player is notified with ColorGameRefundBRC.
+// The refund is claimed and checkpointed like a settlement.
+func (uc *GSUseCase) VoidRound(ctx context.Context, tableID string, roundID string, reason string) error {
+	settlement := &domain.RoundSettlement{
+		RoundID:    roundID,
+		TableID:    tableID,
+		VoidReason: voidReason(reason),
+	}
+	claimed, err := uc.claimSettlement(ctx, settlement)
+	if err != nil || !claimed {
+		return err
+	}
+	defer uc.releaseSettlement(roundID)
+	return uc.refund(ctx, settlement)
+}
+
+// CloseBetting makes the bets of a round of a table final when GMS ends its betting phase.
+// A cancel racing it is rejected by the bet repository, so no stake is refunded once the round is drawing.
+// Settlement closes betting again before reading the bets in case this notification was lost.
+func (uc *GSUseCase) CloseBetting(ctx context.Context, tableID string, roundID string) error {
+	if err := uc.betRepo.CloseBetting(ctx, roundID); err != nil {
+		return fmt.Errorf("failed to close betting of round %s: %w", roundID, err)
+	}
+	logger.Info(ctx).Str("table_id", tableID).Str("round_id", roundID).Msg("下注已截止")
+	return nil
+}
+
+// refund returns the stakes of the queued bets of a claimed voided round batch by batch, checkpointing after each batch
+func (uc *GSUseCase) refund(ctx context.Context, settlement *domain.RoundSettlement) error {
+	tableID := settlement.TableID
+	roundID := settlement.RoundID
+	reason := settlement.VoidReason
+
+	startTime := time.Now()
+	logger.Warn(ctx).Str("table_id", tableID).Str("round_id", roundID).Str("reason", reason).Msg("Starting void refund")
+
+	// Batch processing configuration (same as settlement)
+	const batchSize = 500
+
+	failedCount := 0
+
+	for {
+		bets, err := uc.betRepo.GetBetsForSettlement(ctx, roundID)
+		if err != nil {
+			uc.failSettlement(ctx, settlement, err)
+			return fmt.Errorf("failed to get bets for refund: %w", err)
+		}
+		if len(bets) == 0 {
+			break
+		}
+
+		for start := 0; start < len(bets); start += batchSize {
+			batch := bets[start:min(start+batchSize, len(bets))]
+
+			_, failed, amount, err := uc.processRefundBatch(ctx, tableID, roundID, reason, batch, settlement.Batches+1)
+			if err != nil {
+				uc.failSettlement(ctx, settlement, err)
+				return err
+			}
+			if err := uc.checkpointSettlement(ctx, settlement, batch, amount); err != nil {
+				return err
+			}
+			failedCount += failed
+		}
+	}
+
+	_ = uc.betRepo.ClearBets(ctx, roundID)
+	_ = uc.betLimitRepo.Delete(ctx, roundID)
+	if err := uc.finishSettlement(ctx, settlement); err != nil {
+		return err
+	}
+
+	logger.Info(ctx).
+		Str("table_id", tableID).
+		Str("round_id", roundID).
+		Int("refund_count", settlement.BetCount-failedCount).
+		Int("failed_count", failedCount).
+		Int("batches", settlement.Batches).
+		Int64("total_refund", settlement.TotalPayout).
+		Dur("duration_ms", time.Since(startTime)).
+		Msg("Void refund completed")
+
+	return nil
+}
+
+// processRefundBatch rolls back the stakes of a batch of bets, persists their bet orders and notifies the players.
+// A refund that failed is queued for retry before the batch is persisted: a deposit goes to the payout outbox in the
+// same transaction as the bet orders, rollbacks become COMPENSATING bet intents. Its player is not notified.
+func (uc *GSUseCase) processRefundBatch(ctx context.Context, tableID string, roundID string, reason string, bets []*domain.Bet, batchNum int) (refunded int, failed int, amount int64, err error) {
+	// 1. Return every stake through the wallet
+	betOrders := make([]*domain.BetOrder, 0, len(bets))
+	refundedBets := make([]*domain.Bet, 0, len(bets))
+	retries := make([]*domain.Payout, 0)
+	now := uc.clock.Now()
+
+	for _, bet := range bets {
+		betOrder := &domain.BetOrder{
+			OrderID:   bet.BetID,
+			UserID:    bet.UserID,
+			RoundID:   roundID,
+			GameCode:  "color_game",
+			BetArea:   bet.Color.String(),
+			Amount:    float64(bet.Amount),
+			Status:    domain.BetOrderStatusRefunded,
+			TxIDs:     domain.JoinTxIDs(bet.TxIDs),
+			CreatedAt: bet.Time,
+			SettledAt: &now,
+		}
+		betOrders = append(betOrders, betOrder)
+		amount += bet.Amount
+
+		if err := uc.rollbackStake(ctx, bet, reason); err != nil {
+			logger.Error(ctx).
+				Err(err).
+				Int64("user_id", bet.UserID).
+				Int64("amount", bet.Amount).
+				Str("bet_id", bet.BetID).
+				Strs("tx_ids", bet.TxIDs).
+				Msg("Failed to refund bet - refund queued for retry, player will NOT be notified")
+			failed++
+			if bet.SharesStake() {
+				retries = append(retries, uc.newRefundPayout(tableID, bet, err, now))
+				continue
+			}
+			if err := uc.queueRollbacks(ctx, bet, err, now); err != nil {
+				return refunded, failed, amount, fmt.Errorf("failed to queue refund of bet %s: %w", bet.BetID, err)
+			}
+			continue
+		}
+
+		refundedBets = append(refundedBets, bet)
+		refunded++
+	}
+
+	// 2. Write batch to database, the refunds to retry are recorded in the payout outbox in the same transaction
+	if len(betOrders) > 0 {
+		if err := uc.payoutRepo.CreateWithOrders(context.Background(), betOrders, retries); err != nil {
+			logger.Error(ctx).
+				Err(err).
+				Int("batch_num", batchNum).
+				Int("count", len(betOrders)).
+				Str("round_id", roundID).
+				Msg("Failed to persist refunded bet orders batch to database")
+			return refunded, failed, amount, fmt.Errorf("failed to persist refunded bet orders batch %d: %w", batchNum, err)
+		}
+	}
+
+	// 3. Notify each refunded player
+	if uc.gatewayBroadcaster != nil {
+		for _, bet := range refundedBets {
+			uc.gatewayBroadcaster.SendToUser(ctx, bet.UserID, "color_game", &pbColorGame.ColorGameRefundBRC{
+				TableId:      tableID,
+				RoundId:      roundID,
+				BetId:        bet.BetID,
+				BetColor:     bet.Color,
+				RefundAmount: bet.Amount,
+				Reason:       reason,
+			})
+		}
+	}
+
+	return refunded, failed, amount, nil
+}
+
+// newRefundPayout queues the deposit refunding a voided bet that shares its deductions, the payout worker retries it
+// under the same transaction as rollbackStake so the stake is never returned twice
+func (uc *GSUseCase) newRefundPayout(tableID string, bet *domain.Bet, cause error, now time.Time) *domain.Payout {
+	return &domain.Payout{
+		TxID:        domain.VoidTxID(bet.BetID),
+		BetID:       bet.BetID,
+		TableID:     tableID,
+		RoundID:     bet.RoundID,
+		UserID:      bet.UserID,
+		Color:       bet.Color,
+		BetAmount:   bet.Amount,
+		Amount:      bet.Amount,
+		Status:      domain.PayoutStatusPending,
+		Attempts:    1,
+		LastError:   cause.Error(),
+		NextRetryAt: now.Add(retryBackoff(1)),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// queueRollbacks hands the wallet transactions of a voided bet to the compensation worker as COMPENSATING intents,
+// rollbacks are idempotent so transactions already rolled back are harmless
+func (uc *GSUseCase) queueRollbacks(ctx context.Context, bet *domain.Bet, cause error, now time.Time) error {
+	for _, txID := range bet.TxIDs {
+		intent, err := uc.betIntentRepo.Get(ctx, txID)
+		if err != nil {
+			return err
+		}
+		if intent != nil {
+			// The intent of the deduction was not deleted after placement, or a previous run queued it already
+			intent.Status = domain.BetIntentStatusCompensating
+			intent.LastError = cause.Error()
+			intent.NextRetryAt = now.Add(retryBackoff(1))
+			intent.UpdatedAt = now
+			if err := uc.betIntentRepo.Update(ctx, intent); err != nil {
+				return err
+			}
+			continue
+		}
+
+		intent = &domain.BetIntent{
+			TxID:        txID,
+			RoundID:     bet.RoundID,
+			UserID:      bet.UserID,
+			Color:       bet.Color,
+			Status:      domain.BetIntentStatusCompensating,
+			Attempts:    1,
+			LastError:   cause.Error(),
+			NextRetryAt: now.Add(retryBackoff(1)),
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		if len(bet.TxIDs) == 1 {
+			intent.Amount = bet.Amount // A raised bet does not record the amount of each deduction
+		}
+		if err := uc.betIntentRepo.Create(ctx, intent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rollbackStake rolls back every wallet transaction that deducted the stake of a bet.
+// Rollbacks are idempotent, so a partially refunded bet can simply be retried.
+// A bet the player partly cancelled, or placed by a bet slip, does not own its deductions whole: its stake is deposited instead.
+func (uc *GSUseCase) rollbackStake(ctx context.Context, bet *domain.Bet, reason string) error {
+	if bet.SharesStake() {
+		if _, err := uc.walletSvc.SettleWin(ctx, bet.UserID, bet.Amount, bet.RoundID, domain.VoidTxID(bet.BetID)); err != nil {
+			return fmt.Errorf("refund %s: %w", domain.VoidTxID(bet.BetID), err)
+		}
+		return nil
+	}
+	if len(bet.TxIDs) == 0 {
+		return fmt.Errorf("bet %s has no wallet transaction to roll back", bet.BetID)
+	}
+	for _, txID := range bet.TxIDs {
+		if _, err := uc.walletSvc.Rollback(ctx, txID, "void:"+bet.RoundID+":"+reason); err != nil {
+			return fmt.Errorf("rollback %s: %w", txID, err)
+		}
+	}
+	return nil
+}
+
+// processBatch processes a batch of bet orders: write to DB together with the payouts they owe, then pay and notify.
+// A resumed settlement leaves the payouts to the payout worker, an interrupted run may have paid some of them already.
+func (uc *GSUseCase) processBatch(ctx context.Context, tableID string, roundID string, dice []domain.Color, betOrders []*domain.BetOrder, bets []*domain.Bet, batchNum int, resumed bool) error {
+	// 1. Write batch to database, the winnings are recorded in the payout outbox in the same transaction
+	now := uc.clock.Now()
+	payouts := make(map[string]*domain.Payout)
+	pending := make([]*domain.Payout, 0)
+	for i, bet := range bets {
+		if winAmount := int64(betOrders[i].Payout); winAmount > 0 {
+			payout := uc.newPayout(tableID, roundID, dice, bet, winAmount, now)
+			if resumed {
+				payout.NextRetryAt = now
+			}
+			payouts[bet.BetID] = payout
+			pending = append(pending, payout)
+		}
+	}
+
+	if len(betOrders) > 0 {
 		startTime := time.Now()
-		if err := uc.betOrderRepo.BatchCreate(context.Background(), betOrders); err != nil {
+		if err := uc.payoutRepo.CreateWithOrders(context.Background(), betOrders, pending); err != nil {
 			logger.Error(ctx).
 				Err(err).
 				Int("batch_num", batchNum).
@@ -327,68 +884,26 @@ func (uc *GSUseCase) processBatch(ctx context.Context, roundID string, winningCo
 		logger.Info(ctx).
 			Int("batch_num", batchNum).
 			Int("count", len(betOrders)).
+			Int("payouts", len(pending)).
 			Str("round_id", roundID).
 			Dur("duration_ms", duration).
 			Msg("Bet orders batch persisted to database")
 	}
 
-	// 2. Process wallet and notifications for this batch
-	for i, bet := range bets {
-		betOrder := betOrders[i]
-		winAmount := int64(betOrder.Payout)
+	// 2. Remember what each player staked, Rebet places it again
+	uc.recordLastBetSlips(ctx, roundID, bets)
 
-		// Track if we should notify the player
-		shouldNotify := true
-
-		if winAmount > 0 {
-			// Call Wallet Service to add win amount
-			_, err := uc.walletSvc.AddBalance(ctx, bet.UserID, winAmount, "win:"+roundID)
-			if err != nil {
-				logger.Error(ctx).
-					Err(err).
-					Int64("user_id", bet.UserID).
-					Int64("win_amount", winAmount).
-					Str("bet_id", bet.BetID).
-					Msg("Failed to deposit winnings - player will NOT be notified")
-				// Don't notify player if wallet operation failed
-				shouldNotify = false
-				// TODO: Retry mechanism or compensation queue
-			} else {
-				logger.Debug(ctx).
-					Int64("user_id", bet.UserID).
-					Int64("win_amount", winAmount).
-					Msg("Winnings deposited successfully")
+	// 3. Pay the winners and notify the players
+	for _, bet := range bets {
+		if payout, ok := payouts[bet.BetID]; ok {
+			// Winners are notified once the deposit landed, a failed one is retried by the payout worker
+			if !resumed {
+				uc.deliverPayout(ctx, payout)
 			}
+			continue
 		}
-
-		// Only notify player if wallet operation succeeded (or if they lost)
-		if shouldNotify && uc.gatewayBroadcaster != nil {
-			uc.gatewayBroadcaster.SendToUser(ctx, bet.UserID, "color_game", &pbColorGame.ColorGameSettlementBRC{
-				RoundId:      roundID,
-				WinningColor: winningColor,
-				BetId:        bet.BetID,
-				BetColor:     bet.Color,
-				BetAmount:    bet.Amount,
-				WinAmount:    winAmount,
-				IsWinner:     winAmount > 0,
-			})
-		}
+		uc.notifySettlement(ctx, tableID, roundID, dice, bet.UserID, bet.BetID, bet.Color, bet.Amount, 0)
 	}
 
 	return nil
 }
-
-func isValidColor(color domain.Color) bool {
-	return color == pbColorGame.ColorGameReward_REWARD_RED ||
-		color == pbColorGame.ColorGameReward_REWARD_GREEN ||
-		color == pbColorGame.ColorGameReward_REWARD_BLUE ||
-		color == pbColorGame.ColorGameReward_REWARD_YELLOW
-}
-
-func calculateWin(bet *domain.Bet, winningColor domain.Color) int64 {
-	if bet.Color == winningColor {
-		// Simple 2x multiplier for now
-		return bet.Amount * 2
-	}
-	return 0
-}
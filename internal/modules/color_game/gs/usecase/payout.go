@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	"github.com/frankieli/game_product/pkg/logger"
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+)
+
+const (
+	// payoutTimeout keeps the worker away from payouts the settling GS is still delivering
+	payoutTimeout = time.Minute
+
+	payoutBatchSize = 100
+)
+
+// SetPayoutRepository replaces the in-memory payout outbox (production uses the payouts table)
+func (uc *GSUseCase) SetPayoutRepository(repo domain.PayoutRepository) {
+	uc.payoutRepo = repo
+}
+
+// newPayout creates the pending payout of a winning bet
+func (uc *GSUseCase) newPayout(tableID string, roundID string, dice []domain.Color, bet *domain.Bet, winAmount int64, now time.Time) *domain.Payout {
+	return &domain.Payout{
+		TxID:        domain.PayoutTxID(bet.BetID),
+		BetID:       bet.BetID,
+		TableID:     tableID,
+		RoundID:     roundID,
+		UserID:      bet.UserID,
+		Color:       bet.Color,
+		BetAmount:   bet.Amount,
+		Amount:      winAmount,
+		Dice:        domain.EncodeDice(dice),
+		Status:      domain.PayoutStatusPending,
+		NextRetryAt: now.Add(payoutTimeout),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// deliverPayout deposits the winnings of a payout and notifies the player once they landed.
+// A failed deposit stays PENDING and is retried by the payout worker with backoff, the wallet transaction
+// is keyed by the payout TxID so a retry never pays twice.
+func (uc *GSUseCase) deliverPayout(ctx context.Context, payout *domain.Payout) bool {
+	payout.Attempts++
+	now := uc.clock.Now()
+	payout.UpdatedAt = now
+
+	_, err := uc.walletSvc.SettleWin(ctx, payout.UserID, payout.Amount, payout.RoundID, payout.TxID)
+	if err != nil {
+		payout.LastError = err.Error()
+		payout.NextRetryAt = now.Add(retryBackoff(payout.Attempts))
+		logger.Error(ctx).
+			Err(err).
+			Str("tx_id", payout.TxID).
+			Str("bet_id", payout.BetID).
+			Int64("user_id", payout.UserID).
+			Int64("win_amount", payout.Amount).
+			Int("attempts", payout.Attempts).
+			Time("next_retry_at", payout.NextRetryAt).
+			Msg("派彩失败，稍后重试")
+	} else {
+		payout.Status = domain.PayoutStatusPaid
+		payout.LastError = ""
+		payout.PaidAt = &now
+		logger.Debug(ctx).
+			Str("tx_id", payout.TxID).
+			Int64("user_id", payout.UserID).
+			Int64("win_amount", payout.Amount).
+			Int("attempts", payout.Attempts).
+			Msg("Winnings deposited successfully")
+	}
+
+	if err := uc.payoutRepo.Update(ctx, payout); err != nil {
+		logger.Error(ctx).Err(err).Str("tx_id", payout.TxID).Msg("保存派彩状态失败")
+	}
+	if payout.Status != domain.PayoutStatusPaid {
+		return false
+	}
+
+	dice := domain.DecodeDice(payout.Dice)
+	uc.notifySettlement(ctx, payout.TableID, payout.RoundID, dice, payout.UserID, payout.BetID, payout.Color, payout.BetAmount, payout.Amount)
+	return true
+}
+
+// notifySettlement sends a player the settlement of one of their bets
+func (uc *GSUseCase) notifySettlement(ctx context.Context, tableID string, roundID string, dice []domain.Color, userID int64, betID string, color domain.Color, betAmount int64, winAmount int64) {
+	if uc.gatewayBroadcaster == nil || len(dice) == 0 {
+		return
+	}
+	uc.gatewayBroadcaster.SendToUser(ctx, userID, "color_game", &pbColorGame.ColorGameSettlementBRC{
+		TableId:      tableID,
+		RoundId:      roundID,
+		WinningColor: dice[0],
+		Dice:         dice,
+		BetId:        betID,
+		BetColor:     color,
+		BetAmount:    betAmount,
+		WinAmount:    winAmount,
+		IsWinner:     winAmount > 0,
+		Matches:      int32(colorgame.Matches(color, dice)),
+	})
+}
+
+// RunPayouts retries the due pending payouts once, returns how many were paid
+func (uc *GSUseCase) RunPayouts(ctx context.Context) (int, error) {
+	payouts, err := uc.payoutRepo.ListDue(ctx, uc.clock.Now(), payoutBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list due payouts: %w", err)
+	}
+
+	paid := 0
+	for _, payout := range payouts {
+		if uc.deliverPayout(ctx, payout) {
+			paid++
+		}
+	}
+	return paid, nil
+}
+
+// StartPayoutWorker runs RunPayouts every interval until ctx is done
+func (uc *GSUseCase) StartPayoutWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := uc.RunPayouts(ctx); err != nil {
+				logger.Error(ctx).Err(err).Msg("Payout worker failed")
+			}
+		}
+	}
+}
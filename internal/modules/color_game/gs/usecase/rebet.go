@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	"github.com/frankieli/game_product/pkg/logger"
+	"github.com/frankieli/game_product/pkg/service"
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+)
+
+// maxAutoBetRounds caps the rounds a player opts in to at once
+const maxAutoBetRounds = 100
+
+// SetLastBetSlipRepository replaces the in-memory last bet slips (GS instances share a Redis store)
+func (uc *GSUseCase) SetLastBetSlipRepository(repo domain.LastBetSlipRepository) {
+	uc.lastSlipRepo = repo
+}
+
+// SetAutoBetRepository replaces the in-memory auto bets (GS instances share a Redis store)
+func (uc *GSUseCase) SetAutoBetRepository(repo domain.AutoBetRepository) {
+	uc.autoBetRepo = repo
+}
+
+// recordLastBetSlips remembers the settled bets of each player of a batch as their last bet slip.
+// It does not fail the settlement, a player whose slip was not recorded repeats an older one.
+func (uc *GSUseCase) recordLastBetSlips(ctx context.Context, roundID string, bets []*domain.Bet) {
+	slips := make(map[int64][]domain.BetLine)
+	for _, bet := range bets {
+		slips[bet.UserID] = append(slips[bet.UserID], domain.BetLine{Color: bet.Color, Amount: bet.Amount})
+	}
+	for userID, lines := range slips {
+		if err := uc.lastSlipRepo.Record(ctx, userID, roundID, lines); err != nil {
+			logger.Warn(ctx).Err(err).Int64("user_id", userID).Str("round_id", roundID).Msg("记录上局注单失败")
+		}
+	}
+}
+
+// Rebet places the last settled bet slip of a player again in the current round of a table, every stake multiplied
+// by multiplier (1 repeats, 2 doubles). The slip goes through PlaceBetSlip: the balance and limits are checked as for
+// any bet slip and requestKey deduplicates retries.
+func (uc *GSUseCase) Rebet(ctx context.Context, userID int64, tableID string, multiplier int64, requestKey string) ([]*domain.Bet, error) {
+	if multiplier < 1 {
+		return nil, fmt.Errorf("%w: multiplier %d", domain.ErrInvalidBetAmount, multiplier)
+	}
+
+	slip, err := uc.lastSlipRepo.Get(ctx, userID)
+	if err != nil {
+		logger.Error(ctx).Err(err).Int64("user_id", userID).Msg("查询上局注单失败")
+		return nil, fmt.Errorf("failed to get last bet slip: %w", err)
+	}
+	if slip == nil || len(slip.Lines) == 0 {
+		logger.Warn(ctx).Int64("user_id", userID).Msg("没有可重复的上局注单")
+		return nil, domain.ErrNoBetsToRepeat
+	}
+
+	logger.Info(ctx).
+		Int64("user_id", userID).
+		Str("last_round_id", slip.RoundID).
+		Int64("multiplier", multiplier).
+		Msg("重复上局下注")
+
+	return uc.PlaceBetSlip(ctx, userID, tableID, slip.Scale(multiplier), requestKey)
+}
+
+// StartAutoBet opts a player in to placing their last settled bet slip (multiplied like Rebet) in the next rounds
+// of a table. It stops after rounds rounds, once the balance dropped by stopLoss or grew by stopWin (0 = no threshold),
+// or when a slip is rejected. A new auto bet replaces the one the player had.
+func (uc *GSUseCase) StartAutoBet(ctx context.Context, userID int64, tableID string, multiplier int64, rounds int, stopLoss int64, stopWin int64) (*domain.AutoBet, error) {
+	if multiplier < 1 || rounds < 1 || rounds > maxAutoBetRounds || stopLoss < 0 || stopWin < 0 {
+		return nil, fmt.Errorf("%w: %d rounds (1-%d), multiplier %d, stop loss %d, stop win %d",
+			domain.ErrInvalidAutoBet, rounds, maxAutoBetRounds, multiplier, stopLoss, stopWin)
+	}
+
+	slip, err := uc.lastSlipRepo.Get(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last bet slip: %w", err)
+	}
+	if slip == nil || len(slip.Lines) == 0 {
+		return nil, domain.ErrNoBetsToRepeat
+	}
+
+	// The table must exist, its rounds are the ones the slip is placed in
+	roundRsp, err := uc.getCurrentRound(ctx, userID, tableID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current round: %w", err)
+	}
+	balance, err := uc.walletSvc.GetBalance(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get balance: %w", err)
+	}
+
+	autoBet := &domain.AutoBet{
+		ID:           domain.NewBetID(),
+		UserID:       userID,
+		TableID:      roundRsp.TableId,
+		Lines:        slip.Scale(multiplier),
+		RoundsLeft:   rounds,
+		StopLoss:     stopLoss,
+		StopWin:      stopWin,
+		StartBalance: balance,
+		CreatedAt:    uc.clock.Now(),
+	}
+	if err := uc.autoBetRepo.Save(ctx, autoBet); err != nil {
+		logger.Error(ctx).Err(err).Int64("user_id", userID).Msg("保存自动下注失败")
+		return nil, fmt.Errorf("failed to save auto bet: %w", err)
+	}
+
+	logger.Info(ctx).
+		Int64("user_id", userID).
+		Str("table_id", autoBet.TableID).
+		Int("rounds", rounds).
+		Int64("stop_loss", stopLoss).
+		Int64("stop_win", stopWin).
+		Msg("自动下注开始")
+
+	return autoBet, nil
+}
+
+// StopAutoBet stops the auto bet of a player, a player without one is not an error
+func (uc *GSUseCase) StopAutoBet(ctx context.Context, userID int64) error {
+	if err := uc.autoBetRepo.Delete(ctx, userID); err != nil {
+		return fmt.Errorf("failed to stop auto bet: %w", err)
+	}
+	logger.Info(ctx).Int64("user_id", userID).Msg("自动下注停止")
+	return nil
+}
+
+// GetAutoBet returns the auto bet of a player, nil when there is none
+func (uc *GSUseCase) GetAutoBet(ctx context.Context, userID int64) (*domain.AutoBet, error) {
+	return uc.autoBetRepo.Get(ctx, userID)
+}
+
+// RunAutoBets places the slip of every auto bet whose table is betting in a round it has not bet yet.
+// Every GS instance may run it, the slip of a round is placed once (its request key derives from the round).
+// It returns how many slips were placed.
+func (uc *GSUseCase) RunAutoBets(ctx context.Context) (int, error) {
+	autoBets, err := uc.autoBetRepo.List(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list auto bets: %w", err)
+	}
+
+	placed := 0
+	for _, autoBet := range autoBets {
+		if uc.runAutoBet(ctx, autoBet) {
+			placed++
+		}
+	}
+	return placed, nil
+}
+
+// runAutoBet places the slip of one auto bet in the current round of its table, or stops the auto bet
+func (uc *GSUseCase) runAutoBet(ctx context.Context, autoBet *domain.AutoBet) bool {
+	ctx = logger.WithFields(ctx, map[string]interface{}{
+		"user_id":  autoBet.UserID,
+		"table_id": autoBet.TableID,
+	})
+
+	balance, err := uc.walletSvc.GetBalance(ctx, autoBet.UserID)
+	if err != nil {
+		logger.Warn(ctx).Err(err).Msg("自动下注查询余额失败")
+		return false
+	}
+	if reason := autoBet.StopReason(balance); reason != "" {
+		uc.finishAutoBet(ctx, autoBet, reason)
+		return false
+	}
+
+	roundRsp, err := uc.getCurrentRound(ctx, autoBet.UserID, autoBet.TableID)
+	if err != nil {
+		if errors.Is(err, domain.ErrTableNotFound) {
+			uc.finishAutoBet(ctx, autoBet, "table closed")
+		}
+		return false
+	}
+	if roundRsp.State != pbColorGame.ColorGameState_GAME_STATE_BETTING || roundRsp.RoundId == autoBet.LastRoundID {
+		return false
+	}
+
+	// A GS that retries the round after a failure, or another GS running the same auto bet, replays the slip
+	if _, err := uc.PlaceBetSlip(ctx, autoBet.UserID, autoBet.TableID, autoBet.Lines, autoBetRequestKey(roundRsp.RoundId)); err != nil {
+		switch {
+		case errors.Is(err, service.ErrWalletRejected),
+			errors.Is(err, domain.ErrInvalidBetAmount),
+			errors.Is(err, domain.ErrInvalidBetOption),
+			errors.Is(err, domain.ErrBetLimitExceeded),
+			errors.Is(err, domain.ErrExposureLimitExceeded):
+			uc.finishAutoBet(ctx, autoBet, err.Error())
+		default:
+			// Betting closed meanwhile or a transient failure, the next round is tried
+			logger.Warn(ctx).Err(err).Str("round_id", roundRsp.RoundId).Msg("自动下注失败")
+		}
+		return false
+	}
+
+	advanced, err := uc.autoBetRepo.Advance(ctx, autoBet.UserID, autoBet.ID, roundRsp.RoundId)
+	if err != nil {
+		logger.Error(ctx).Err(err).Str("round_id", roundRsp.RoundId).Msg("更新自动下注失败")
+		return false
+	}
+	if !advanced {
+		return false
+	}
+
+	logger.Info(ctx).
+		Str("round_id", roundRsp.RoundId).
+		Int("rounds_left", autoBet.RoundsLeft-1).
+		Msg("自动下注成功")
+
+	if autoBet.RoundsLeft-1 <= 0 {
+		autoBet.RoundsLeft = 0
+		uc.finishAutoBet(ctx, autoBet, autoBet.StopReason(balance))
+	}
+	return true
+}
+
+// finishAutoBet removes an auto bet that reached its end, unless the player replaced it meanwhile
+func (uc *GSUseCase) finishAutoBet(ctx context.Context, autoBet *domain.AutoBet, reason string) {
+	if err := uc.autoBetRepo.Finish(ctx, autoBet.UserID, autoBet.ID); err != nil {
+		logger.Error(ctx).Err(err).Msg("结束自动下注失败")
+		return
+	}
+	logger.Info(ctx).
+		Str("reason", reason).
+		Int("rounds_left", autoBet.RoundsLeft).
+		Msg("自动下注结束")
+}
+
+// autoBetRequestKey is the idempotency key of the slip an auto bet places in a round
+func autoBetRequestKey(roundID string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(roundID))
+	return "auto-" + strconv.FormatUint(h.Sum64(), 16)
+}
+
+// StartAutoBetWorker runs RunAutoBets every interval until ctx is done, the interval must be well below
+// the betting duration for every round to be bet
+func (uc *GSUseCase) StartAutoBetWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := uc.RunAutoBets(ctx); err != nil {
+				logger.Error(ctx).Err(err).Msg("Auto bet worker failed")
+			}
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/frankieli/game_product/pkg/logger"
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
+)
+
+const reconciliationBatchSize = 500
+
+// ReconcileBetOrders flags the pending bet orders whose round is at least maxRounds rounds behind
+// the current round of its table: the stake was deducted but the bet was never settled or refunded.
+// Returns how many orders were flagged, flagged orders are logged for operators and not reported again.
+func (uc *GSUseCase) ReconcileBetOrders(ctx context.Context, maxRounds int) (int, error) {
+	if uc.betOrderRepo == nil {
+		return 0, nil
+	}
+
+	orders, err := uc.betOrderRepo.ListPending(ctx, reconciliationBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list pending bet orders: %w", err)
+	}
+
+	currentSeq := make(map[string]int64) // table -> sequence of its current round
+	stale := make([]string, 0)
+	for _, order := range orders {
+		round, err := colorgame.ParseRoundID(order.RoundID)
+		if err != nil {
+			continue
+		}
+
+		seq, ok := currentSeq[round.TableID]
+		if !ok {
+			seq, err = uc.currentRoundSeq(ctx, round.TableID)
+			if err != nil {
+				logger.Warn(ctx).Err(err).Str("table_id", round.TableID).Msg("Failed to get current round for reconciliation")
+				continue
+			}
+			currentSeq[round.TableID] = seq
+		}
+		if seq-round.Seq < int64(maxRounds) {
+			continue
+		}
+
+		logger.Error(ctx).
+			Str("bet_id", order.OrderID).
+			Str("round_id", order.RoundID).
+			Int64("user_id", order.UserID).
+			Float64("amount", order.Amount).
+			Str("tx_ids", order.TxIDs).
+			Int64("rounds_behind", seq-round.Seq).
+			Msg("注单长时间未结算，需人工对账")
+		stale = append(stale, order.OrderID)
+	}
+
+	if err := uc.betOrderRepo.Flag(ctx, stale, uc.clock.Now()); err != nil {
+		return 0, fmt.Errorf("failed to flag stale bet orders: %w", err)
+	}
+	return len(stale), nil
+}
+
+// currentRoundSeq returns the sequence of the current round of a table
+func (uc *GSUseCase) currentRoundSeq(ctx context.Context, tableID string) (int64, error) {
+	rsp, err := uc.getCurrentRound(ctx, 0, tableID)
+	if err != nil {
+		return 0, err
+	}
+	round, err := colorgame.ParseRoundID(rsp.RoundId)
+	if err != nil {
+		return 0, err
+	}
+	return round.Seq, nil
+}
+
+// StartReconciliationWorker runs ReconcileBetOrders every interval until ctx is done
+func (uc *GSUseCase) StartReconciliationWorker(ctx context.Context, interval time.Duration, maxRounds int) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := uc.ReconcileBetOrders(ctx, maxRounds); err != nil {
+				logger.Error(ctx).Err(err).Msg("Reconciliation worker failed")
+			}
+		}
+	}
+}
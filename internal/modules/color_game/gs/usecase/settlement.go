@@ -0,0 +1,296 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	"github.com/frankieli/game_product/pkg/logger"
+)
+
+const (
+	// settlementLease is how long a GS owns a round without renewing it, a settlement idle longer belongs to a dead GS
+	settlementLease = time.Minute
+
+	// settlementRenewInterval renews the lease while a batch runs, a batch waiting on the wallet may outlast the lease
+	settlementRenewInterval = settlementLease / 3
+
+	settlementListLimit = 100
+)
+
+// instanceSeq tells apart GS use cases of the same process (monolith, tests)
+var instanceSeq atomic.Int64
+
+// newInstanceID names this GS as the owner of the settlements it claims
+func newInstanceID() string {
+	hostname, _ := os.Hostname()
+	return fmt.Sprintf("%s-%d-%d", hostname, os.Getpid(), instanceSeq.Add(1))
+}
+
+// SetSettlementRepository replaces the in-memory settlement checkpoints (production uses the round_settlements table)
+func (uc *GSUseCase) SetSettlementRepository(repo domain.SettlementRepository) {
+	uc.settlementRepo = repo
+}
+
+// claimSettlement takes the lease of a round, false when it is done or another GS (or this one) is settling it.
+// A claimed round stays in flight until releaseSettlement, a RESULT repeated meanwhile (GMS recovery, leader failover) is dropped.
+// Its lease is renewed in the background until then.
+func (uc *GSUseCase) claimSettlement(ctx context.Context, settlement *domain.RoundSettlement) (bool, error) {
+	uc.settlingMu.Lock()
+	if _, settling := uc.settling[settlement.RoundID]; settling {
+		uc.settlingMu.Unlock()
+		logger.Info(ctx).
+			Str("round_id", settlement.RoundID).
+			Msg("回合正在本 GS 结算中，忽略重复的结算请求")
+		return false, nil
+	}
+	uc.settling[settlement.RoundID] = nil
+	uc.settlingMu.Unlock()
+
+	// The bets are final before anything reads them, a cancel racing the settlement is rejected by the bet repository
+	if err := uc.betRepo.CloseBetting(ctx, settlement.RoundID); err != nil {
+		uc.releaseSettlement(settlement.RoundID)
+		return false, fmt.Errorf("failed to close betting of round %s: %w", settlement.RoundID, err)
+	}
+
+	now := uc.clock.Now()
+	claimed, err := uc.settlementRepo.Claim(ctx, settlement, uc.instanceID, now, now.Add(settlementLease))
+	if err != nil {
+		uc.releaseSettlement(settlement.RoundID)
+		return false, fmt.Errorf("failed to claim settlement of round %s: %w", settlement.RoundID, err)
+	}
+	if !claimed {
+		uc.releaseSettlement(settlement.RoundID)
+		logger.Info(ctx).
+			Str("round_id", settlement.RoundID).
+			Msg("回合已结算或由其他 GS 结算中，跳过")
+		return false, nil
+	}
+	if settlement.Attempts > 1 {
+		logger.Warn(ctx).
+			Str("round_id", settlement.RoundID).
+			Int("attempts", settlement.Attempts).
+			Int("batches", settlement.Batches).
+			Msg("接管未完成的结算")
+	}
+
+	stopRenewal := uc.renewSettlementLease(settlement.RoundID)
+	uc.settlingMu.Lock()
+	uc.settling[settlement.RoundID] = stopRenewal
+	uc.settlingMu.Unlock()
+	return true, nil
+}
+
+// releaseSettlement ends the settlement of a claimed round on this GS, whether it completed or stopped
+func (uc *GSUseCase) releaseSettlement(roundID string) {
+	uc.settlingMu.Lock()
+	stopRenewal := uc.settling[roundID]
+	delete(uc.settling, roundID)
+	uc.settlingMu.Unlock()
+
+	if stopRenewal != nil {
+		stopRenewal()
+	}
+}
+
+// renewSettlementLease extends the lease of a claimed round every settlementRenewInterval until the returned func is
+// called, so a batch slower than the lease is not taken over by another GS while this one still settles it.
+// It stops by itself once the lease is lost or the settlement finished.
+func (uc *GSUseCase) renewSettlementLease(roundID string) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(settlementRenewInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				renewed, err := uc.settlementRepo.Renew(context.Background(), roundID, uc.instanceID, uc.clock.Now().Add(settlementLease))
+				if err != nil {
+					logger.WarnGlobal().Err(err).Str("round_id", roundID).Msg("续约结算租约失败")
+					continue
+				}
+				if !renewed {
+					return
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
+
+// checkpointSettlement removes a persisted batch from the settlement queue and records the progress,
+// the lease is extended with every batch (and renewed in the background while the batch runs)
+func (uc *GSUseCase) checkpointSettlement(ctx context.Context, settlement *domain.RoundSettlement, bets []*domain.Bet, amount int64) error {
+	if err := uc.betRepo.AckSettled(ctx, settlement.RoundID, bets); err != nil {
+		return fmt.Errorf("failed to ack settled bets: %w", err)
+	}
+
+	now := uc.clock.Now()
+	settlement.Batches++
+	settlement.BetCount += len(bets)
+	settlement.TotalPayout += amount
+	settlement.LeaseUntil = now.Add(settlementLease)
+	settlement.UpdatedAt = now
+	return uc.saveSettlement(ctx, settlement)
+}
+
+// finishSettlement marks a round settled once every bet is persisted
+func (uc *GSUseCase) finishSettlement(ctx context.Context, settlement *domain.RoundSettlement) error {
+	now := uc.clock.Now()
+	settlement.Status = domain.SettlementStatusSettled
+	settlement.LastError = ""
+	settlement.UpdatedAt = now
+	settlement.FinishedAt = &now
+	return uc.saveSettlement(ctx, settlement)
+}
+
+// failSettlement records why a settlement stopped, it is resumed once its lease expires
+func (uc *GSUseCase) failSettlement(ctx context.Context, settlement *domain.RoundSettlement, cause error) {
+	settlement.LastError = cause.Error()
+	settlement.UpdatedAt = uc.clock.Now()
+	if err := uc.saveSettlement(ctx, settlement); err != nil {
+		logger.Error(ctx).Err(err).Str("round_id", settlement.RoundID).Msg("保存结算状态失败")
+	}
+}
+
+// saveSettlement checkpoints a settlement, ErrSettlementLeaseLost when another GS took it over
+func (uc *GSUseCase) saveSettlement(ctx context.Context, settlement *domain.RoundSettlement) error {
+	saved, err := uc.settlementRepo.Checkpoint(ctx, settlement)
+	if err != nil {
+		return fmt.Errorf("failed to checkpoint settlement of round %s: %w", settlement.RoundID, err)
+	}
+	if !saved {
+		return fmt.Errorf("%w: round %s", domain.ErrSettlementLeaseLost, settlement.RoundID)
+	}
+	return nil
+}
+
+// runSettlement settles or refunds a claimed round
+func (uc *GSUseCase) runSettlement(ctx context.Context, settlement *domain.RoundSettlement) error {
+	if settlement.IsVoid() {
+		return uc.refund(ctx, settlement)
+	}
+	return uc.settle(ctx, settlement)
+}
+
+// ScheduleSettlement claims a round before returning and settles it in the background.
+// The claim is durable, a GS dying before the settlement completes leaves it to ResumeSettlements.
+func (uc *GSUseCase) ScheduleSettlement(ctx context.Context, tableID string, roundID string, dice []domain.Color) error {
+	if len(dice) == 0 {
+		return fmt.Errorf("round %s has no result", roundID)
+	}
+	return uc.schedule(ctx, &domain.RoundSettlement{
+		RoundID: roundID,
+		TableID: tableID,
+		Dice:    domain.EncodeDice(dice),
+	})
+}
+
+// ScheduleVoid claims a voided round before returning and refunds it in the background
+func (uc *GSUseCase) ScheduleVoid(ctx context.Context, tableID string, roundID string, reason string) error {
+	return uc.schedule(ctx, &domain.RoundSettlement{
+		RoundID:    roundID,
+		TableID:    tableID,
+		VoidReason: voidReason(reason),
+	})
+}
+
+func (uc *GSUseCase) schedule(ctx context.Context, settlement *domain.RoundSettlement) error {
+	claimed, err := uc.claimSettlement(ctx, settlement)
+	if err != nil || !claimed {
+		return err
+	}
+
+	go func() {
+		defer uc.releaseSettlement(settlement.RoundID)
+
+		// The request context ends with the RPC, the settlement must not
+		bgCtx := context.Background()
+		if err := uc.runSettlement(bgCtx, settlement); err != nil {
+			logger.ErrorGlobal().Err(err).Str("round_id", settlement.RoundID).Msg("Settlement failed")
+		}
+	}()
+	return nil
+}
+
+// ResumeSettlements takes over the settlements whose lease expired (their GS died) and completes them,
+// returns how many were completed
+func (uc *GSUseCase) ResumeSettlements(ctx context.Context) (int, error) {
+	settlements, err := uc.settlementRepo.ListExpired(ctx, uc.clock.Now(), settlementListLimit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list expired settlements: %w", err)
+	}
+
+	completed := 0
+	for _, settlement := range settlements {
+		claimed, err := uc.claimSettlement(ctx, settlement)
+		if err != nil || !claimed {
+			continue // Another GS took it over first
+		}
+		err = uc.runSettlement(ctx, settlement)
+		uc.releaseSettlement(settlement.RoundID)
+		if err != nil {
+			logger.Error(ctx).Err(err).Str("round_id", settlement.RoundID).Msg("Resumed settlement failed")
+			continue
+		}
+		completed++
+	}
+	return completed, nil
+}
+
+// StartSettlementWorker resumes unfinished settlements on startup and then every interval until ctx is done
+func (uc *GSUseCase) StartSettlementWorker(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := uc.ResumeSettlements(ctx); err != nil {
+			logger.Error(ctx).Err(err).Msg("Settlement worker failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetSettlement returns the settlement progress of a round (operators)
+func (uc *GSUseCase) GetSettlement(ctx context.Context, roundID string) (*domain.RoundSettlement, error) {
+	settlement, err := uc.settlementRepo.Get(ctx, roundID)
+	if err != nil {
+		return nil, err
+	}
+	if settlement == nil {
+		return nil, fmt.Errorf("%w: %s", domain.ErrSettlementNotFound, roundID)
+	}
+	return settlement, nil
+}
+
+// ListSettlements returns the settlements in a status, newest first (operators)
+func (uc *GSUseCase) ListSettlements(ctx context.Context, status domain.SettlementStatus, limit int) ([]*domain.RoundSettlement, error) {
+	if limit <= 0 {
+		limit = settlementListLimit
+	}
+	return uc.settlementRepo.List(ctx, status, limit)
+}
+
+// voidReason keeps a voided round distinguishable from a settled one
+func voidReason(reason string) string {
+	if reason == "" {
+		return "voided"
+	}
+	return reason
+}
@@ -32,10 +32,15 @@ func (h *Handler) convertEvent(gameCode string, anyEvent *anypb.Any) []byte {
 	brcState := &pbColorGame.ColorGameRoundStateBRC{}
 	if err := anyEvent.UnmarshalTo(brcState); err == nil {
 		finalData := map[string]interface{}{
+			"table_id":              brcState.TableId,
 			"round_id":              brcState.RoundId,
 			"state":                 brcState.State.String(),
 			"betting_end_timestamp": brcState.BettingEndTimestamp,
 			"left_time":             brcState.LeftTime,
+			"server_seed_hash":      brcState.ServerSeedHash,
+			"client_seed":           brcState.ClientSeed,
+			"server_seed":           brcState.ServerSeed,
+			"seq":                   brcState.Seq,
 		}
 		jsonMsg, _ := json.Marshal(map[string]interface{}{
 			"game_code": gameCode,
@@ -49,6 +54,7 @@ func (h *Handler) convertEvent(gameCode string, anyEvent *anypb.Any) []byte {
 	brcSettlement := &pbColorGame.ColorGameSettlementBRC{}
 	if err := anyEvent.UnmarshalTo(brcSettlement); err == nil {
 		finalData := map[string]interface{}{
+			"table_id":      brcSettlement.TableId,
 			"round_id":      brcSettlement.RoundId,
 			"winning_color": brcSettlement.WinningColor.String(),
 			"bet_id":        brcSettlement.BetId,
@@ -56,6 +62,8 @@ func (h *Handler) convertEvent(gameCode string, anyEvent *anypb.Any) []byte {
 			"bet_amount":    brcSettlement.BetAmount,
 			"win_amount":    brcSettlement.WinAmount,
 			"is_winner":     brcSettlement.IsWinner,
+			"dice":          colorNames(brcSettlement.Dice),
+			"matches":       brcSettlement.Matches,
 		}
 		jsonMsg, _ := json.Marshal(map[string]interface{}{
 			"game_code": gameCode,
@@ -65,6 +73,51 @@ func (h *Handler) convertEvent(gameCode string, anyEvent *anypb.Any) []byte {
 		return jsonMsg
 	}
 
+	// 3. ColorGameRefundBRC
+	brcRefund := &pbColorGame.ColorGameRefundBRC{}
+	if err := anyEvent.UnmarshalTo(brcRefund); err == nil {
+		finalData := map[string]interface{}{
+			"table_id":      brcRefund.TableId,
+			"round_id":      brcRefund.RoundId,
+			"bet_id":        brcRefund.BetId,
+			"bet_color":     brcRefund.BetColor.String(),
+			"refund_amount": brcRefund.RefundAmount,
+			"reason":        brcRefund.Reason,
+		}
+		jsonMsg, _ := json.Marshal(map[string]interface{}{
+			"game_code": gameCode,
+			"command":   "ColorGameRefundBRC",
+			"data":      finalData,
+		})
+		return jsonMsg
+	}
+
+	// 4. ColorGameBetPoolBRC
+	brcBetPool := &pbColorGame.ColorGameBetPoolBRC{}
+	if err := anyEvent.UnmarshalTo(brcBetPool); err == nil {
+		pools := make([]map[string]interface{}, 0, len(brcBetPool.Pools))
+		for _, pool := range brcBetPool.Pools {
+			pools = append(pools, map[string]interface{}{
+				"color":   pool.Color.String(),
+				"amount":  pool.Amount,
+				"bettors": pool.Bettors,
+			})
+		}
+		finalData := map[string]interface{}{
+			"table_id":      brcBetPool.TableId,
+			"round_id":      brcBetPool.RoundId,
+			"pools":         pools,
+			"total_amount":  brcBetPool.TotalAmount,
+			"total_bettors": brcBetPool.TotalBettors,
+		}
+		jsonMsg, _ := json.Marshal(map[string]interface{}{
+			"game_code": gameCode,
+			"command":   "ColorGameBetPoolBRC",
+			"data":      finalData,
+		})
+		return jsonMsg
+	}
+
 	// Fallback: If unknown type, try generic conversion (less ideal but safe)
 	// Or log warning and return nil
 	logger.WarnGlobal().Str("type_url", anyEvent.TypeUrl).Msg("Unknown event type in convertEvent")
@@ -72,10 +125,11 @@ func (h *Handler) convertEvent(gameCode string, anyEvent *anypb.Any) []byte {
 }
 
 // Broadcast implements the Broadcast RPC
-// Broadcasts a message to all users in a specific game
+// Broadcasts a message to all users in a specific game, or only to the members of req.TableId
 func (h *Handler) Broadcast(ctx context.Context, req *pb.BroadcastReq) (*pb.BroadcastRsp, error) {
 	logger.Debug(ctx).
 		Str("game_code", req.GameCode).
+		Str("table_id", req.TableId).
 		Msg("gRPC Broadcast request")
 
 	if req.Event == nil {
@@ -84,8 +138,12 @@ func (h *Handler) Broadcast(ctx context.Context, req *pb.BroadcastReq) (*pb.Broa
 
 	msgBytes := h.convertEvent(req.GameCode, req.Event)
 	if msgBytes != nil {
-		h.wsManager.Broadcast(msgBytes)
-		logger.Info(ctx).Str("game_code", req.GameCode).Msg("Broadcast successful")
+		if req.TableId != "" {
+			h.wsManager.BroadcastToTable(req.GameCode, req.TableId, msgBytes)
+		} else {
+			h.wsManager.Broadcast(msgBytes)
+		}
+		logger.Info(ctx).Str("game_code", req.GameCode).Str("table_id", req.TableId).Msg("Broadcast successful")
 	} else {
 		logger.Warn(ctx).Str("game_code", req.GameCode).Msg("Failed to convert event for broadcast")
 	}
@@ -119,3 +177,12 @@ func (h *Handler) SendToUser(ctx context.Context, req *pb.SendToUserReq) (*pb.Se
 		Success:   true,
 	}, nil
 }
+
+// colorNames converts drawn dice to their enum names
+func colorNames(dice []pbColorGame.ColorGameReward) []string {
+	names := make([]string, len(dice))
+	for i, color := range dice {
+		names[i] = color.String()
+	}
+	return names
+}
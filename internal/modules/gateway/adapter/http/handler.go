@@ -9,6 +9,7 @@ import (
 	"github.com/frankieli/game_product/internal/modules/gateway/ws"
 	"github.com/frankieli/game_product/pkg/logger"
 	"github.com/frankieli/game_product/pkg/service"
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
 	"github.com/gorilla/websocket"
 )
 
@@ -82,6 +83,17 @@ func (h *Handler) HandleWebSocket(w http.ResponseWriter, r *http.Request) {
 	// 4. Register client
 	client := h.manager.Register(conn, userID)
 
+	// Players start at the default table, clients without table support keep receiving its rounds
+	h.manager.JoinTable(userID, "color_game", colorgame.DefaultTableID)
+
+	// 5. Push the results roadmap of the table so that the client can draw it right away,
+	// queued before any message is read so that it precedes the responses to the client's requests
+	if roadmap, err := h.useCase.ColorGameRoundHistory(ctx, userID); err != nil {
+		logger.Warn(ctx).Err(err).Int64("user_id", userID).Msg("推送开奖路单失败")
+	} else {
+		client.Send <- roadmap
+	}
+
 	// Start pumps
 	go client.WritePump()
 	go client.ReadPump(func(userID int64, message []byte) {
@@ -29,10 +29,15 @@ func (h *Handler) convertEvent(gameCode string, event proto.Message) []byte {
 	switch e := event.(type) {
 	case *pbColorGame.ColorGameRoundStateBRC:
 		finalData := map[string]interface{}{
+			"table_id":              e.TableId,
 			"round_id":              e.RoundId,
 			"state":                 e.State.String(),
 			"betting_end_timestamp": e.BettingEndTimestamp,
 			"left_time":             e.LeftTime,
+			"server_seed_hash":      e.ServerSeedHash,
+			"client_seed":           e.ClientSeed,
+			"server_seed":           e.ServerSeed,
+			"seq":                   e.Seq,
 		}
 
 		jsonMsg, err := json.Marshal(map[string]interface{}{
@@ -46,6 +51,7 @@ func (h *Handler) convertEvent(gameCode string, event proto.Message) []byte {
 
 	case *pbColorGame.ColorGameSettlementBRC:
 		finalData := map[string]interface{}{
+			"table_id":      e.TableId,
 			"round_id":      e.RoundId,
 			"winning_color": e.WinningColor.String(),
 			"bet_id":        e.BetId,
@@ -53,6 +59,8 @@ func (h *Handler) convertEvent(gameCode string, event proto.Message) []byte {
 			"bet_amount":    e.BetAmount,
 			"win_amount":    e.WinAmount,
 			"is_winner":     e.IsWinner,
+			"dice":          colorNames(e.Dice),
+			"matches":       e.Matches,
 		}
 
 		jsonMsg, err := json.Marshal(map[string]interface{}{
@@ -64,6 +72,51 @@ func (h *Handler) convertEvent(gameCode string, event proto.Message) []byte {
 			return jsonMsg
 		}
 
+	case *pbColorGame.ColorGameRefundBRC:
+		finalData := map[string]interface{}{
+			"table_id":      e.TableId,
+			"round_id":      e.RoundId,
+			"bet_id":        e.BetId,
+			"bet_color":     e.BetColor.String(),
+			"refund_amount": e.RefundAmount,
+			"reason":        e.Reason,
+		}
+
+		jsonMsg, err := json.Marshal(map[string]interface{}{
+			"game_code": gameCode,
+			"command":   "ColorGameRefundBRC",
+			"data":      finalData,
+		})
+		if err == nil {
+			return jsonMsg
+		}
+
+	case *pbColorGame.ColorGameBetPoolBRC:
+		pools := make([]map[string]interface{}, 0, len(e.Pools))
+		for _, pool := range e.Pools {
+			pools = append(pools, map[string]interface{}{
+				"color":   pool.Color.String(),
+				"amount":  pool.Amount,
+				"bettors": pool.Bettors,
+			})
+		}
+		finalData := map[string]interface{}{
+			"table_id":      e.TableId,
+			"round_id":      e.RoundId,
+			"pools":         pools,
+			"total_amount":  e.TotalAmount,
+			"total_bettors": e.TotalBettors,
+		}
+
+		jsonMsg, err := json.Marshal(map[string]interface{}{
+			"game_code": gameCode,
+			"command":   "ColorGameBetPoolBRC",
+			"data":      finalData,
+		})
+		if err == nil {
+			return jsonMsg
+		}
+
 	default:
 		// Ignore unknown types
 	}
@@ -77,9 +130,25 @@ func (h *Handler) Broadcast(ctx context.Context, gameCode string, event proto.Me
 	}
 }
 
+func (h *Handler) BroadcastToTable(ctx context.Context, gameCode string, tableID string, event proto.Message) {
+	msgBytes := h.convertEvent(gameCode, event)
+	if msgBytes != nil {
+		h.wsManager.BroadcastToTable(gameCode, tableID, msgBytes)
+	}
+}
+
 func (h *Handler) SendToUser(ctx context.Context, userID int64, gameCode string, event proto.Message) {
 	msgBytes := h.convertEvent(gameCode, event)
 	if msgBytes != nil {
 		h.wsManager.SendToUser(userID, msgBytes)
 	}
 }
+
+// colorNames converts drawn dice to their enum names
+func colorNames(dice []pbColorGame.ColorGameReward) []string {
+	names := make([]string, len(dice))
+	for i, color := range dice {
+		names[i] = color.String()
+	}
+	return names
+}
@@ -8,16 +8,19 @@ import (
 	"strings"
 
 	"github.com/frankieli/game_product/pkg/logger"
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
 	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
 	pbCommon "github.com/frankieli/game_product/shared/proto/common"
 )
 
-func (uc *GatewayUseCase) handleColorGame(ctx context.Context, userID int64, command string, data []byte) ([]byte, error) {
+func (uc *GatewayUseCase) handleColorGame(ctx context.Context, userID int64, req *RequestEnvelope) ([]byte, error) {
+	command, data := req.Command, []byte(req.Data)
 	switch command {
 	case "ColorGamePlaceBetREQ":
 		var payload struct {
-			Color  string `json:"color"`
-			Amount int64  `json:"amount"`
+			TableID string `json:"table_id"` // Optional, defaults to the table the player joined
+			Color   string `json:"color"`
+			Amount  int64  `json:"amount"`
 		}
 		if err := json.Unmarshal(data, &payload); err != nil {
 			logger.Error(ctx).
@@ -40,9 +43,10 @@ func (uc *GatewayUseCase) handleColorGame(ctx context.Context, userID int64, com
 				"game_code": "color_game",
 				"command":   "ColorGamePlaceBetRSP",
 				"data": map[string]interface{}{
-					"error_code": int32(errCode),
-					"bet_id":     "",
-					"error":      errMsg,
+					"error_code":      int32(errCode),
+					"bet_id":          "",
+					"idempotency_key": req.IdempotencyKey,
+					"error":           errMsg,
 				},
 			})
 		}
@@ -52,10 +56,16 @@ func (uc *GatewayUseCase) handleColorGame(ctx context.Context, userID int64, com
 			return buildError(pbCommon.ErrorCode_INVALID_BET_OPTION, fmt.Sprintf("invalid color: %s", payload.Color))
 		}
 
+		if payload.TableID == "" {
+			payload.TableID = uc.currentTable(userID, "color_game")
+		}
+
 		rsp, err := uc.colorGameSvc.PlaceBet(ctx, &pbColorGame.ColorGamePlaceBetReq{
-			UserId: userID,
-			Color:  pbColorGame.ColorGameReward(colorEnumVal),
-			Amount: payload.Amount,
+			UserId:         userID,
+			TableId:        payload.TableID,
+			Color:          pbColorGame.ColorGameReward(colorEnumVal),
+			Amount:         payload.Amount,
+			IdempotencyKey: req.IdempotencyKey,
 		})
 		if err != nil {
 			return buildError(pbCommon.ErrorCode_INTERNAL_ERROR, err.Error())
@@ -68,15 +78,311 @@ func (uc *GatewayUseCase) handleColorGame(ctx context.Context, userID int64, com
 			"game_code": "color_game",
 			"command":   "ColorGamePlaceBetRSP",
 			"data": map[string]interface{}{
-				"error_code": int32(pbCommon.ErrorCode_SUCCESS),
-				"bet_id":     rsp.BetId,
-				"error":      "",
+				"error_code":      int32(pbCommon.ErrorCode_SUCCESS),
+				"bet_id":          rsp.BetId,
+				"idempotency_key": req.IdempotencyKey,
+				"error":           "",
+			},
+		})
+
+	case "ColorGameCancelBetREQ":
+		var payload struct {
+			TableID string `json:"table_id"` // Optional, defaults to the table the player joined
+			Color   string `json:"color"`
+			Amount  int64  `json:"amount"` // Optional, 0 cancels the whole bet
+		}
+		if err := json.Unmarshal(data, &payload); err != nil {
+			logger.Error(ctx).
+				Err(err).
+				Int64("user_id", userID).
+				Str("command", command).
+				Msg("Failed to unmarshal CancelBet payload")
+			return nil, fmt.Errorf("invalid cancel_bet payload: %w", err)
+		}
+
+		buildError := func(errCode pbCommon.ErrorCode, errMsg string) ([]byte, error) {
+			logger.Warn(ctx).
+				Int64("user_id", userID).
+				Str("command", command).
+				Str("error_code", errCode.String()).
+				Str("error", errMsg).
+				Msg("CancelBet failed")
+			return json.Marshal(map[string]interface{}{
+				"game_code": "color_game",
+				"command":   "ColorGameCancelBetRSP",
+				"data": map[string]interface{}{
+					"error_code":       int32(errCode),
+					"bet_id":           "",
+					"refund_amount":    0,
+					"remaining_amount": 0,
+					"idempotency_key":  req.IdempotencyKey,
+					"error":            errMsg,
+				},
+			})
+		}
+
+		colorEnumVal, ok := pbColorGame.ColorGameReward_value["REWARD_"+strings.ToUpper(payload.Color)]
+		if !ok {
+			return buildError(pbCommon.ErrorCode_INVALID_BET_OPTION, fmt.Sprintf("invalid color: %s", payload.Color))
+		}
+
+		if payload.TableID == "" {
+			payload.TableID = uc.currentTable(userID, "color_game")
+		}
+
+		rsp, err := uc.colorGameSvc.CancelBet(ctx, &pbColorGame.ColorGameCancelBetReq{
+			UserId:         userID,
+			TableId:        payload.TableID,
+			Color:          pbColorGame.ColorGameReward(colorEnumVal),
+			Amount:         payload.Amount,
+			IdempotencyKey: req.IdempotencyKey,
+		})
+		if err != nil {
+			return buildError(pbCommon.ErrorCode_INTERNAL_ERROR, err.Error())
+		}
+		if rsp.ErrorCode != pbCommon.ErrorCode_SUCCESS {
+			return buildError(rsp.ErrorCode, rsp.Error)
+		}
+
+		return json.Marshal(map[string]interface{}{
+			"game_code": "color_game",
+			"command":   "ColorGameCancelBetRSP",
+			"data": map[string]interface{}{
+				"error_code":       int32(pbCommon.ErrorCode_SUCCESS),
+				"bet_id":           rsp.BetId,
+				"refund_amount":    rsp.RefundAmount,
+				"remaining_amount": rsp.RemainingAmount,
+				"idempotency_key":  req.IdempotencyKey,
+				"error":            "",
+			},
+		})
+
+	case "ColorGamePlaceBetSlipREQ":
+		var payload struct {
+			TableID string `json:"table_id"` // Optional, defaults to the table the player joined
+			Bets    []struct {
+				Color  string `json:"color"`
+				Amount int64  `json:"amount"`
+			} `json:"bets"`
+		}
+		if err := json.Unmarshal(data, &payload); err != nil {
+			logger.Error(ctx).
+				Err(err).
+				Int64("user_id", userID).
+				Str("command", command).
+				Msg("Failed to unmarshal PlaceBetSlip payload")
+			return nil, fmt.Errorf("invalid place_bet_slip payload: %w", err)
+		}
+
+		buildError := func(errCode pbCommon.ErrorCode, errMsg string) ([]byte, error) {
+			logger.Warn(ctx).
+				Int64("user_id", userID).
+				Str("command", command).
+				Str("error_code", errCode.String()).
+				Str("error", errMsg).
+				Msg("PlaceBetSlip failed")
+			return json.Marshal(map[string]interface{}{
+				"game_code": "color_game",
+				"command":   "ColorGamePlaceBetSlipRSP",
+				"data": map[string]interface{}{
+					"error_code":      int32(errCode),
+					"bets":            []interface{}{},
+					"idempotency_key": req.IdempotencyKey,
+					"error":           errMsg,
+				},
+			})
+		}
+
+		lines := make([]*pbColorGame.ColorGameBetLine, 0, len(payload.Bets))
+		for _, bet := range payload.Bets {
+			colorEnumVal, ok := pbColorGame.ColorGameReward_value["REWARD_"+strings.ToUpper(bet.Color)]
+			if !ok {
+				return buildError(pbCommon.ErrorCode_INVALID_BET_OPTION, fmt.Sprintf("invalid color: %s", bet.Color))
+			}
+			lines = append(lines, &pbColorGame.ColorGameBetLine{
+				Color:  pbColorGame.ColorGameReward(colorEnumVal),
+				Amount: bet.Amount,
+			})
+		}
+
+		if payload.TableID == "" {
+			payload.TableID = uc.currentTable(userID, "color_game")
+		}
+
+		rsp, err := uc.colorGameSvc.PlaceBetSlip(ctx, &pbColorGame.ColorGamePlaceBetSlipReq{
+			UserId:         userID,
+			TableId:        payload.TableID,
+			Lines:          lines,
+			IdempotencyKey: req.IdempotencyKey,
+		})
+		if err != nil {
+			return buildError(pbCommon.ErrorCode_INTERNAL_ERROR, err.Error())
+		}
+		if rsp.ErrorCode != pbCommon.ErrorCode_SUCCESS {
+			return buildError(rsp.ErrorCode, rsp.Error)
+		}
+
+		return json.Marshal(map[string]interface{}{
+			"game_code": "color_game",
+			"command":   "ColorGamePlaceBetSlipRSP",
+			"data": map[string]interface{}{
+				"error_code":      int32(pbCommon.ErrorCode_SUCCESS),
+				"bets":            betLinesToJSON(rsp.Lines),
+				"idempotency_key": req.IdempotencyKey,
+				"error":           "",
+			},
+		})
+
+	case "ColorGameRebetREQ":
+		return uc.rebetColorGame(ctx, userID, req, 1, "ColorGameRebetRSP")
+
+	case "ColorGameDoubleBetREQ":
+		return uc.rebetColorGame(ctx, userID, req, 2, "ColorGameDoubleBetRSP")
+
+	case "ColorGameStartAutoBetREQ":
+		var payload struct {
+			TableID    string `json:"table_id"`   // Optional, defaults to the table the player joined
+			Multiplier int64  `json:"multiplier"` // Optional, 2 doubles the last slip
+			Rounds     int32  `json:"rounds"`
+			StopLoss   int64  `json:"stop_loss"` // Optional, 0 = no threshold
+			StopWin    int64  `json:"stop_win"`  // Optional, 0 = no threshold
+		}
+		if err := json.Unmarshal(data, &payload); err != nil {
+			logger.Error(ctx).
+				Err(err).
+				Int64("user_id", userID).
+				Str("command", command).
+				Msg("Failed to unmarshal StartAutoBet payload")
+			return nil, fmt.Errorf("invalid start_auto_bet payload: %w", err)
+		}
+		if payload.TableID == "" {
+			payload.TableID = uc.currentTable(userID, "color_game")
+		}
+
+		rsp, err := uc.colorGameSvc.StartAutoBet(ctx, &pbColorGame.ColorGameStartAutoBetReq{
+			UserId:     userID,
+			TableId:    payload.TableID,
+			Multiplier: payload.Multiplier,
+			Rounds:     payload.Rounds,
+			StopLoss:   payload.StopLoss,
+			StopWin:    payload.StopWin,
+		})
+		if err != nil {
+			rsp = &pbColorGame.ColorGameStartAutoBetRsp{ErrorCode: pbCommon.ErrorCode_INTERNAL_ERROR, Error: err.Error()}
+		}
+		if rsp.ErrorCode != pbCommon.ErrorCode_SUCCESS {
+			logger.Warn(ctx).
+				Int64("user_id", userID).
+				Str("command", command).
+				Str("error_code", rsp.ErrorCode.String()).
+				Str("error", rsp.Error).
+				Msg("StartAutoBet failed")
+		}
+
+		return json.Marshal(map[string]interface{}{
+			"game_code": "color_game",
+			"command":   "ColorGameStartAutoBetRSP",
+			"data": map[string]interface{}{
+				"error_code":  int32(rsp.ErrorCode),
+				"bets":        betLinesToJSON(rsp.Lines),
+				"rounds_left": rsp.RoundsLeft,
+				"error":       rsp.Error,
+			},
+		})
+
+	case "ColorGameStopAutoBetREQ":
+		rsp, err := uc.colorGameSvc.StopAutoBet(ctx, &pbColorGame.ColorGameStopAutoBetReq{UserId: userID})
+		if err != nil {
+			rsp = &pbColorGame.ColorGameStopAutoBetRsp{ErrorCode: pbCommon.ErrorCode_INTERNAL_ERROR, Error: err.Error()}
+		}
+
+		return json.Marshal(map[string]interface{}{
+			"game_code": "color_game",
+			"command":   "ColorGameStopAutoBetRSP",
+			"data": map[string]interface{}{
+				"error_code": int32(rsp.ErrorCode),
+				"error":      rsp.Error,
+			},
+		})
+
+	case "ColorGameBetHistoryREQ":
+		var payload struct {
+			RoundID  string `json:"round_id"`  // Optional, every bet of the player in the round
+			GameCode string `json:"game_code"` // Optional
+			From     int64  `json:"from"`      // Optional, Unix seconds
+			To       int64  `json:"to"`        // Optional, Unix seconds (exclusive)
+			Cursor   string `json:"cursor"`    // next_cursor of the previous page
+			Limit    int32  `json:"limit"`     // Optional, defaults to 20
+		}
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, &payload); err != nil {
+				return nil, fmt.Errorf("invalid bet_history payload: %w", err)
+			}
+		}
+
+		rsp, err := uc.colorGameSvc.GetBetHistory(ctx, &pbColorGame.ColorGameGetBetHistoryReq{
+			UserId:   userID,
+			RoundId:  payload.RoundID,
+			GameCode: payload.GameCode,
+			From:     payload.From,
+			To:       payload.To,
+			Cursor:   payload.Cursor,
+			Limit:    payload.Limit,
+		})
+		if err != nil {
+			rsp = &pbColorGame.ColorGameGetBetHistoryRsp{ErrorCode: pbCommon.ErrorCode_INTERNAL_ERROR, Error: err.Error()}
+		}
+		if rsp.ErrorCode != pbCommon.ErrorCode_SUCCESS {
+			logger.Warn(ctx).
+				Int64("user_id", userID).
+				Str("command", command).
+				Str("error_code", rsp.ErrorCode.String()).
+				Str("error", rsp.Error).
+				Msg("GetBetHistory failed")
+		}
+
+		return json.Marshal(map[string]interface{}{
+			"game_code": "color_game",
+			"command":   "ColorGameBetHistoryRSP",
+			"data": map[string]interface{}{
+				"error_code":  int32(rsp.ErrorCode),
+				"records":     betRecordsToJSON(rsp.Records),
+				"next_cursor": rsp.NextCursor,
+				"error":       rsp.Error,
 			},
 		})
 
+	case "ColorGameRoundHistoryREQ":
+		var payload struct {
+			TableID string `json:"table_id"` // Optional, defaults to the table the player joined
+			Limit   int32  `json:"limit"`    // Optional, defaults to 20 (at most 100)
+		}
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, &payload); err != nil {
+				return nil, fmt.Errorf("invalid round_history payload: %w", err)
+			}
+		}
+		if payload.TableID == "" {
+			payload.TableID = uc.currentTable(userID, "color_game")
+		}
+		return uc.roundHistoryColorGame(ctx, userID, payload.TableID, payload.Limit, "ColorGameRoundHistoryRSP")
+
 	case "ColorGameGetStateREQ":
+		var payload struct {
+			TableID string `json:"table_id"` // Optional, defaults to the table the player joined
+		}
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, &payload); err != nil {
+				return nil, fmt.Errorf("invalid get_state payload: %w", err)
+			}
+		}
+		if payload.TableID == "" {
+			payload.TableID = uc.currentTable(userID, "color_game")
+		}
+
 		rsp, err := uc.colorGameSvc.GetState(ctx, &pbColorGame.ColorGameGetStateReq{
-			UserId: userID,
+			UserId:  userID,
+			TableId: payload.TableID,
 		})
 		if err != nil {
 			logger.Error(ctx).
@@ -104,6 +410,30 @@ func (uc *GatewayUseCase) handleColorGame(ctx context.Context, userID int64, com
 			"data":      stateData,
 		})
 
+	case "ColorGameJoinTableREQ":
+		return uc.joinColorGameTable(ctx, userID, data)
+
+	case "ColorGameLeaveTableREQ":
+		if uc.tables == nil {
+			return nil, fmt.Errorf("tables are not supported by this gateway")
+		}
+		tableID := uc.tables.LeaveTable(userID, "color_game")
+
+		logger.Info(ctx).
+			Int64("user_id", userID).
+			Str("table_id", tableID).
+			Msg("Player left table")
+
+		return json.Marshal(map[string]interface{}{
+			"game_code": "color_game",
+			"command":   "ColorGameLeaveTableRSP",
+			"data": map[string]interface{}{
+				"error_code": int32(pbCommon.ErrorCode_SUCCESS),
+				"table_id":   tableID,
+				"error":      "",
+			},
+		})
+
 	default:
 		logger.Error(ctx).
 			Int64("user_id", userID).
@@ -112,3 +442,215 @@ func (uc *GatewayUseCase) handleColorGame(ctx context.Context, userID int64, com
 		return nil, fmt.Errorf("unknown command for color_game: %s", command)
 	}
 }
+
+// rebetColorGame places the last settled bet slip of the player again, multiplier 2 doubles it
+func (uc *GatewayUseCase) rebetColorGame(ctx context.Context, userID int64, req *RequestEnvelope, multiplier int64, rspCommand string) ([]byte, error) {
+	var payload struct {
+		TableID string `json:"table_id"` // Optional, defaults to the table the player joined
+	}
+	if len(req.Data) > 0 {
+		if err := json.Unmarshal(req.Data, &payload); err != nil {
+			return nil, fmt.Errorf("invalid rebet payload: %w", err)
+		}
+	}
+	if payload.TableID == "" {
+		payload.TableID = uc.currentTable(userID, "color_game")
+	}
+
+	rsp, err := uc.colorGameSvc.Rebet(ctx, &pbColorGame.ColorGameRebetReq{
+		UserId:         userID,
+		TableId:        payload.TableID,
+		Multiplier:     multiplier,
+		IdempotencyKey: req.IdempotencyKey,
+	})
+	if err != nil {
+		rsp = &pbColorGame.ColorGameRebetRsp{ErrorCode: pbCommon.ErrorCode_INTERNAL_ERROR, Error: err.Error()}
+	}
+	if rsp.ErrorCode != pbCommon.ErrorCode_SUCCESS {
+		logger.Warn(ctx).
+			Int64("user_id", userID).
+			Str("command", req.Command).
+			Str("error_code", rsp.ErrorCode.String()).
+			Str("error", rsp.Error).
+			Msg("Rebet failed")
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"game_code": "color_game",
+		"command":   rspCommand,
+		"data": map[string]interface{}{
+			"error_code":      int32(rsp.ErrorCode),
+			"bets":            betLinesToJSON(rsp.Lines),
+			"idempotency_key": req.IdempotencyKey,
+			"error":           rsp.Error,
+		},
+	})
+}
+
+// ColorGameRoundHistory returns the ColorGameRoundHistoryBRC sent to a player when they connect or join a table:
+// the results roadmap of the table they are seated at, so that the client can draw it before the next round
+func (uc *GatewayUseCase) ColorGameRoundHistory(ctx context.Context, userID int64) ([]byte, error) {
+	return uc.roundHistoryColorGame(ctx, userID, uc.currentTable(userID, "color_game"), 0, "ColorGameRoundHistoryBRC")
+}
+
+// roundHistoryColorGame returns the results roadmap of a table with its color frequencies
+func (uc *GatewayUseCase) roundHistoryColorGame(ctx context.Context, userID int64, tableID string, limit int32, command string) ([]byte, error) {
+	rsp, err := uc.colorGameSvc.GetRoundHistory(ctx, &pbColorGame.ColorGameGetRoundHistoryReq{
+		TableId: tableID,
+		Limit:   limit,
+	})
+	if err != nil {
+		rsp = &pbColorGame.ColorGameGetRoundHistoryRsp{ErrorCode: pbCommon.ErrorCode_INTERNAL_ERROR, Error: err.Error()}
+	}
+	if rsp.ErrorCode != pbCommon.ErrorCode_SUCCESS {
+		logger.Warn(ctx).
+			Int64("user_id", userID).
+			Str("table_id", tableID).
+			Str("command", command).
+			Str("error_code", rsp.ErrorCode.String()).
+			Str("error", rsp.Error).
+			Msg("GetRoundHistory failed")
+	}
+
+	rounds := make([]map[string]interface{}, 0, len(rsp.Rounds))
+	for _, round := range rsp.Rounds {
+		dice := make([]string, 0, len(round.Dice))
+		for _, die := range round.Dice {
+			dice = append(dice, colorName(die))
+		}
+		rounds = append(rounds, map[string]interface{}{
+			"round_id": round.RoundId,
+			"dice":     dice,
+			"voided":   round.Voided,
+			"end_time": round.EndTime,
+		})
+	}
+	stats := make([]map[string]interface{}, 0, len(rsp.Stats))
+	for _, stat := range rsp.Stats {
+		stats = append(stats, map[string]interface{}{
+			"color":  colorName(stat.Color),
+			"count":  stat.Count,
+			"rounds": stat.Rounds,
+		})
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"game_code": "color_game",
+		"command":   command,
+		"data": map[string]interface{}{
+			"error_code": int32(rsp.ErrorCode),
+			"table_id":   rsp.TableId,
+			"rounds":     rounds,
+			"stats":      stats,
+			"error":      rsp.Error,
+		},
+	})
+}
+
+// betLinesToJSON returns the lines of a bet slip with their colors as the client names them ("red")
+func betLinesToJSON(lines []*pbColorGame.ColorGameBetLine) []map[string]interface{} {
+	bets := make([]map[string]interface{}, 0, len(lines))
+	for _, line := range lines {
+		bets = append(bets, map[string]interface{}{
+			"color":  colorName(line.Color),
+			"amount": line.Amount,
+			"bet_id": line.BetId,
+		})
+	}
+	return bets
+}
+
+// betRecordsToJSON returns bet records with their colors as the client names them ("red")
+func betRecordsToJSON(records []*pbColorGame.ColorGameBetRecord) []map[string]interface{} {
+	bets := make([]map[string]interface{}, 0, len(records))
+	for _, record := range records {
+		dice := make([]string, 0, len(record.Dice))
+		for _, die := range record.Dice {
+			dice = append(dice, colorName(die))
+		}
+		bets = append(bets, map[string]interface{}{
+			"bet_id":      record.BetId,
+			"round_id":    record.RoundId,
+			"color":       colorName(record.Color),
+			"amount":      record.Amount,
+			"payout":      record.Payout,
+			"status":      record.Status,
+			"dice":        dice,
+			"void_reason": record.VoidReason,
+			"created_at":  record.CreatedAt,
+			"settled_at":  record.SettledAt,
+		})
+	}
+	return bets
+}
+
+// colorName returns the name the client uses for a color ("red")
+func colorName(color pbColorGame.ColorGameReward) string {
+	return strings.ToLower(strings.TrimPrefix(color.String(), "REWARD_"))
+}
+
+// joinColorGameTable seats the player at a table after GS confirmed that the table exists.
+// The response carries the current state of the table so that the client can render it right away,
+// the results roadmap of the table is pushed alongside as on connect.
+func (uc *GatewayUseCase) joinColorGameTable(ctx context.Context, userID int64, data []byte) ([]byte, error) {
+	if uc.tables == nil {
+		return nil, fmt.Errorf("tables are not supported by this gateway")
+	}
+
+	var payload struct {
+		TableID string `json:"table_id"`
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &payload); err != nil {
+			return nil, fmt.Errorf("invalid join_table payload: %w", err)
+		}
+	}
+	if payload.TableID == "" {
+		payload.TableID = colorgame.DefaultTableID
+	}
+
+	buildRsp := func(errCode pbCommon.ErrorCode, errMsg string, state interface{}) ([]byte, error) {
+		return json.Marshal(map[string]interface{}{
+			"game_code": "color_game",
+			"command":   "ColorGameJoinTableRSP",
+			"data": map[string]interface{}{
+				"error_code": int32(errCode),
+				"table_id":   payload.TableID,
+				"state":      state,
+				"error":      errMsg,
+			},
+		})
+	}
+
+	rsp, err := uc.colorGameSvc.GetState(ctx, &pbColorGame.ColorGameGetStateReq{
+		UserId:  userID,
+		TableId: payload.TableID,
+	})
+	if err != nil {
+		logger.Error(ctx).Err(err).Int64("user_id", userID).Str("table_id", payload.TableID).Msg("JoinTable failed")
+		return buildRsp(pbCommon.ErrorCode_INTERNAL_ERROR, err.Error(), nil)
+	}
+	if rsp.ErrorCode != pbCommon.ErrorCode_SUCCESS {
+		logger.Warn(ctx).Int64("user_id", userID).Str("table_id", payload.TableID).Str("error_code", rsp.ErrorCode.String()).Msg("JoinTable rejected")
+		return buildRsp(rsp.ErrorCode, "cannot join table "+payload.TableID, nil)
+	}
+
+	uc.tables.JoinTable(userID, "color_game", payload.TableID)
+
+	logger.Info(ctx).
+		Int64("user_id", userID).
+		Str("table_id", payload.TableID).
+		Msg("Player joined table")
+
+	if roadmap, err := uc.ColorGameRoundHistory(ctx, userID); err != nil {
+		logger.Warn(ctx).Err(err).Int64("user_id", userID).Str("table_id", payload.TableID).Msg("Push round history failed")
+	} else {
+		uc.tables.SendToUser(userID, roadmap)
+	}
+
+	var stateData interface{}
+	if len(rsp.StateJson) > 0 {
+		_ = json.Unmarshal(rsp.StateJson, &stateData)
+	}
+	return buildRsp(pbCommon.ErrorCode_SUCCESS, "", stateData)
+}
@@ -9,23 +9,42 @@ import (
 	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
 )
 
+// TableMembership tracks the table each player joined, table broadcasts only reach its members (implemented by ws.Manager)
+type TableMembership interface {
+	JoinTable(userID int64, gameCode, tableID string)
+	LeaveTable(userID int64, gameCode string) string
+	CurrentTable(userID int64, gameCode string) string
+	SendToUser(userID int64, message []byte) // Pushes a message to the player outside of a request/response
+}
+
 // GatewayUseCase handles gateway logic
 type GatewayUseCase struct {
 	colorGameSvc colorgame.ColorGameGSService
+	tables       TableMembership
 }
 
 // NewGatewayUseCase creates a new gateway use case
-func NewGatewayUseCase(colorGameSvc colorgame.ColorGameGSService) *GatewayUseCase {
+func NewGatewayUseCase(colorGameSvc colorgame.ColorGameGSService, tables TableMembership) *GatewayUseCase {
 	return &GatewayUseCase{
 		colorGameSvc: colorGameSvc,
+		tables:       tables,
+	}
+}
+
+// currentTable returns the table the player joined, requests without a table_id are routed there
+func (uc *GatewayUseCase) currentTable(userID int64, gameCode string) string {
+	if uc.tables == nil {
+		return ""
 	}
+	return uc.tables.CurrentTable(userID, gameCode)
 }
 
 // RequestEnvelope defines the standard request structure
 type RequestEnvelope struct {
-	Game    string          `json:"game_code"`
-	Command string          `json:"command"`
-	Data    json.RawMessage `json:"data"`
+	Game           string          `json:"game_code"`
+	Command        string          `json:"command"`
+	Data           json.RawMessage `json:"data"`
+	IdempotencyKey string          `json:"idempotency_key,omitempty"` // Client request key, a resent request is not applied twice
 }
 
 // HandleMessage forwards message to game service
@@ -41,7 +60,7 @@ func (uc *GatewayUseCase) HandleMessage(ctx context.Context, userID int64, messa
 
 	switch req.Game {
 	case "color_game":
-		return uc.handleColorGame(ctx, userID, req.Command, req.Data)
+		return uc.handleColorGame(ctx, userID, &req)
 	default:
 		return nil, fmt.Errorf("unknown game: %s", req.Game)
 	}
@@ -34,6 +34,8 @@ type Connection struct {
 // Manager manages all WebSocket connections
 type Manager struct {
 	clients    map[int64]*Connection
+	tables     map[string]map[int64]struct{} // gameCode:tableID -> members
+	userTables map[int64]map[string]string   // userID -> gameCode -> joined tableID
 	register   chan *Connection
 	unregister chan *Connection
 	mu         sync.RWMutex
@@ -43,11 +45,17 @@ type Manager struct {
 func NewManager() *Manager {
 	return &Manager{
 		clients:    make(map[int64]*Connection),
+		tables:     make(map[string]map[int64]struct{}),
+		userTables: make(map[int64]map[string]string),
 		register:   make(chan *Connection),
 		unregister: make(chan *Connection),
 	}
 }
 
+func tableKey(gameCode, tableID string) string {
+	return gameCode + ":" + tableID
+}
+
 // Register registers a new connection
 func (m *Manager) Register(conn *websocket.Conn, userID int64) *Connection {
 	c := &Connection{
@@ -75,8 +83,10 @@ func (m *Manager) Run() {
 
 		case client := <-m.unregister:
 			m.mu.Lock()
-			if _, ok := m.clients[client.UserID]; ok {
+			// A replaced connection must not remove the user's new connection (and its tables)
+			if current, ok := m.clients[client.UserID]; ok && current == client {
 				delete(m.clients, client.UserID)
+				m.leaveAllTablesLocked(client.UserID)
 				client.CloseWithReason(ReasonShutdown, nil)
 			}
 			m.mu.Unlock()
@@ -101,6 +111,83 @@ func (m *Manager) Broadcast(message []byte) {
 	}
 }
 
+// BroadcastToTable sends a message to the local clients that joined a table of a game
+func (m *Manager) BroadcastToTable(gameCode, tableID string, message []byte) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for userID := range m.tables[tableKey(gameCode, tableID)] {
+		client, ok := m.clients[userID]
+		if !ok {
+			continue
+		}
+		select {
+		case client.Send <- message:
+		default:
+			// Buffer full, drop client (cleanup happens through the unregister channel)
+			client.CloseWithReason(ReasonBufferFull, nil)
+		}
+	}
+}
+
+// JoinTable seats the user at a table, a user sits at one table per game so the previous table is left
+func (m *Manager) JoinTable(userID int64, gameCode, tableID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.leaveTableLocked(userID, gameCode)
+
+	key := tableKey(gameCode, tableID)
+	if m.tables[key] == nil {
+		m.tables[key] = make(map[int64]struct{})
+	}
+	m.tables[key][userID] = struct{}{}
+
+	if m.userTables[userID] == nil {
+		m.userTables[userID] = make(map[string]string)
+	}
+	m.userTables[userID][gameCode] = tableID
+}
+
+// LeaveTable removes the user from its table of a game and returns the table left ("" if none)
+func (m *Manager) LeaveTable(userID int64, gameCode string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.leaveTableLocked(userID, gameCode)
+}
+
+// CurrentTable returns the table the user joined in a game ("" if none)
+func (m *Manager) CurrentTable(userID int64, gameCode string) string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.userTables[userID][gameCode]
+}
+
+func (m *Manager) leaveTableLocked(userID int64, gameCode string) string {
+	tableID, ok := m.userTables[userID][gameCode]
+	if !ok {
+		return ""
+	}
+
+	key := tableKey(gameCode, tableID)
+	delete(m.tables[key], userID)
+	if len(m.tables[key]) == 0 {
+		delete(m.tables, key)
+	}
+
+	delete(m.userTables[userID], gameCode)
+	if len(m.userTables[userID]) == 0 {
+		delete(m.userTables, userID)
+	}
+	return tableID
+}
+
+func (m *Manager) leaveAllTablesLocked(userID int64) {
+	for gameCode := range m.userTables[userID] {
+		m.leaveTableLocked(userID, gameCode)
+	}
+}
+
 // SendToUser sends a message to a specific user
 func (m *Manager) SendToUser(userID int64, message []byte) {
 	m.mu.RLock()
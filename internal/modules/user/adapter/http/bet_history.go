@@ -0,0 +1,132 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/frankieli/game_product/pkg/logger"
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	pbCommon "github.com/frankieli/game_product/shared/proto/common"
+	"github.com/gin-gonic/gin"
+)
+
+// BetHistoryService returns the bet orders of a player, implemented by the color game GS
+// (the local GS handler in the monolith, the gRPC client in microservices mode)
+type BetHistoryService interface {
+	GetBetHistory(ctx context.Context, req *pbColorGame.ColorGameGetBetHistoryReq) (*pbColorGame.ColorGameGetBetHistoryRsp, error)
+}
+
+// SetBetHistoryService enables GET /bets, the user API has no bet history without it
+func (h *Handler) SetBetHistoryService(svc BetHistoryService) {
+	h.betHistory = svc
+}
+
+type betRecordResponse struct {
+	BetID      string   `json:"bet_id"`
+	RoundID    string   `json:"round_id"`
+	GameCode   string   `json:"game_code"`
+	Color      string   `json:"color"`
+	Amount     int64    `json:"amount"`
+	Payout     int64    `json:"payout"`
+	Status     string   `json:"status"`
+	Dice       []string `json:"dice"`
+	VoidReason string   `json:"void_reason,omitempty"`
+	CreatedAt  int64    `json:"created_at"`
+	SettledAt  int64    `json:"settled_at,omitempty"`
+}
+
+type betHistoryResponse struct {
+	Records    []betRecordResponse `json:"records"`
+	NextCursor string              `json:"next_cursor"`
+}
+
+// BetHistory returns the bets of the logged in player, newest first.
+// Query: round_id, game_code, from and to (Unix seconds), cursor (next_cursor of the previous page), limit.
+func (h *Handler) BetHistory(c *gin.Context) {
+	ctx := c.Request.Context()
+	if h.betHistory == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "bet history is not available"})
+		return
+	}
+
+	token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if token == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "missing token"})
+		return
+	}
+	userID, _, _, err := h.userUC.ValidateToken(ctx, token)
+	if err != nil {
+		logger.Warn(ctx).Err(err).Msg("BetHistory: invalid token")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	req := &pbColorGame.ColorGameGetBetHistoryReq{
+		UserId:   userID,
+		RoundId:  c.Query("round_id"),
+		GameCode: c.Query("game_code"),
+		Cursor:   c.Query("cursor"),
+	}
+	for name, target := range map[string]*int64{"from": &req.From, "to": &req.To} {
+		if value := c.Query(name); value != "" {
+			if *target, err = strconv.ParseInt(value, 10, 64); err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": "invalid " + name})
+				return
+			}
+		}
+	}
+	if value := c.Query("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+			return
+		}
+		req.Limit = int32(limit)
+	}
+
+	rsp, err := h.betHistory.GetBetHistory(ctx, req)
+	if err != nil {
+		logger.Error(ctx).Err(err).Int64("user_id", userID).Msg("BetHistory: failed")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	switch rsp.ErrorCode {
+	case pbCommon.ErrorCode_SUCCESS:
+	case pbCommon.ErrorCode_INVALID_PARAMS:
+		c.JSON(http.StatusBadRequest, gin.H{"error": rsp.Error})
+		return
+	default:
+		logger.Error(ctx).Int64("user_id", userID).Str("error_code", rsp.ErrorCode.String()).Str("error", rsp.Error).Msg("BetHistory: failed")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": rsp.Error})
+		return
+	}
+
+	records := make([]betRecordResponse, 0, len(rsp.Records))
+	for _, record := range rsp.Records {
+		dice := make([]string, 0, len(record.Dice))
+		for _, die := range record.Dice {
+			dice = append(dice, colorName(die))
+		}
+		records = append(records, betRecordResponse{
+			BetID:      record.BetId,
+			RoundID:    record.RoundId,
+			GameCode:   record.GameCode,
+			Color:      colorName(record.Color),
+			Amount:     record.Amount,
+			Payout:     record.Payout,
+			Status:     record.Status,
+			Dice:       dice,
+			VoidReason: record.VoidReason,
+			CreatedAt:  record.CreatedAt,
+			SettledAt:  record.SettledAt,
+		})
+	}
+	c.JSON(http.StatusOK, betHistoryResponse{Records: records, NextCursor: rsp.NextCursor})
+}
+
+// colorName returns the name clients use for a color ("red")
+func colorName(color pbColorGame.ColorGameReward) string {
+	return strings.ToLower(strings.TrimPrefix(color.String(), "REWARD_"))
+}
@@ -16,6 +16,7 @@ type Handler struct {
 	userUC          *usecase.UserUseCase
 	loginLimiter    *rate.Limiter
 	registerLimiter *rate.Limiter
+	betHistory      BetHistoryService // nil until SetBetHistoryService
 }
 
 // NewHandler creates a new HTTP handler
@@ -34,6 +35,7 @@ func (h *Handler) RegisterRoutes(router *gin.RouterGroup) {
 	router.POST("/register", h.Register)
 	router.POST("/login", h.Login)
 	router.POST("/logout", h.Logout)
+	router.GET("/bets", h.BetHistory)
 	router.GET("/health", h.Health)
 }
 
@@ -2,12 +2,24 @@ package wallet
 
 import (
 	"context"
+	"fmt"
 	"sync"
+
+	"github.com/frankieli/game_product/pkg/service"
 )
 
+// mockBet is a stake deduction that can be rolled back
+type mockBet struct {
+	userID     int64
+	amount     int64
+	rolledBack bool
+}
+
 // MockService implements contract.WalletService with mock logic
 type MockService struct {
 	balances map[int64]int64
+	bets     map[string]*mockBet // txID -> stake deduction
+	wins     map[string]bool     // txIDs of credited winnings
 	mu       sync.RWMutex
 }
 
@@ -15,6 +27,8 @@ type MockService struct {
 func NewMockService() *MockService {
 	return &MockService{
 		balances: make(map[int64]int64),
+		bets:     make(map[string]*mockBet),
+		wins:     make(map[string]bool),
 	}
 }
 
@@ -67,7 +81,46 @@ func (s *MockService) AddBalance(ctx context.Context, userID int64, amount int64
 	return newBalance, nil
 }
 
-// PlaceBet places a bet (wrapper for compatibility)
-func (s *MockService) PlaceBet(ctx context.Context, userID int64, amount int64, roundID string) (int64, error) {
+// PlaceBet places a bet, a repeated txID is not deducted twice
+func (s *MockService) PlaceBet(ctx context.Context, userID int64, amount int64, roundID string, txID string) (int64, error) {
+	s.mu.Lock()
+	if _, exists := s.bets[txID]; exists {
+		s.mu.Unlock()
+		return s.GetBalance(ctx, userID)
+	}
+	s.bets[txID] = &mockBet{userID: userID, amount: amount}
+	s.mu.Unlock()
+
 	return s.DeductBalance(ctx, userID, amount, "bet:"+roundID)
 }
+
+// SettleWin credits winnings, a repeated txID is not credited twice
+func (s *MockService) SettleWin(ctx context.Context, userID int64, amount int64, roundID string, txID string) (int64, error) {
+	s.mu.Lock()
+	if s.wins[txID] {
+		s.mu.Unlock()
+		return s.GetBalance(ctx, userID)
+	}
+	s.wins[txID] = true
+	s.mu.Unlock()
+
+	return s.AddBalance(ctx, userID, amount, "win:"+roundID)
+}
+
+// Rollback returns the stake of a bet transaction, a transaction is only rolled back once
+func (s *MockService) Rollback(ctx context.Context, originalTxID string, reason string) (int64, error) {
+	s.mu.Lock()
+	bet, exists := s.bets[originalTxID]
+	if !exists {
+		s.mu.Unlock()
+		return 0, fmt.Errorf("%w: %s", service.ErrTransactionNotFound, originalTxID)
+	}
+	if bet.rolledBack {
+		s.mu.Unlock()
+		return s.GetBalance(ctx, bet.userID)
+	}
+	bet.rolledBack = true
+	s.mu.Unlock()
+
+	return s.AddBalance(ctx, bet.userID, bet.amount, "rollback:"+originalTxID)
+}
@@ -0,0 +1,58 @@
+// Package clock abstracts time so that timed game logic can be driven deterministically in tests.
+package clock
+
+import "time"
+
+// Clock tells the time and creates timers
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a single-shot timer created by a Clock
+type Timer interface {
+	// C delivers the time once the timer fired
+	C() <-chan time.Time
+	// Stop prevents the timer from firing, it returns false if the timer already fired or was stopped
+	Stop() bool
+}
+
+// Real is the Clock of the operating system
+type Real struct{}
+
+// New returns the real clock
+func New() Clock {
+	return Real{}
+}
+
+func (Real) Now() time.Time {
+	return time.Now()
+}
+
+func (Real) NewTimer(d time.Duration) Timer {
+	return realTimer{time.NewTimer(d)}
+}
+
+type realTimer struct {
+	timer *time.Timer
+}
+
+func (t realTimer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+func (t realTimer) Stop() bool {
+	return t.timer.Stop()
+}
+
+// Sleep waits for d on clock c, it returns false if done was closed first
+func Sleep(c Clock, d time.Duration, done <-chan struct{}) bool {
+	timer := c.NewTimer(d)
+	select {
+	case <-timer.C():
+		return true
+	case <-done:
+		timer.Stop()
+		return false
+	}
+}
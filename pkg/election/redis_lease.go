@@ -0,0 +1,221 @@
+// Package election provides leader election on top of a Redis lease with fencing tokens.
+package election
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/frankieli/game_product/pkg/logger"
+	"github.com/redis/go-redis/v9"
+)
+
+// acquireScript takes the lease if it is free and returns a new fencing token (0 if the lease is held)
+var acquireScript = redis.NewScript(`
+if redis.call('SET', KEYS[1], ARGV[1], 'NX', 'PX', ARGV[2]) then
+	return redis.call('INCR', KEYS[2])
+end
+return 0
+`)
+
+// renewScript extends the lease only if it is still held by this candidate
+var renewScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseScript drops the lease only if it is still held by this candidate
+var releaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// LeaderFunc runs while this candidate is the leader.
+// leaderCtx is cancelled as soon as leadership is lost, token is the fencing token of this term.
+type LeaderFunc func(leaderCtx context.Context, token int64)
+
+// Elector campaigns for a Redis lease. Only the holder of the lease is the leader.
+// Every new term increments a fencing token, so that writes of a deposed leader can be rejected.
+type Elector struct {
+	rdb      *redis.Client
+	leaseKey string
+	tokenKey string
+	id       string
+
+	TTL           time.Duration // Lease lifetime, a crashed leader is replaced after at most TTL + RetryInterval
+	RenewInterval time.Duration // How often the leader extends the lease
+	RetryInterval time.Duration // How often followers try to acquire the lease
+
+	mu       sync.RWMutex
+	isLeader bool
+	token    int64
+	resigned bool
+}
+
+// NewElector creates a candidate with a unique id (e.g. ip:port) for the election called name
+func NewElector(rdb *redis.Client, name string, id string) *Elector {
+	return &Elector{
+		rdb:           rdb,
+		leaseKey:      "election:" + name,
+		tokenKey:      "election:" + name + ":token",
+		id:            id,
+		TTL:           3 * time.Second,
+		RenewInterval: 1 * time.Second,
+		RetryInterval: 500 * time.Millisecond,
+	}
+}
+
+// IsLeader reports whether this candidate currently holds the lease
+func (e *Elector) IsLeader() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.isLeader
+}
+
+// Token returns the fencing token of the current term (0 if not leader)
+func (e *Elector) Token() int64 {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.token
+}
+
+// Leader returns the id of the current lease holder, empty while no candidate holds the lease.
+// Candidates use their service address as id, so followers can forward requests only the leader can serve.
+func (e *Elector) Leader(ctx context.Context) (string, error) {
+	id, err := e.rdb.Get(ctx, e.leaseKey).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("get lease holder: %w", err)
+	}
+	return id, nil
+}
+
+// Resign stops campaigning without cutting the current term short: Run returns once onElected returned
+// (or right away on a follower) instead of taking the lease again
+func (e *Elector) Resign() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.resigned = true
+}
+
+// hasResigned reports whether Resign was called
+func (e *Elector) hasResigned() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.resigned
+}
+
+// Run campaigns until ctx is done or the candidate resigns. Whenever the lease is acquired, onElected is called
+// and blocks for the term. The lease is released when onElected returns, so another candidate can take over immediately.
+func (e *Elector) Run(ctx context.Context, onElected LeaderFunc) {
+	for {
+		if e.hasResigned() {
+			return
+		}
+
+		token, err := e.tryAcquire(ctx)
+		if err != nil {
+			logger.Warn(ctx).Err(err).Str("candidate", e.id).Msg("⚠️ [Election] Failed to acquire lease")
+		}
+
+		if token > 0 {
+			e.lead(ctx, token, onElected)
+			if e.hasResigned() {
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(e.RetryInterval):
+		}
+	}
+}
+
+// lead runs one leadership term
+func (e *Elector) lead(ctx context.Context, token int64, onElected LeaderFunc) {
+	leaderCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	e.setLeader(true, token)
+	defer e.setLeader(false, 0)
+
+	logger.Info(ctx).
+		Str("candidate", e.id).
+		Int64("fencing_token", token).
+		Msg("👑 [Election] Became leader")
+
+	go e.keepAlive(leaderCtx, cancel)
+
+	onElected(leaderCtx, token)
+
+	// Resign so that a follower does not have to wait for the lease to expire
+	releaseCtx, releaseCancel := context.WithTimeout(context.Background(), time.Second)
+	defer releaseCancel()
+	if err := releaseScript.Run(releaseCtx, e.rdb, []string{e.leaseKey}, e.id).Err(); err != nil {
+		logger.Warn(ctx).Err(err).Str("candidate", e.id).Msg("⚠️ [Election] Failed to release lease")
+	}
+
+	logger.Info(ctx).
+		Str("candidate", e.id).
+		Int64("fencing_token", token).
+		Msg("👋 [Election] Leadership ended")
+}
+
+// keepAlive renews the lease and cancels the term once it can no longer be guaranteed
+func (e *Elector) keepAlive(leaderCtx context.Context, cancel context.CancelFunc) {
+	ticker := time.NewTicker(e.RenewInterval)
+	defer ticker.Stop()
+
+	lastRenew := time.Now()
+	for {
+		select {
+		case <-leaderCtx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		renewed, err := renewScript.Run(leaderCtx, e.rdb, []string{e.leaseKey}, e.id, e.TTL.Milliseconds()).Int64()
+		if err == nil && renewed == 1 {
+			lastRenew = time.Now()
+			continue
+		}
+
+		if err == nil {
+			// Someone else holds the lease
+			logger.Warn(leaderCtx).Str("candidate", e.id).Msg("⚠️ [Election] Lease lost")
+			cancel()
+			return
+		}
+
+		// Redis is unreachable: keep leading only while the lease is guaranteed to be ours (one renew interval of margin)
+		if time.Since(lastRenew) >= e.TTL-e.RenewInterval {
+			logger.Warn(leaderCtx).Err(err).Str("candidate", e.id).Msg("⚠️ [Election] Lease expired while Redis unreachable")
+			cancel()
+			return
+		}
+	}
+}
+
+func (e *Elector) tryAcquire(ctx context.Context) (int64, error) {
+	token, err := acquireScript.Run(ctx, e.rdb, []string{e.leaseKey, e.tokenKey}, e.id, e.TTL.Milliseconds()).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("acquire lease: %w", err)
+	}
+	return token, nil
+}
+
+func (e *Elector) setLeader(isLeader bool, token int64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.isLeader = isLeader
+	e.token = token
+}
@@ -212,6 +212,11 @@ func (c *BaseClient) getConnDirect(addr string) (*grpc.ClientConn, error) {
 	return conn, nil
 }
 
+// GetInstanceConn returns the persistent connection to one instance ("ip:port"), bypassing load balancing
+func (c *BaseClient) GetInstanceConn(addr string) (*grpc.ClientConn, error) {
+	return c.getConnDirect(addr)
+}
+
 // GetConn maintains backward compatibility but now uses Load Balancing logic
 func (c *BaseClient) GetConn(serviceName string) (*grpc.ClientConn, error) {
 	return c.GetLBConn(serviceName)
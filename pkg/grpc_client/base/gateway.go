@@ -18,7 +18,17 @@ var _ service.GatewayService = (*BaseClient)(nil)
 // Broadcast sends a message to all connected players for a specific game
 // Performs client-side fan-out to all Gateway instances using SubmitTask.
 func (c *BaseClient) Broadcast(ctx context.Context, gameCode string, event proto.Message) {
-	logger.InfoGlobal().Str("game_code", gameCode).Msg("BaseClient.Broadcast called")
+	c.broadcast(ctx, gameCode, "", event)
+}
+
+// BroadcastToTable sends a message to the players that joined a table of a game
+// Every Gateway instance filters its own connections by table membership.
+func (c *BaseClient) BroadcastToTable(ctx context.Context, gameCode string, tableID string, event proto.Message) {
+	c.broadcast(ctx, gameCode, tableID, event)
+}
+
+func (c *BaseClient) broadcast(ctx context.Context, gameCode string, tableID string, event proto.Message) {
+	logger.InfoGlobal().Str("game_code", gameCode).Str("table_id", tableID).Msg("BaseClient.Broadcast called")
 
 	// Optimization: Use cached service addresses (Watcher + Singleflight)
 	addrs, err := c.GetServiceAddrs("gateway-service")
@@ -38,6 +48,7 @@ func (c *BaseClient) Broadcast(ctx context.Context, gameCode string, event proto
 	req := &pbGateway.BroadcastReq{
 		GameCode: gameCode,
 		Event:    anyEvent,
+		TableId:  tableID,
 	}
 
 	// Capture Request ID for simple propagation to async tasks
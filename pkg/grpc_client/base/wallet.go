@@ -82,7 +82,7 @@ func (c *BaseClient) AddBalance(ctx context.Context, userID int64, amount int64,
 	return rsp.NewBalance, nil
 }
 
-func (c *BaseClient) PlaceBet(ctx context.Context, userID int64, amount int64, roundID string) (int64, error) {
+func (c *BaseClient) PlaceBet(ctx context.Context, userID int64, amount int64, roundID string, txID string) (int64, error) {
 	ctx = c.withRequestID(ctx)
 	client, err := c.getWalletClient()
 	if err != nil {
@@ -93,11 +93,54 @@ func (c *BaseClient) PlaceBet(ctx context.Context, userID int64, amount int64, r
 		PlayerId:    userID,
 		Amount:      amount,
 		GameRoundId: roundID,
-		TxId:        fmt.Sprintf("bet-%d-%s", userID, roundID), // Idempotency key from round?
+		TxId:        txID,
 	})
 	if err != nil {
 		return 0, fmt.Errorf("rpc PlaceBet failed: %w", err)
 	}
+	if !rsp.Success {
+		return 0, fmt.Errorf("%w: %s", service.ErrWalletRejected, rsp.Message)
+	}
+	return rsp.NewBalance, nil
+}
+
+func (c *BaseClient) SettleWin(ctx context.Context, userID int64, amount int64, roundID string, txID string) (int64, error) {
+	ctx = c.withRequestID(ctx)
+	client, err := c.getWalletClient()
+	if err != nil {
+		return 0, err
+	}
+
+	rsp, err := client.SettleWin(ctx, &pbWallet.SettleWinReq{
+		PlayerId:    userID,
+		Amount:      amount,
+		GameRoundId: roundID,
+		TxId:        txID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("rpc SettleWin failed: %w", err)
+	}
+	if !rsp.Success {
+		return 0, fmt.Errorf("%w: %s", service.ErrWalletRejected, rsp.Message)
+	}
+	return rsp.NewBalance, nil
+}
+
+func (c *BaseClient) Rollback(ctx context.Context, originalTxID string, reason string) (int64, error) {
+	ctx = c.withRequestID(ctx)
+	client, err := c.getWalletClient()
+	if err != nil {
+		return 0, err
+	}
+
+	rsp, err := client.Rollback(ctx, &pbWallet.RollbackReq{
+		OriginalTxId: originalTxID,
+		RollbackTxId: "rollback-" + originalTxID, // One rollback per transaction, retries are idempotent
+		Reason:       reason,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("rpc Rollback failed: %w", err)
+	}
 	if !rsp.Success {
 		return 0, fmt.Errorf("wallet error: %s", rsp.Message)
 	}
@@ -41,6 +41,17 @@ func (c *Client) RecordBet(ctx context.Context, req *pb.ColorGameRecordBetReq) (
 	return gmsClient.RecordBet(ctx, req)
 }
 
+// RevokeBet takes a cancelled stake back from the round stats in GMS
+func (c *Client) RevokeBet(ctx context.Context, req *pb.ColorGameRevokeBetReq) (*pb.ColorGameRevokeBetRsp, error) {
+	conn, err := c.BaseClient.GetConn("gms-service")
+	if err != nil {
+		return nil, err
+	}
+
+	gmsClient := pb.NewColorGameGMSServiceClient(conn)
+	return gmsClient.RevokeBet(ctx, req)
+}
+
 // GetCurrentRound gets the current round from GMS
 func (c *Client) GetCurrentRound(ctx context.Context, req *pb.ColorGameGetCurrentRoundReq) (*pb.ColorGameGetCurrentRoundRsp, error) {
 	conn, err := c.BaseClient.GetConn("gms-service")
@@ -52,6 +63,177 @@ func (c *Client) GetCurrentRound(ctx context.Context, req *pb.ColorGameGetCurren
 	return gmsClient.GetCurrentRound(ctx, req)
 }
 
+// VerifyRound recomputes the provably fair result of a historical round in GMS
+func (c *Client) VerifyRound(ctx context.Context, req *pb.ColorGameVerifyRoundReq) (*pb.ColorGameVerifyRoundRsp, error) {
+	conn, err := c.BaseClient.GetConn("gms-service")
+	if err != nil {
+		return nil, err
+	}
+
+	gmsClient := pb.NewColorGameGMSServiceClient(conn)
+	return gmsClient.VerifyRound(ctx, req)
+}
+
+// GetRecentEvents fetches the buffered round state events of a table from GMS to resync after a gap
+func (c *Client) GetRecentEvents(ctx context.Context, req *pb.ColorGameGetRecentEventsReq) (*pb.ColorGameGetRecentEventsRsp, error) {
+	conn, err := c.BaseClient.GetConn("gms-service")
+	if err != nil {
+		return nil, err
+	}
+
+	gmsClient := pb.NewColorGameGMSServiceClient(conn)
+	return gmsClient.GetRecentEvents(ctx, req)
+}
+
+// GetRoundHistory fetches the results roadmap of a table from GMS.
+// The roadmap is served by GMS, gateways reach it directly through the GS interface as well.
+func (c *Client) GetRoundHistory(ctx context.Context, req *pb.ColorGameGetRoundHistoryReq) (*pb.ColorGameGetRoundHistoryRsp, error) {
+	conn, err := c.BaseClient.GetConn("gms-service")
+	if err != nil {
+		return nil, err
+	}
+
+	gmsClient := pb.NewColorGameGMSServiceClient(conn)
+	return gmsClient.GetRoundHistory(ctx, req)
+}
+
+// --- GMS Admin Service Implementation ---
+// Any GMS replica accepts the round controls, a follower forwards them to the leader running the rounds.
+
+// SubmitResult submits the result of a drawing round (manual / live dealer tables)
+func (c *Client) SubmitResult(ctx context.Context, req *pb.ColorGameSubmitResultReq) (*pb.ColorGameSubmitResultRsp, error) {
+	conn, err := c.BaseClient.GetConn("gms-service")
+	if err != nil {
+		return nil, err
+	}
+
+	adminClient := pb.NewColorGameGMSAdminServiceClient(conn)
+	return adminClient.SubmitResult(ctx, req)
+}
+
+// PauseTable holds a table after its current round
+func (c *Client) PauseTable(ctx context.Context, req *pb.ColorGamePauseTableReq) (*pb.ColorGamePauseTableRsp, error) {
+	conn, err := c.BaseClient.GetConn("gms-service")
+	if err != nil {
+		return nil, err
+	}
+
+	adminClient := pb.NewColorGameGMSAdminServiceClient(conn)
+	return adminClient.PauseTable(ctx, req)
+}
+
+// ResumeTable starts rounds again on a paused table
+func (c *Client) ResumeTable(ctx context.Context, req *pb.ColorGameResumeTableReq) (*pb.ColorGameResumeTableRsp, error) {
+	conn, err := c.BaseClient.GetConn("gms-service")
+	if err != nil {
+		return nil, err
+	}
+
+	adminClient := pb.NewColorGameGMSAdminServiceClient(conn)
+	return adminClient.ResumeTable(ctx, req)
+}
+
+// AdjustBetting extends or shortens the betting phase of the current round
+func (c *Client) AdjustBetting(ctx context.Context, req *pb.ColorGameAdjustBettingReq) (*pb.ColorGameAdjustBettingRsp, error) {
+	conn, err := c.BaseClient.GetConn("gms-service")
+	if err != nil {
+		return nil, err
+	}
+
+	adminClient := pb.NewColorGameGMSAdminServiceClient(conn)
+	return adminClient.AdjustBetting(ctx, req)
+}
+
+// VoidCurrentRound voids the current round, every bet of the round is refunded
+func (c *Client) VoidCurrentRound(ctx context.Context, req *pb.ColorGameVoidCurrentRoundReq) (*pb.ColorGameVoidCurrentRoundRsp, error) {
+	conn, err := c.BaseClient.GetConn("gms-service")
+	if err != nil {
+		return nil, err
+	}
+
+	adminClient := pb.NewColorGameGMSAdminServiceClient(conn)
+	return adminClient.VoidCurrentRound(ctx, req)
+}
+
+// GetRound returns a persisted round by ID (support lookup)
+func (c *Client) GetRound(ctx context.Context, req *pb.ColorGameGetRoundReq) (*pb.ColorGameGetRoundRsp, error) {
+	conn, err := c.BaseClient.GetConn("gms-service")
+	if err != nil {
+		return nil, err
+	}
+
+	adminClient := pb.NewColorGameGMSAdminServiceClient(conn)
+	return adminClient.GetRound(ctx, req)
+}
+
+// ListRounds lists the rounds started in a time range, newest first
+func (c *Client) ListRounds(ctx context.Context, req *pb.ColorGameListRoundsReq) (*pb.ColorGameListRoundsRsp, error) {
+	conn, err := c.BaseClient.GetConn("gms-service")
+	if err != nil {
+		return nil, err
+	}
+
+	adminClient := pb.NewColorGameGMSAdminServiceClient(conn)
+	return adminClient.ListRounds(ctx, req)
+}
+
+// --- GS Admin Service Implementation ---
+
+// ListCompensations lists the bet intents of the PlaceBet saga in a status (stuck wallet rollbacks by default)
+func (c *Client) ListCompensations(ctx context.Context, req *pb.ColorGameListCompensationsReq) (*pb.ColorGameListCompensationsRsp, error) {
+	conn, err := c.BaseClient.GetConn("gs-service")
+	if err != nil {
+		return nil, err
+	}
+
+	adminClient := pb.NewColorGameGSAdminServiceClient(conn)
+	return adminClient.ListCompensations(ctx, req)
+}
+
+// RetryCompensation rolls back the stake of a bet intent immediately
+func (c *Client) RetryCompensation(ctx context.Context, req *pb.ColorGameRetryCompensationReq) (*pb.ColorGameRetryCompensationRsp, error) {
+	conn, err := c.BaseClient.GetConn("gs-service")
+	if err != nil {
+		return nil, err
+	}
+
+	adminClient := pb.NewColorGameGSAdminServiceClient(conn)
+	return adminClient.RetryCompensation(ctx, req)
+}
+
+// GetSettlement returns the settlement progress of a round
+func (c *Client) GetSettlement(ctx context.Context, req *pb.ColorGameGetSettlementReq) (*pb.ColorGameGetSettlementRsp, error) {
+	conn, err := c.BaseClient.GetConn("gs-service")
+	if err != nil {
+		return nil, err
+	}
+
+	adminClient := pb.NewColorGameGSAdminServiceClient(conn)
+	return adminClient.GetSettlement(ctx, req)
+}
+
+// ListSettlements lists the round settlements in a status (unfinished ones by default)
+func (c *Client) ListSettlements(ctx context.Context, req *pb.ColorGameListSettlementsReq) (*pb.ColorGameListSettlementsRsp, error) {
+	conn, err := c.BaseClient.GetConn("gs-service")
+	if err != nil {
+		return nil, err
+	}
+
+	adminClient := pb.NewColorGameGSAdminServiceClient(conn)
+	return adminClient.ListSettlements(ctx, req)
+}
+
+// SearchBetHistory searches the bet orders of every player by round, game and date range
+func (c *Client) SearchBetHistory(ctx context.Context, req *pb.ColorGameGetBetHistoryReq) (*pb.ColorGameGetBetHistoryRsp, error) {
+	conn, err := c.BaseClient.GetConn("gs-service")
+	if err != nil {
+		return nil, err
+	}
+
+	adminClient := pb.NewColorGameGSAdminServiceClient(conn)
+	return adminClient.SearchBetHistory(ctx, req)
+}
+
 // --- GS Service Implementation ---
 
 // PlaceBet handles placing a bet through GS
@@ -67,6 +249,72 @@ func (c *Client) PlaceBet(ctx context.Context, req *pb.ColorGamePlaceBetReq) (*p
 	return gsClient.PlaceBet(ctx, req)
 }
 
+// CancelBet cancels or reduces a bet through GS
+func (c *Client) CancelBet(ctx context.Context, req *pb.ColorGameCancelBetReq) (*pb.ColorGameCancelBetRsp, error) {
+	conn, err := c.BaseClient.GetConn("gs-service")
+	if err != nil {
+		return nil, err
+	}
+
+	gsClient := pb.NewColorGameGSServiceClient(conn)
+	return gsClient.CancelBet(ctx, req)
+}
+
+// PlaceBetSlip places a multi-color bet slip through GS
+func (c *Client) PlaceBetSlip(ctx context.Context, req *pb.ColorGamePlaceBetSlipReq) (*pb.ColorGamePlaceBetSlipRsp, error) {
+	conn, err := c.BaseClient.GetConn("gs-service")
+	if err != nil {
+		return nil, err
+	}
+
+	gsClient := pb.NewColorGameGSServiceClient(conn)
+	return gsClient.PlaceBetSlip(ctx, req)
+}
+
+// Rebet places the last settled bet slip again through GS
+func (c *Client) Rebet(ctx context.Context, req *pb.ColorGameRebetReq) (*pb.ColorGameRebetRsp, error) {
+	conn, err := c.BaseClient.GetConn("gs-service")
+	if err != nil {
+		return nil, err
+	}
+
+	gsClient := pb.NewColorGameGSServiceClient(conn)
+	return gsClient.Rebet(ctx, req)
+}
+
+// StartAutoBet opts the player in to auto bet through GS
+func (c *Client) StartAutoBet(ctx context.Context, req *pb.ColorGameStartAutoBetReq) (*pb.ColorGameStartAutoBetRsp, error) {
+	conn, err := c.BaseClient.GetConn("gs-service")
+	if err != nil {
+		return nil, err
+	}
+
+	gsClient := pb.NewColorGameGSServiceClient(conn)
+	return gsClient.StartAutoBet(ctx, req)
+}
+
+// StopAutoBet stops the auto bet of the player through GS
+func (c *Client) StopAutoBet(ctx context.Context, req *pb.ColorGameStopAutoBetReq) (*pb.ColorGameStopAutoBetRsp, error) {
+	conn, err := c.BaseClient.GetConn("gs-service")
+	if err != nil {
+		return nil, err
+	}
+
+	gsClient := pb.NewColorGameGSServiceClient(conn)
+	return gsClient.StopAutoBet(ctx, req)
+}
+
+// GetBetHistory returns the bet orders of the player through GS
+func (c *Client) GetBetHistory(ctx context.Context, req *pb.ColorGameGetBetHistoryReq) (*pb.ColorGameGetBetHistoryRsp, error) {
+	conn, err := c.BaseClient.GetConn("gs-service")
+	if err != nil {
+		return nil, err
+	}
+
+	gsClient := pb.NewColorGameGSServiceClient(conn)
+	return gsClient.GetBetHistory(ctx, req)
+}
+
 // GetState returns the current game state from GS
 func (c *Client) GetState(ctx context.Context, req *pb.ColorGameGetStateReq) (*pb.ColorGameGetStateRsp, error) {
 	conn, err := c.BaseClient.GetConn("gs-service")
@@ -88,3 +336,25 @@ func (c *Client) RoundResult(ctx context.Context, req *pb.ColorGameRoundResultRe
 	gsClient := pb.NewColorGameGSServiceClient(conn)
 	return gsClient.RoundResult(ctx, req)
 }
+
+// VoidRound handles round void notification (GS endpoint)
+func (c *Client) VoidRound(ctx context.Context, req *pb.ColorGameVoidRoundReq) (*pb.ColorGameVoidRoundRsp, error) {
+	conn, err := c.BaseClient.GetConn("gs-service")
+	if err != nil {
+		return nil, err
+	}
+
+	gsClient := pb.NewColorGameGSServiceClient(conn)
+	return gsClient.VoidRound(ctx, req)
+}
+
+// CloseBetting handles the end of the betting phase of a round (GS endpoint)
+func (c *Client) CloseBetting(ctx context.Context, req *pb.ColorGameCloseBettingReq) (*pb.ColorGameCloseBettingRsp, error) {
+	conn, err := c.BaseClient.GetConn("gs-service")
+	if err != nil {
+		return nil, err
+	}
+
+	gsClient := pb.NewColorGameGSServiceClient(conn)
+	return gsClient.CloseBetting(ctx, req)
+}
@@ -11,6 +11,18 @@ type GMSService interface {
 	// RecordBet records a bet in GMS
 	RecordBet(ctx context.Context, req *pbColorGame.ColorGameRecordBetReq) (*pbColorGame.ColorGameRecordBetRsp, error)
 
+	// RevokeBet takes a cancelled stake back from the round stats, rejected once betting closed (unless it restores one)
+	RevokeBet(ctx context.Context, req *pbColorGame.ColorGameRevokeBetReq) (*pbColorGame.ColorGameRevokeBetRsp, error)
+
 	// GetCurrentRound gets the current round from GMS
 	GetCurrentRound(ctx context.Context, req *pbColorGame.ColorGameGetCurrentRoundReq) (*pbColorGame.ColorGameGetCurrentRoundRsp, error)
+
+	// VerifyRound recomputes the provably fair result of a historical round
+	VerifyRound(ctx context.Context, req *pbColorGame.ColorGameVerifyRoundReq) (*pbColorGame.ColorGameVerifyRoundRsp, error)
+
+	// GetRecentEvents returns the buffered round state events after (round_id, after_seq) for resynchronisation
+	GetRecentEvents(ctx context.Context, req *pbColorGame.ColorGameGetRecentEventsReq) (*pbColorGame.ColorGameGetRecentEventsRsp, error)
+
+	// GetRoundHistory returns the results roadmap of a table: its last finished rounds and color frequencies
+	GetRoundHistory(ctx context.Context, req *pbColorGame.ColorGameGetRoundHistoryReq) (*pbColorGame.ColorGameGetRoundHistoryRsp, error)
 }
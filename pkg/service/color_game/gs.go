@@ -11,9 +11,36 @@ type ColorGameGSService interface {
 	// PlaceBet handles placing a bet
 	PlaceBet(ctx context.Context, req *pbColorGame.ColorGamePlaceBetReq) (*pbColorGame.ColorGamePlaceBetRsp, error)
 
+	// CancelBet cancels or reduces a bet while the round is betting and refunds the stake
+	CancelBet(ctx context.Context, req *pbColorGame.ColorGameCancelBetReq) (*pbColorGame.ColorGameCancelBetRsp, error)
+
+	// PlaceBetSlip places bets on several colors at once, every line is placed or none
+	PlaceBetSlip(ctx context.Context, req *pbColorGame.ColorGamePlaceBetSlipReq) (*pbColorGame.ColorGamePlaceBetSlipRsp, error)
+
+	// Rebet places the last settled bet slip of the player again (multiplier 2 doubles it)
+	Rebet(ctx context.Context, req *pbColorGame.ColorGameRebetReq) (*pbColorGame.ColorGameRebetRsp, error)
+
+	// StartAutoBet repeats the last settled bet slip in the next rounds until a round count or threshold is reached
+	StartAutoBet(ctx context.Context, req *pbColorGame.ColorGameStartAutoBetReq) (*pbColorGame.ColorGameStartAutoBetRsp, error)
+
+	// StopAutoBet stops the auto bet of the player
+	StopAutoBet(ctx context.Context, req *pbColorGame.ColorGameStopAutoBetReq) (*pbColorGame.ColorGameStopAutoBetRsp, error)
+
+	// GetBetHistory returns the bet orders of the player newest first, with the result and payout of each
+	GetBetHistory(ctx context.Context, req *pbColorGame.ColorGameGetBetHistoryReq) (*pbColorGame.ColorGameGetBetHistoryRsp, error)
+
+	// GetRoundHistory returns the results roadmap of a table, read from GMS
+	GetRoundHistory(ctx context.Context, req *pbColorGame.ColorGameGetRoundHistoryReq) (*pbColorGame.ColorGameGetRoundHistoryRsp, error)
+
 	// GetState returns the current game state
 	GetState(ctx context.Context, req *pbColorGame.ColorGameGetStateReq) (*pbColorGame.ColorGameGetStateRsp, error)
 
 	// RoundResult handles round result notification from GMS
 	RoundResult(ctx context.Context, req *pbColorGame.ColorGameRoundResultReq) (*pbColorGame.ColorGameRoundResultRsp, error)
+
+	// VoidRound handles round void notification from GMS (refund all bets)
+	VoidRound(ctx context.Context, req *pbColorGame.ColorGameVoidRoundReq) (*pbColorGame.ColorGameVoidRoundRsp, error)
+
+	// CloseBetting handles the end of the betting phase of a round from GMS, bets of the round can no longer be cancelled
+	CloseBetting(ctx context.Context, req *pbColorGame.ColorGameCloseBettingReq) (*pbColorGame.ColorGameCloseBettingRsp, error)
 }
@@ -0,0 +1,425 @@
+package color_game
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+)
+
+// Draw modes of the game
+const (
+	// DrawModeSingle draws one color per round, a bet on it pays the payout of its paytable entry
+	DrawModeSingle = "single"
+	// DrawModeThreeDice rolls three colored dice per round, a bet pays according to how many dice match it
+	DrawModeThreeDice = "three_dice"
+)
+
+// DefaultPaytableSpec is the classic four color table: uniform draw, 2x payout (RTP 50%)
+const DefaultPaytableSpec = "RED:1:2,GREEN:1:2,BLUE:1:2,YELLOW:1:2"
+
+// DefaultDicePaytableSpec is the traditional three-dice table: six colors on every die
+const DefaultDicePaytableSpec = "RED:1,GREEN:1,BLUE:1,YELLOW:1,WHITE:1,PINK:1"
+
+// DefaultDicePayoutsSpec pays 1:1, 2:1 and 3:1 for one, two and three matching dice (total return, stake included)
+const DefaultDicePayoutsSpec = "2,3,4"
+
+// PaytableEntry is one color of the paytable
+type PaytableEntry struct {
+	Color  pbColorGame.ColorGameReward
+	Weight int     // Relative draw weight, the color is drawn (on every die) with probability Weight / TotalWeight
+	Payout float64 // Single mode: total return per unit staked on a winning bet (stake included), at most 2 decimals
+}
+
+// Paytable defines the colors of the game, how often each one is drawn and what a winning bet pays.
+// GMS draws with the weights and GS settles with the payouts, both must load the same table.
+// The entry order is part of the provably fair algorithm (see Pick).
+type Paytable struct {
+	Entries []PaytableEntry
+
+	// Dice is the number of dice rolled per round, 0 or 1 is the single color draw
+	Dice int
+	// MatchPayouts is the total return per unit staked when 1, 2, ... Dice dice match the bet (dice mode only)
+	MatchPayouts []float64
+}
+
+// DefaultPaytable returns the table used when none is configured
+func DefaultPaytable() *Paytable {
+	paytable, _ := ParsePaytable(DefaultPaytableSpec)
+	return paytable
+}
+
+// NewPaytable builds the paytable of a draw mode, empty specs select the defaults of the mode
+func NewPaytable(mode string, spec string, dicePayouts string) (*Paytable, error) {
+	switch mode {
+	case "", DrawModeSingle:
+		if spec == "" {
+			spec = DefaultPaytableSpec
+		}
+		return ParsePaytable(spec)
+	case DrawModeThreeDice:
+		if spec == "" {
+			spec = DefaultDicePaytableSpec
+		}
+		if dicePayouts == "" {
+			dicePayouts = DefaultDicePayoutsSpec
+		}
+		return ParseDicePaytable(spec, dicePayouts)
+	default:
+		return nil, fmt.Errorf("unknown draw mode: %s", mode)
+	}
+}
+
+// ParsePaytable parses and validates a single draw table: a comma separated list of color:weight:payout entries,
+// e.g. "RED:3:2,GREEN:3:2,BLUE:3:2,YELLOW:1:5.5". Colors are enum names with or without the REWARD_ prefix.
+func ParsePaytable(spec string) (*Paytable, error) {
+	entries, err := parseEntries(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	paytable := &Paytable{Entries: entries}
+	if err := paytable.Validate(); err != nil {
+		return nil, err
+	}
+	return paytable, nil
+}
+
+// ParseDicePaytable parses and validates a three-dice table: the colors of a die as color:weight entries
+// and the total return for 1, 2 and 3 matching dice, e.g. ("RED:1,GREEN:1,BLUE:1,YELLOW:1,WHITE:1,PINK:1", "2,3,4")
+func ParseDicePaytable(spec string, payouts string) (*Paytable, error) {
+	entries, err := parseEntries(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	paytable := &Paytable{Entries: entries, Dice: 3}
+	for _, field := range strings.Split(payouts, ",") {
+		payout, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dice payout %q", field)
+		}
+		paytable.MatchPayouts = append(paytable.MatchPayouts, payout)
+	}
+
+	if err := paytable.Validate(); err != nil {
+		return nil, err
+	}
+	return paytable, nil
+}
+
+// parseEntries parses color:weight[:payout] entries
+func parseEntries(spec string) ([]PaytableEntry, error) {
+	var entries []PaytableEntry
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		fields := strings.Split(entry, ":")
+		if len(fields) != 2 && len(fields) != 3 {
+			return nil, fmt.Errorf("invalid paytable entry %q: expected color:weight[:payout]", entry)
+		}
+
+		name := strings.ToUpper(strings.TrimSpace(fields[0]))
+		if !strings.HasPrefix(name, "REWARD_") {
+			name = "REWARD_" + name
+		}
+		color, ok := pbColorGame.ColorGameReward_value[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid paytable entry %q: unknown color", entry)
+		}
+		weight, err := strconv.Atoi(strings.TrimSpace(fields[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid paytable entry %q: bad weight", entry)
+		}
+		var payout float64
+		if len(fields) == 3 {
+			if payout, err = strconv.ParseFloat(strings.TrimSpace(fields[2]), 64); err != nil {
+				return nil, fmt.Errorf("invalid paytable entry %q: bad payout", entry)
+			}
+		}
+
+		entries = append(entries, PaytableEntry{
+			Color:  pbColorGame.ColorGameReward(color),
+			Weight: weight,
+			Payout: payout,
+		})
+	}
+	return entries, nil
+}
+
+// Validate checks that the table can be drawn and settled: at least two distinct colors, positive weights
+// and payouts of at least 1x with at most 2 decimals (per color in single mode, per match count in dice mode)
+func (p *Paytable) Validate() error {
+	if len(p.Entries) < 2 {
+		return fmt.Errorf("paytable needs at least 2 colors, got %d", len(p.Entries))
+	}
+	if p.IsDice() && len(p.MatchPayouts) != p.Dice {
+		return fmt.Errorf("paytable with %d dice needs %d match payouts, got %d", p.Dice, p.Dice, len(p.MatchPayouts))
+	}
+	for i, payout := range p.MatchPayouts {
+		if err := validatePayout(payout); err != nil {
+			return fmt.Errorf("paytable payout for %d matching dice %w", i+1, err)
+		}
+	}
+
+	seen := make(map[pbColorGame.ColorGameReward]bool)
+	for _, entry := range p.Entries {
+		if entry.Color == pbColorGame.ColorGameReward_REWARD_UNSPECIFIED {
+			return fmt.Errorf("paytable color %s is not allowed", entry.Color)
+		}
+		if _, ok := pbColorGame.ColorGameReward_name[int32(entry.Color)]; !ok {
+			return fmt.Errorf("paytable color %d is unknown", entry.Color)
+		}
+		if seen[entry.Color] {
+			return fmt.Errorf("paytable color %s is listed twice", entry.Color)
+		}
+		seen[entry.Color] = true
+
+		if entry.Weight <= 0 {
+			return fmt.Errorf("paytable weight of %s must be positive, got %d", entry.Color, entry.Weight)
+		}
+		if !p.IsDice() {
+			if err := validatePayout(entry.Payout); err != nil {
+				return fmt.Errorf("paytable payout of %s %w", entry.Color, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validatePayout(payout float64) error {
+	if payout < 1 {
+		return fmt.Errorf("must be at least 1, got %v", payout)
+	}
+	if math.Abs(payout*100-math.Round(payout*100)) > 1e-6 {
+		return fmt.Errorf("has more than 2 decimals: %v", payout)
+	}
+	return nil
+}
+
+// IsDice reports whether a round rolls several dice
+func (p *Paytable) IsDice() bool {
+	return p.Dice > 1
+}
+
+// DiceCount is the number of colors drawn per round (1 in single mode)
+func (p *Paytable) DiceCount() int {
+	if p.IsDice() {
+		return p.Dice
+	}
+	return 1
+}
+
+// TotalWeight is the sum of all weights
+func (p *Paytable) TotalWeight() int {
+	total := 0
+	for _, entry := range p.Entries {
+		total += entry.Weight
+	}
+	return total
+}
+
+// Contains reports whether bets on color are accepted
+func (p *Paytable) Contains(color pbColorGame.ColorGameReward) bool {
+	_, ok := p.entry(color)
+	return ok
+}
+
+// ValidDice checks a drawn or submitted outcome: DiceCount colors of the table
+func (p *Paytable) ValidDice(dice []pbColorGame.ColorGameReward) error {
+	if len(dice) != p.DiceCount() {
+		return fmt.Errorf("expected %d dice, got %d", p.DiceCount(), len(dice))
+	}
+	for _, color := range dice {
+		if !p.Contains(color) {
+			return fmt.Errorf("invalid result: %s", color)
+		}
+	}
+	return nil
+}
+
+// Pick maps n in [0, TotalWeight) to a color: the entries own consecutive ranges of the size of their weight, in table order
+func (p *Paytable) Pick(n int) pbColorGame.ColorGameReward {
+	for _, entry := range p.Entries {
+		if n < entry.Weight {
+			return entry.Color
+		}
+		n -= entry.Weight
+	}
+	return p.Entries[len(p.Entries)-1].Color
+}
+
+// Matches counts the dice showing the bet color
+func Matches(betColor pbColorGame.ColorGameReward, dice []pbColorGame.ColorGameReward) int {
+	matches := 0
+	for _, color := range dice {
+		if color == betColor {
+			matches++
+		}
+	}
+	return matches
+}
+
+// WinAmount returns what a bet pays out for the drawn dice, 0 when no die matches.
+// Single mode pays the payout of the color, dice mode the payout of the match count.
+// Fractional payouts are computed in hundredths and rounded down to whole units.
+func (p *Paytable) WinAmount(betColor pbColorGame.ColorGameReward, amount int64, dice []pbColorGame.ColorGameReward) int64 {
+	payout := p.payout(betColor, Matches(betColor, dice))
+	return amount * int64(math.Round(payout*100)) / 100
+}
+
+// MaxWinAmount returns the most a bet can pay out (every die showing its color), the liability of the house for the bet
+func (p *Paytable) MaxWinAmount(betColor pbColorGame.ColorGameReward, amount int64) int64 {
+	payout := p.payout(betColor, p.DiceCount())
+	return amount * int64(math.Round(payout*100)) / 100
+}
+
+// payout is the total return per unit staked on color when matches dice show it
+func (p *Paytable) payout(color pbColorGame.ColorGameReward, matches int) float64 {
+	entry, ok := p.entry(color)
+	if !ok || matches == 0 {
+		return 0
+	}
+	if !p.IsDice() {
+		return entry.Payout
+	}
+	if matches > len(p.MatchPayouts) {
+		matches = len(p.MatchPayouts)
+	}
+	return p.MatchPayouts[matches-1]
+}
+
+// String formats the table in the syntax accepted by ParsePaytable (ParseDicePaytable in dice mode)
+func (p *Paytable) String() string {
+	entries := make([]string, 0, len(p.Entries))
+	for _, entry := range p.Entries {
+		name := strings.TrimPrefix(entry.Color.String(), "REWARD_")
+		if p.IsDice() {
+			entries = append(entries, fmt.Sprintf("%s:%d", name, entry.Weight))
+			continue
+		}
+		entries = append(entries, fmt.Sprintf("%s:%d:%s", name, entry.Weight, strconv.FormatFloat(entry.Payout, 'f', -1, 64)))
+	}
+	if !p.IsDice() {
+		return strings.Join(entries, ",")
+	}
+
+	payouts := make([]string, 0, len(p.MatchPayouts))
+	for _, payout := range p.MatchPayouts {
+		payouts = append(payouts, strconv.FormatFloat(payout, 'f', -1, 64))
+	}
+	return fmt.Sprintf("%s (%d dice, payouts %s)", strings.Join(entries, ","), p.Dice, strings.Join(payouts, ","))
+}
+
+func (p *Paytable) entry(color pbColorGame.ColorGameReward) (PaytableEntry, bool) {
+	for _, entry := range p.Entries {
+		if entry.Color == color {
+			return entry, true
+		}
+	}
+	return PaytableEntry{}, false
+}
+
+// ColorRTP is the theoretical return of a unit bet on one color
+type ColorRTP struct {
+	Color       pbColorGame.ColorGameReward
+	Probability float64 // Chance that a bet on the color wins (at least one die shows it)
+	Payout      float64 // Single mode payout, 0 in dice mode (see Paytable.MatchPayouts)
+	RTP         float64 // Expected return per unit staked
+	HouseEdge   float64 // 1 - RTP
+	Volatility  float64 // Standard deviation of the return per unit staked
+}
+
+// RTPReport is the theoretical return of the whole paytable
+type RTPReport struct {
+	Colors []ColorRTP
+	// RTP and Volatility of a unit stake spread evenly over every color (what the house keeps on average)
+	RTP        float64
+	HouseEdge  float64
+	Volatility float64
+	MinRTP     float64
+	MaxRTP     float64
+}
+
+// RTP computes the theoretical return to player and volatility of the table by enumerating every outcome
+// of the dice (one die in single mode). For a unit bet returning X:
+//
+//	RTP = E[X], Volatility = sqrt(E[X²] - RTP²)
+//
+// In single mode this is RTP = p × Payout. With three fair six color dice and 2 / 3 / 4 payouts it is 199/216.
+func (p *Paytable) RTP() *RTPReport {
+	total := float64(p.TotalWeight())
+	n := len(p.Entries)
+	diceCount := p.DiceCount()
+
+	win := make([]float64, n)    // P(bet on color wins)
+	mean := make([]float64, n)   // E[X] per color
+	square := make([]float64, n) // E[X²] per color
+	var spreadMean, spreadSquare float64
+
+	// Walk every combination of entry indices, one per die
+	dice := make([]int, diceCount)
+	for {
+		probability := 1.0
+		for _, i := range dice {
+			probability *= float64(p.Entries[i].Weight) / total
+		}
+
+		spread := 0.0
+		for c, entry := range p.Entries {
+			matches := 0
+			for _, i := range dice {
+				if i == c {
+					matches++
+				}
+			}
+			payout := p.payout(entry.Color, matches)
+			if matches > 0 {
+				win[c] += probability
+			}
+			mean[c] += probability * payout
+			square[c] += probability * payout * payout
+			spread += payout / float64(n)
+		}
+		spreadMean += probability * spread
+		spreadSquare += probability * spread * spread
+
+		// Next combination (odometer)
+		d := 0
+		for ; d < diceCount; d++ {
+			dice[d]++
+			if dice[d] < n {
+				break
+			}
+			dice[d] = 0
+		}
+		if d == diceCount {
+			break
+		}
+	}
+
+	report := &RTPReport{MinRTP: math.Inf(1), MaxRTP: math.Inf(-1)}
+	for c, entry := range p.Entries {
+		color := ColorRTP{
+			Color:       entry.Color,
+			Probability: win[c],
+			RTP:         mean[c],
+			HouseEdge:   1 - mean[c],
+			Volatility:  math.Sqrt(math.Max(square[c]-mean[c]*mean[c], 0)),
+		}
+		if !p.IsDice() {
+			color.Payout = entry.Payout
+		}
+		report.Colors = append(report.Colors, color)
+		report.MinRTP = math.Min(report.MinRTP, mean[c])
+		report.MaxRTP = math.Max(report.MaxRTP, mean[c])
+	}
+	report.RTP = spreadMean
+	report.HouseEdge = 1 - spreadMean
+	report.Volatility = math.Sqrt(math.Max(spreadSquare-spreadMean*spreadMean, 0))
+	return report
+}
@@ -0,0 +1,76 @@
+package color_game
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GameCode identifies the color game in round IDs, broadcasts and bet orders
+const GameCode = "color_game"
+
+// MaxTableIDLength keeps round IDs within the varchar(64) round_id columns
+const MaxTableIDLength = 24
+
+// roundIDTimeLayout is a sortable UTC timestamp with millisecond precision (e.g. 20251205123456789)
+const roundIDTimeLayout = "20060102150405.000"
+
+var tableIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// ValidTableID reports whether a table ID can be embedded in round IDs and Redis keys
+func ValidTableID(tableID string) bool {
+	return len(tableID) <= MaxTableIDLength && tableIDPattern.MatchString(tableID)
+}
+
+// RoundIDInfo is the content of a round ID
+type RoundIDInfo struct {
+	GameCode string    `json:"game_code"`
+	TableID  string    `json:"table_id"`
+	Time     time.Time `json:"time"`
+	Seq      int64     `json:"seq"`
+}
+
+// NewRoundID builds a round ID: <game code>-<table>-<UTC yyyyMMddHHmmssSSS>-<seq>.
+// seq is the monotonic round counter of the table, so IDs of a table sort by time and never repeat
+// even if two rounds start within the same millisecond.
+func NewRoundID(gameCode string, tableID string, t time.Time, seq int64) string {
+	timestamp := strings.Replace(t.UTC().Format(roundIDTimeLayout), ".", "", 1)
+	return fmt.Sprintf("%s-%s-%s-%06d", gameCode, tableID, timestamp, seq)
+}
+
+// ParseRoundID extracts game code, table, start time and sequence from a round ID created by NewRoundID
+func ParseRoundID(roundID string) (*RoundIDInfo, error) {
+	// The game code never contains '-', the table may: split the fixed fields from both ends
+	first := strings.Index(roundID, "-")
+	last := strings.LastIndex(roundID, "-")
+	if first <= 0 || last <= first {
+		return nil, fmt.Errorf("invalid round id %q", roundID)
+	}
+	secondLast := strings.LastIndex(roundID[:last], "-")
+	if secondLast <= first+1 {
+		return nil, fmt.Errorf("invalid round id %q", roundID)
+	}
+
+	timestamp := roundID[secondLast+1 : last]
+	if len(timestamp) != len(roundIDTimeLayout)-1 {
+		return nil, fmt.Errorf("invalid round id %q: bad timestamp", roundID)
+	}
+	t, err := time.Parse(roundIDTimeLayout, timestamp[:14]+"."+timestamp[14:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid round id %q: %w", roundID, err)
+	}
+
+	seq, err := strconv.ParseInt(roundID[last+1:], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid round id %q: bad sequence", roundID)
+	}
+
+	return &RoundIDInfo{
+		GameCode: roundID[:first],
+		TableID:  roundID[first+1 : secondLast],
+		Time:     t,
+		Seq:      seq,
+	}, nil
+}
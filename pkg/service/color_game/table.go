@@ -0,0 +1,4 @@
+package color_game
+
+// DefaultTableID is the table used when a request does not name one
+const DefaultTableID = "default"
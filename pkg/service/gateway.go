@@ -10,6 +10,8 @@ import (
 type GatewayService interface {
 	// Broadcast sends a message to all connected players for a specific game
 	Broadcast(ctx context.Context, gameCode string, event proto.Message)
+	// BroadcastToTable sends a message to the players that joined a table of a specific game
+	BroadcastToTable(ctx context.Context, gameCode string, tableID string, event proto.Message)
 	// SendToUser sends a message to a specific user
 	SendToUser(ctx context.Context, userID int64, gameCode string, event proto.Message)
 }
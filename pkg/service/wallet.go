@@ -1,12 +1,26 @@
 package service
 
-import "context"
+import (
+	"context"
+	"errors"
+)
+
+// ErrWalletRejected wraps a transaction the wallet refused (e.g. insufficient balance), nothing was applied
+var ErrWalletRejected = errors.New("wallet rejected the transaction")
+
+// ErrTransactionNotFound is returned by Rollback when the original transaction was never applied
+var ErrTransactionNotFound = errors.New("transaction not found")
 
 // WalletService defines the interface for wallet-related operations
 type WalletService interface {
 	GetBalance(ctx context.Context, userID int64) (int64, error)
 	DeductBalance(ctx context.Context, userID int64, amount int64, reason string) (int64, error)
 	AddBalance(ctx context.Context, userID int64, amount int64, reason string) (int64, error)
-	PlaceBet(ctx context.Context, userID int64, amount int64, roundID string) (int64, error)
+	// PlaceBet deducts a stake, txID identifies the transaction (idempotency key, and the key to roll it back)
+	PlaceBet(ctx context.Context, userID int64, amount int64, roundID string, txID string) (int64, error)
+	// SettleWin credits the winnings of a round, txID is the idempotency key (a retried payout is credited once)
+	SettleWin(ctx context.Context, userID int64, amount int64, roundID string, txID string) (int64, error)
+	// Rollback reverses the transaction originalTxID (e.g. refunds the stake of a voided round), repeated calls are no-ops
+	Rollback(ctx context.Context, originalTxID string, reason string) (int64, error)
 }
 
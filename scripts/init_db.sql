@@ -40,22 +40,34 @@ CREATE INDEX IF NOT EXISTS idx_sessions_expires_at ON sessions(expires_at);
 -- Game round history and statistics for Color Game
 -- Records each round from start to completion with betting statistics and results
 CREATE TABLE IF NOT EXISTS game_rounds (
-    round_id VARCHAR(64) PRIMARY KEY,                                 -- Unique round identifier (format: YYYYMMDDHHMMSS)
+    round_id VARCHAR(64) PRIMARY KEY,                                 -- Unique round identifier (format: <game>-<table>-<UTC yyyyMMddHHmmssSSS>-<seq>)
     game_code VARCHAR(32) NOT NULL,                                   -- Game type identifier (e.g., "color_game")
-    status INTEGER NOT NULL DEFAULT 0,                                -- Round status: 0=in_progress (betting/drawing), 1=ended (result announced)
+    table_id VARCHAR(32) NOT NULL DEFAULT 'default',                  -- Table hosting the round (each table runs its own rounds)
+    status INTEGER NOT NULL DEFAULT 0,                                -- Round status: 0=in_progress (betting/drawing), 1=ended (result announced), 2=voided (refunded)
     start_time TIMESTAMP NOT NULL,                                    -- Round start timestamp (when round_started event fires)
     end_time TIMESTAMP,                                               -- Round end timestamp (when result event fires)
-    result VARCHAR(512),                                              -- Game result (e.g., "red", "green", "blue", "yellow" for color game, or complex JSON for other games)
+    result VARCHAR(512),                                              -- Game result (e.g., "REWARD_RED" for a single draw, a JSON array of colors for three dice)
     total_bets INTEGER NOT NULL DEFAULT 0,                            -- Total number of bets placed during this round
     total_players INTEGER NOT NULL DEFAULT 0,                         -- Total number of unique players participated in this round
     total_bet_amount DECIMAL(18,2) NOT NULL DEFAULT 0,                -- Total amount wagered in this round (sum of all bets)
+    server_seed_hash VARCHAR(64),                                     -- Provably fair commitment: sha256(server_seed), published at round start
+    server_seed VARCHAR(64),                                          -- Provably fair server seed, revealed when the result is announced
+    client_seed VARCHAR(64),                                          -- Provably fair public seed
     created_at TIMESTAMP NOT NULL,                                    -- Record creation timestamp (application server time)
     updated_at TIMESTAMP NOT NULL                                     -- Record last update timestamp (application server time)
 );
 
+-- Columns added after the first release, CREATE TABLE IF NOT EXISTS leaves an existing table untouched
+ALTER TABLE game_rounds ADD COLUMN IF NOT EXISTS table_id VARCHAR(32) NOT NULL DEFAULT 'default';
+ALTER TABLE game_rounds ADD COLUMN IF NOT EXISTS server_seed_hash VARCHAR(64);
+ALTER TABLE game_rounds ADD COLUMN IF NOT EXISTS server_seed VARCHAR(64);
+ALTER TABLE game_rounds ADD COLUMN IF NOT EXISTS client_seed VARCHAR(64);
+
 CREATE INDEX IF NOT EXISTS idx_game_rounds_game_code ON game_rounds(game_code);
+CREATE INDEX IF NOT EXISTS idx_game_rounds_table_id ON game_rounds(table_id);
 CREATE INDEX IF NOT EXISTS idx_game_rounds_status ON game_rounds(status);
 CREATE INDEX IF NOT EXISTS idx_game_rounds_start_time ON game_rounds(start_time);
+CREATE INDEX IF NOT EXISTS idx_game_rounds_table_id_start_time ON game_rounds(table_id, start_time DESC); -- Roadmap and round history of a table
 
 -- Bet orders table for tracking individual player bets
 -- Records each bet placed by players, stored in memory during betting and persisted to DB during settlement
@@ -67,14 +79,93 @@ CREATE TABLE IF NOT EXISTS bet_orders (
     bet_area VARCHAR(512) NOT NULL,                                  -- Bet area/zone (e.g., "red" for color game, "player" for baccarat, "17" for roulette)
     amount DECIMAL(18,2) NOT NULL,                                    -- Bet amount
     payout DECIMAL(18,2) NOT NULL DEFAULT 0,                          -- Payout amount (0 for lose, amount * odds for win)
-    status INTEGER NOT NULL DEFAULT 0,                                -- Bet status: 0=pending, 1=settled
+    status INTEGER NOT NULL DEFAULT 0,                                -- Bet status: 0=pending (written at placement), 1=settled, 2=refunded, 3=cancelled
+    tx_ids TEXT NOT NULL DEFAULT '',                                  -- Wallet transactions of the stake, comma separated
     created_at TIMESTAMP NOT NULL,                                    -- Bet placement timestamp (application server time)
-    settled_at TIMESTAMP                                              -- Settlement timestamp (application server time)
+    settled_at TIMESTAMP,                                             -- Settlement timestamp (application server time)
+    flagged_at TIMESTAMP                                              -- Set by reconciliation when the order stayed pending too many rounds
 );
 
+-- Columns added after the first release, CREATE TABLE IF NOT EXISTS leaves an existing table untouched
+ALTER TABLE bet_orders ADD COLUMN IF NOT EXISTS tx_ids TEXT NOT NULL DEFAULT '';
+ALTER TABLE bet_orders ADD COLUMN IF NOT EXISTS flagged_at TIMESTAMP;
+
 CREATE INDEX IF NOT EXISTS idx_bet_orders_user_id ON bet_orders(user_id);
 CREATE INDEX IF NOT EXISTS idx_bet_orders_round_id ON bet_orders(round_id);
 CREATE INDEX IF NOT EXISTS idx_bet_orders_game_code ON bet_orders(game_code);
 CREATE INDEX IF NOT EXISTS idx_bet_orders_status ON bet_orders(status);
 CREATE INDEX IF NOT EXISTS idx_bet_orders_created_at ON bet_orders(created_at);
+CREATE INDEX IF NOT EXISTS idx_bet_orders_user_id_created_at ON bet_orders(user_id, created_at DESC, order_id DESC); -- Bet history pages of a player
+CREATE INDEX IF NOT EXISTS idx_bet_orders_user_id_round_id ON bet_orders(user_id, round_id); -- Bets of a player in a round
+
+
+-- Bet intents of the PlaceBet saga (GS)
+-- Recorded before the wallet deducts a stake, deleted once the bet is placed.
+-- A bet that could not be placed is compensated by rolling back its wallet transaction, retried until it succeeds.
+CREATE TABLE IF NOT EXISTS bet_intents (
+    tx_id VARCHAR(64) PRIMARY KEY,                                    -- Wallet transaction of the stake deduction
+    round_id VARCHAR(64) NOT NULL,                                    -- Round the bet was placed on
+    user_id BIGINT NOT NULL,                                          -- Player user ID
+    color INTEGER NOT NULL,                                           -- ColorGameReward enum value
+    amount BIGINT NOT NULL,                                           -- Stake
+    status INTEGER NOT NULL DEFAULT 0,                                -- 0=pending, 1=placed, 2=compensating, 3=compensated
+    attempts INTEGER NOT NULL DEFAULT 0,                              -- Wallet rollback attempts
+    last_error TEXT,                                                  -- Error of the last failed step
+    next_retry_at TIMESTAMP NOT NULL,                                 -- When the compensation worker picks the intent up
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_bet_intents_user_id ON bet_intents(user_id);
+CREATE INDEX IF NOT EXISTS idx_bet_intents_status_next_retry_at ON bet_intents(status, next_retry_at);
+
+
+-- Payout outbox (GS)
+-- Written in the same transaction as the bet orders of a settlement batch, one row per winning bet.
+-- The deposit is retried with backoff until the wallet accepts it, tx_id makes every retry idempotent.
+CREATE TABLE IF NOT EXISTS payouts (
+    tx_id VARCHAR(64) PRIMARY KEY,                                    -- Wallet transaction of the deposit ("payout-" + bet_id)
+    bet_id VARCHAR(64) NOT NULL,                                      -- Settled bet (bet_orders.order_id)
+    table_id VARCHAR(32) NOT NULL,                                    -- Table of the round
+    round_id VARCHAR(64) NOT NULL,                                    -- Settled round
+    user_id BIGINT NOT NULL,                                          -- Player user ID
+    color INTEGER NOT NULL,                                           -- ColorGameReward enum value of the bet
+    bet_amount BIGINT NOT NULL,                                       -- Stake
+    amount BIGINT NOT NULL,                                           -- Winnings to deposit
+    dice VARCHAR(128) NOT NULL,                                       -- Drawn colors, e.g. 'REWARD_RED,REWARD_RED,REWARD_BLUE'
+    status INTEGER NOT NULL DEFAULT 0,                                -- 0=pending, 1=paid
+    attempts INTEGER NOT NULL DEFAULT 0,                              -- Deposit attempts
+    last_error TEXT,                                                  -- Error of the last failed deposit
+    next_retry_at TIMESTAMP NOT NULL,                                 -- When the payout worker picks the payout up
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    paid_at TIMESTAMP                                                 -- When the deposit landed
+);
+
+CREATE INDEX IF NOT EXISTS idx_payouts_round_id ON payouts(round_id);
+CREATE INDEX IF NOT EXISTS idx_payouts_user_id ON payouts(user_id);
+CREATE INDEX IF NOT EXISTS idx_payouts_status_next_retry_at ON payouts(status, next_retry_at);
+
+
+-- Round settlement checkpoints (GS)
+-- A GS claims a round under a lease before settling (or refunding) it and checkpoints after every persisted batch.
+-- A settlement still SETTLING after its lease expired belongs to a crashed GS and is resumed by another instance.
+CREATE TABLE IF NOT EXISTS round_settlements (
+    round_id VARCHAR(64) PRIMARY KEY,                                 -- Settled round
+    table_id VARCHAR(32) NOT NULL,                                    -- Table of the round
+    dice VARCHAR(128) NOT NULL DEFAULT '',                            -- Drawn colors, empty for a voided round
+    void_reason VARCHAR(255) NOT NULL DEFAULT '',                     -- Set when the round is refunded instead of settled
+    status INTEGER NOT NULL DEFAULT 0,                                -- 0=settling, 1=settled
+    owner VARCHAR(128) NOT NULL,                                      -- GS instance holding the lease
+    lease_until TIMESTAMP NOT NULL,                                   -- Lease expiry, extended by every checkpoint
+    batches INTEGER NOT NULL DEFAULT 0,                               -- Persisted batches
+    bet_count INTEGER NOT NULL DEFAULT 0,                             -- Settled (or refunded) bets
+    total_payout BIGINT NOT NULL DEFAULT 0,                           -- Winnings (or refunds) of the settled bets
+    attempts INTEGER NOT NULL DEFAULT 0,                              -- Claims, more than one means the settlement was resumed
+    last_error TEXT,                                                  -- Why the last run stopped
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    finished_at TIMESTAMP                                             -- When every bet was settled
+);
 
+CREATE INDEX IF NOT EXISTS idx_round_settlements_status_lease_until ON round_settlements(status, lease_until);
@@ -31,6 +31,8 @@ const (
 	ColorGameState_GAME_STATE_RESULT        ColorGameState = 4
 	ColorGameState_GAME_STATE_ROUND_ENDED   ColorGameState = 5
 	ColorGameState_GAME_STATE_STOPPED       ColorGameState = 6
+	ColorGameState_GAME_STATE_VOIDED        ColorGameState = 7
+	ColorGameState_GAME_STATE_PAUSED        ColorGameState = 8
 )
 
 // Enum value maps for ColorGameState.
@@ -43,6 +45,8 @@ var (
 		4: "GAME_STATE_RESULT",
 		5: "GAME_STATE_ROUND_ENDED",
 		6: "GAME_STATE_STOPPED",
+		7: "GAME_STATE_VOIDED",
+		8: "GAME_STATE_PAUSED",
 	}
 	ColorGameState_value = map[string]int32{
 		"GAME_STATE_UNSPECIFIED":   0,
@@ -52,6 +56,8 @@ var (
 		"GAME_STATE_RESULT":        4,
 		"GAME_STATE_ROUND_ENDED":   5,
 		"GAME_STATE_STOPPED":       6,
+		"GAME_STATE_VOIDED":        7,
+		"GAME_STATE_PAUSED":        8,
 	}
 )
 
@@ -90,6 +96,9 @@ const (
 	ColorGameReward_REWARD_GREEN       ColorGameReward = 2
 	ColorGameReward_REWARD_BLUE        ColorGameReward = 3
 	ColorGameReward_REWARD_YELLOW      ColorGameReward = 4
+	// Optional colors of the six color variant, only drawn and accepted when listed in the paytable
+	ColorGameReward_REWARD_WHITE ColorGameReward = 5
+	ColorGameReward_REWARD_PINK  ColorGameReward = 6
 )
 
 // Enum value maps for ColorGameReward.
@@ -100,6 +109,8 @@ var (
 		2: "REWARD_GREEN",
 		3: "REWARD_BLUE",
 		4: "REWARD_YELLOW",
+		5: "REWARD_WHITE",
+		6: "REWARD_PINK",
 	}
 	ColorGameReward_value = map[string]int32{
 		"REWARD_UNSPECIFIED": 0,
@@ -107,6 +118,8 @@ var (
 		"REWARD_GREEN":       2,
 		"REWARD_BLUE":        3,
 		"REWARD_YELLOW":      4,
+		"REWARD_WHITE":       5,
+		"REWARD_PINK":        6,
 	}
 )
 
@@ -142,9 +155,11 @@ type ColorGamePlaceBetReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64           `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Color  ColorGameReward `protobuf:"varint,2,opt,name=color,proto3,enum=colorgame.ColorGameReward" json:"color,omitempty"`
-	Amount int64           `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	UserId         int64           `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Color          ColorGameReward `protobuf:"varint,2,opt,name=color,proto3,enum=colorgame.ColorGameReward" json:"color,omitempty"`
+	Amount         int64           `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	TableId        string          `protobuf:"bytes,4,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`                      // 桌號，空值為預設桌
+	IdempotencyKey string          `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // 客戶端請求鍵，重送時返回原注單 (空值不去重)
 }
 
 func (x *ColorGamePlaceBetReq) Reset() {
@@ -200,6 +215,20 @@ func (x *ColorGamePlaceBetReq) GetAmount() int64 {
 	return 0
 }
 
+func (x *ColorGamePlaceBetReq) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGamePlaceBetReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type ColorGamePlaceBetRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -263,16 +292,19 @@ func (x *ColorGamePlaceBetRsp) GetError() string {
 	return ""
 }
 
-type ColorGameGetStateReq struct {
+// ColorGameBetLine is one color of a bet slip
+type ColorGameBetLine struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Color  ColorGameReward `protobuf:"varint,1,opt,name=color,proto3,enum=colorgame.ColorGameReward" json:"color,omitempty"`
+	Amount int64           `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`           // 請求中為本次下注金額，回應中為該顏色注單的總金額
+	BetId  string          `protobuf:"bytes,3,opt,name=bet_id,json=betId,proto3" json:"bet_id,omitempty"` // 下注成功後的注單 ID
 }
 
-func (x *ColorGameGetStateReq) Reset() {
-	*x = ColorGameGetStateReq{}
+func (x *ColorGameBetLine) Reset() {
+	*x = ColorGameBetLine{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -280,13 +312,13 @@ func (x *ColorGameGetStateReq) Reset() {
 	}
 }
 
-func (x *ColorGameGetStateReq) String() string {
+func (x *ColorGameBetLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColorGameGetStateReq) ProtoMessage() {}
+func (*ColorGameBetLine) ProtoMessage() {}
 
-func (x *ColorGameGetStateReq) ProtoReflect() protoreflect.Message {
+func (x *ColorGameBetLine) ProtoReflect() protoreflect.Message {
 	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -298,29 +330,45 @@ func (x *ColorGameGetStateReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColorGameGetStateReq.ProtoReflect.Descriptor instead.
-func (*ColorGameGetStateReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ColorGameBetLine.ProtoReflect.Descriptor instead.
+func (*ColorGameBetLine) Descriptor() ([]byte, []int) {
 	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ColorGameGetStateReq) GetUserId() int64 {
+func (x *ColorGameBetLine) GetColor() ColorGameReward {
 	if x != nil {
-		return x.UserId
+		return x.Color
+	}
+	return ColorGameReward_REWARD_UNSPECIFIED
+}
+
+func (x *ColorGameBetLine) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
 	}
 	return 0
 }
 
-type ColorGameGetStateRsp struct {
+func (x *ColorGameBetLine) GetBetId() string {
+	if x != nil {
+		return x.BetId
+	}
+	return ""
+}
+
+type ColorGamePlaceBetSlipReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrorCode common.ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
-	StateJson []byte           `protobuf:"bytes,2,opt,name=state_json,json=stateJson,proto3" json:"state_json,omitempty"`
+	UserId         int64               `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TableId        string              `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`                      // 桌號，空值為預設桌
+	Lines          []*ColorGameBetLine `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`                                         // 每個顏色至多一行
+	IdempotencyKey string              `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // 客戶端請求鍵，重送時返回原注單 (空值不去重)
 }
 
-func (x *ColorGameGetStateRsp) Reset() {
-	*x = ColorGameGetStateRsp{}
+func (x *ColorGamePlaceBetSlipReq) Reset() {
+	*x = ColorGamePlaceBetSlipReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -328,13 +376,13 @@ func (x *ColorGameGetStateRsp) Reset() {
 	}
 }
 
-func (x *ColorGameGetStateRsp) String() string {
+func (x *ColorGamePlaceBetSlipReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColorGameGetStateRsp) ProtoMessage() {}
+func (*ColorGamePlaceBetSlipReq) ProtoMessage() {}
 
-func (x *ColorGameGetStateRsp) ProtoReflect() protoreflect.Message {
+func (x *ColorGamePlaceBetSlipReq) ProtoReflect() protoreflect.Message {
 	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -346,38 +394,51 @@ func (x *ColorGameGetStateRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColorGameGetStateRsp.ProtoReflect.Descriptor instead.
-func (*ColorGameGetStateRsp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ColorGamePlaceBetSlipReq.ProtoReflect.Descriptor instead.
+func (*ColorGamePlaceBetSlipReq) Descriptor() ([]byte, []int) {
 	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *ColorGameGetStateRsp) GetErrorCode() common.ErrorCode {
+func (x *ColorGamePlaceBetSlipReq) GetUserId() int64 {
 	if x != nil {
-		return x.ErrorCode
+		return x.UserId
 	}
-	return common.ErrorCode(0)
+	return 0
 }
 
-func (x *ColorGameGetStateRsp) GetStateJson() []byte {
+func (x *ColorGamePlaceBetSlipReq) GetTableId() string {
 	if x != nil {
-		return x.StateJson
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGamePlaceBetSlipReq) GetLines() []*ColorGameBetLine {
+	if x != nil {
+		return x.Lines
 	}
 	return nil
 }
 
-type ColorGameRecordBetReq struct {
+func (x *ColorGamePlaceBetSlipReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ColorGamePlaceBetSlipRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoundId string          `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
-	UserId  int64           `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Color   ColorGameReward `protobuf:"varint,3,opt,name=color,proto3,enum=colorgame.ColorGameReward" json:"color,omitempty"`
-	Amount  int64           `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	ErrorCode common.ErrorCode    `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Lines     []*ColorGameBetLine `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"` // 依請求順序，帶上各行注單 ID
+	Error     string              `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *ColorGameRecordBetReq) Reset() {
-	*x = ColorGameRecordBetReq{}
+func (x *ColorGamePlaceBetSlipRsp) Reset() {
+	*x = ColorGamePlaceBetSlipRsp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -385,13 +446,13 @@ func (x *ColorGameRecordBetReq) Reset() {
 	}
 }
 
-func (x *ColorGameRecordBetReq) String() string {
+func (x *ColorGamePlaceBetSlipRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColorGameRecordBetReq) ProtoMessage() {}
+func (*ColorGamePlaceBetSlipRsp) ProtoMessage() {}
 
-func (x *ColorGameRecordBetReq) ProtoReflect() protoreflect.Message {
+func (x *ColorGamePlaceBetSlipRsp) ProtoReflect() protoreflect.Message {
 	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -403,50 +464,45 @@ func (x *ColorGameRecordBetReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColorGameRecordBetReq.ProtoReflect.Descriptor instead.
-func (*ColorGameRecordBetReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ColorGamePlaceBetSlipRsp.ProtoReflect.Descriptor instead.
+func (*ColorGamePlaceBetSlipRsp) Descriptor() ([]byte, []int) {
 	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ColorGameRecordBetReq) GetRoundId() string {
-	if x != nil {
-		return x.RoundId
-	}
-	return ""
-}
-
-func (x *ColorGameRecordBetReq) GetUserId() int64 {
+func (x *ColorGamePlaceBetSlipRsp) GetErrorCode() common.ErrorCode {
 	if x != nil {
-		return x.UserId
+		return x.ErrorCode
 	}
-	return 0
+	return common.ErrorCode(0)
 }
 
-func (x *ColorGameRecordBetReq) GetColor() ColorGameReward {
+func (x *ColorGamePlaceBetSlipRsp) GetLines() []*ColorGameBetLine {
 	if x != nil {
-		return x.Color
+		return x.Lines
 	}
-	return ColorGameReward_REWARD_UNSPECIFIED
+	return nil
 }
 
-func (x *ColorGameRecordBetReq) GetAmount() int64 {
+func (x *ColorGamePlaceBetSlipRsp) GetError() string {
 	if x != nil {
-		return x.Amount
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-type ColorGameRecordBetRsp struct {
+type ColorGameRebetReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrorCode common.ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
-	Error     string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	UserId         int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TableId        string `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`                      // 桌號，空值為預設桌
+	Multiplier     int64  `protobuf:"varint,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`                              // 金額倍數，0 或 1 為重複上局，2 為加倍
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // 客戶端請求鍵，重送時返回原注單 (空值不去重)
 }
 
-func (x *ColorGameRecordBetRsp) Reset() {
-	*x = ColorGameRecordBetRsp{}
+func (x *ColorGameRebetReq) Reset() {
+	*x = ColorGameRebetReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -454,13 +510,13 @@ func (x *ColorGameRecordBetRsp) Reset() {
 	}
 }
 
-func (x *ColorGameRecordBetRsp) String() string {
+func (x *ColorGameRebetReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColorGameRecordBetRsp) ProtoMessage() {}
+func (*ColorGameRebetReq) ProtoMessage() {}
 
-func (x *ColorGameRecordBetRsp) ProtoReflect() protoreflect.Message {
+func (x *ColorGameRebetReq) ProtoReflect() protoreflect.Message {
 	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -472,35 +528,51 @@ func (x *ColorGameRecordBetRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColorGameRecordBetRsp.ProtoReflect.Descriptor instead.
-func (*ColorGameRecordBetRsp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ColorGameRebetReq.ProtoReflect.Descriptor instead.
+func (*ColorGameRebetReq) Descriptor() ([]byte, []int) {
 	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ColorGameRecordBetRsp) GetErrorCode() common.ErrorCode {
+func (x *ColorGameRebetReq) GetUserId() int64 {
 	if x != nil {
-		return x.ErrorCode
+		return x.UserId
 	}
-	return common.ErrorCode(0)
+	return 0
 }
 
-func (x *ColorGameRecordBetRsp) GetError() string {
+func (x *ColorGameRebetReq) GetTableId() string {
 	if x != nil {
-		return x.Error
+		return x.TableId
 	}
 	return ""
 }
 
-type ColorGameGetCurrentRoundReq struct {
+func (x *ColorGameRebetReq) GetMultiplier() int64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+func (x *ColorGameRebetReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ColorGameRebetRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ErrorCode common.ErrorCode    `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Lines     []*ColorGameBetLine `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"` // 上局注單各行，帶上本局注單 ID
+	Error     string              `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *ColorGameGetCurrentRoundReq) Reset() {
-	*x = ColorGameGetCurrentRoundReq{}
+func (x *ColorGameRebetRsp) Reset() {
+	*x = ColorGameRebetRsp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -508,13 +580,13 @@ func (x *ColorGameGetCurrentRoundReq) Reset() {
 	}
 }
 
-func (x *ColorGameGetCurrentRoundReq) String() string {
+func (x *ColorGameRebetRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColorGameGetCurrentRoundReq) ProtoMessage() {}
+func (*ColorGameRebetRsp) ProtoMessage() {}
 
-func (x *ColorGameGetCurrentRoundReq) ProtoReflect() protoreflect.Message {
+func (x *ColorGameRebetRsp) ProtoReflect() protoreflect.Message {
 	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -526,29 +598,47 @@ func (x *ColorGameGetCurrentRoundReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColorGameGetCurrentRoundReq.ProtoReflect.Descriptor instead.
-func (*ColorGameGetCurrentRoundReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ColorGameRebetRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameRebetRsp) Descriptor() ([]byte, []int) {
 	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ColorGameGetCurrentRoundReq) GetUserId() int64 {
+func (x *ColorGameRebetRsp) GetErrorCode() common.ErrorCode {
 	if x != nil {
-		return x.UserId
+		return x.ErrorCode
 	}
-	return 0
+	return common.ErrorCode(0)
 }
 
-type ColorGamePlayerBet struct {
+func (x *ColorGameRebetRsp) GetLines() []*ColorGameBetLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *ColorGameRebetRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ColorGameStartAutoBetReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Color  ColorGameReward `protobuf:"varint,1,opt,name=color,proto3,enum=colorgame.ColorGameReward" json:"color,omitempty"`
-	Amount int64           `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	UserId     int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TableId    string `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`     // 桌號，空值為預設桌
+	Multiplier int64  `protobuf:"varint,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`             // 金額倍數，0 或 1 為上局金額
+	Rounds     int32  `protobuf:"varint,4,opt,name=rounds,proto3" json:"rounds,omitempty"`                     // 自動下注局數
+	StopLoss   int64  `protobuf:"varint,5,opt,name=stop_loss,json=stopLoss,proto3" json:"stop_loss,omitempty"` // 餘額比開始時減少達此金額即停止，0 表示不限
+	StopWin    int64  `protobuf:"varint,6,opt,name=stop_win,json=stopWin,proto3" json:"stop_win,omitempty"`    // 餘額比開始時增加達此金額即停止，0 表示不限
 }
 
-func (x *ColorGamePlayerBet) Reset() {
-	*x = ColorGamePlayerBet{}
+func (x *ColorGameStartAutoBetReq) Reset() {
+	*x = ColorGameStartAutoBetReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -556,13 +646,13 @@ func (x *ColorGamePlayerBet) Reset() {
 	}
 }
 
-func (x *ColorGamePlayerBet) String() string {
+func (x *ColorGameStartAutoBetReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColorGamePlayerBet) ProtoMessage() {}
+func (*ColorGameStartAutoBetReq) ProtoMessage() {}
 
-func (x *ColorGamePlayerBet) ProtoReflect() protoreflect.Message {
+func (x *ColorGameStartAutoBetReq) ProtoReflect() protoreflect.Message {
 	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -574,40 +664,66 @@ func (x *ColorGamePlayerBet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColorGamePlayerBet.ProtoReflect.Descriptor instead.
-func (*ColorGamePlayerBet) Descriptor() ([]byte, []int) {
+// Deprecated: Use ColorGameStartAutoBetReq.ProtoReflect.Descriptor instead.
+func (*ColorGameStartAutoBetReq) Descriptor() ([]byte, []int) {
 	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ColorGamePlayerBet) GetColor() ColorGameReward {
+func (x *ColorGameStartAutoBetReq) GetUserId() int64 {
 	if x != nil {
-		return x.Color
+		return x.UserId
 	}
-	return ColorGameReward_REWARD_UNSPECIFIED
+	return 0
 }
 
-func (x *ColorGamePlayerBet) GetAmount() int64 {
+func (x *ColorGameStartAutoBetReq) GetTableId() string {
 	if x != nil {
-		return x.Amount
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGameStartAutoBetReq) GetMultiplier() int64 {
+	if x != nil {
+		return x.Multiplier
 	}
 	return 0
 }
 
-type ColorGameGetCurrentRoundRsp struct {
+func (x *ColorGameStartAutoBetReq) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+func (x *ColorGameStartAutoBetReq) GetStopLoss() int64 {
+	if x != nil {
+		return x.StopLoss
+	}
+	return 0
+}
+
+func (x *ColorGameStartAutoBetReq) GetStopWin() int64 {
+	if x != nil {
+		return x.StopWin
+	}
+	return 0
+}
+
+type ColorGameStartAutoBetRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrorCode           common.ErrorCode      `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
-	RoundId             string                `protobuf:"bytes,2,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
-	State               ColorGameState        `protobuf:"varint,3,opt,name=state,proto3,enum=colorgame.ColorGameState" json:"state,omitempty"`
-	BettingEndTimestamp int64                 `protobuf:"varint,4,opt,name=betting_end_timestamp,json=bettingEndTimestamp,proto3" json:"betting_end_timestamp,omitempty"`
-	PlayerBets          []*ColorGamePlayerBet `protobuf:"bytes,5,rep,name=player_bets,json=playerBets,proto3" json:"player_bets,omitempty"`
-	LeftTime            int64                 `protobuf:"varint,6,opt,name=left_time,json=leftTime,proto3" json:"left_time,omitempty"`
+	ErrorCode  common.ErrorCode    `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Lines      []*ColorGameBetLine `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"` // 每局下注的注單
+	RoundsLeft int32               `protobuf:"varint,3,opt,name=rounds_left,json=roundsLeft,proto3" json:"rounds_left,omitempty"`
+	Error      string              `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *ColorGameGetCurrentRoundRsp) Reset() {
-	*x = ColorGameGetCurrentRoundRsp{}
+func (x *ColorGameStartAutoBetRsp) Reset() {
+	*x = ColorGameStartAutoBetRsp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -615,13 +731,13 @@ func (x *ColorGameGetCurrentRoundRsp) Reset() {
 	}
 }
 
-func (x *ColorGameGetCurrentRoundRsp) String() string {
+func (x *ColorGameStartAutoBetRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColorGameGetCurrentRoundRsp) ProtoMessage() {}
+func (*ColorGameStartAutoBetRsp) ProtoMessage() {}
 
-func (x *ColorGameGetCurrentRoundRsp) ProtoReflect() protoreflect.Message {
+func (x *ColorGameStartAutoBetRsp) ProtoReflect() protoreflect.Message {
 	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -633,66 +749,49 @@ func (x *ColorGameGetCurrentRoundRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColorGameGetCurrentRoundRsp.ProtoReflect.Descriptor instead.
-func (*ColorGameGetCurrentRoundRsp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ColorGameStartAutoBetRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameStartAutoBetRsp) Descriptor() ([]byte, []int) {
 	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ColorGameGetCurrentRoundRsp) GetErrorCode() common.ErrorCode {
+func (x *ColorGameStartAutoBetRsp) GetErrorCode() common.ErrorCode {
 	if x != nil {
 		return x.ErrorCode
 	}
 	return common.ErrorCode(0)
 }
 
-func (x *ColorGameGetCurrentRoundRsp) GetRoundId() string {
-	if x != nil {
-		return x.RoundId
-	}
-	return ""
-}
-
-func (x *ColorGameGetCurrentRoundRsp) GetState() ColorGameState {
+func (x *ColorGameStartAutoBetRsp) GetLines() []*ColorGameBetLine {
 	if x != nil {
-		return x.State
+		return x.Lines
 	}
-	return ColorGameState_GAME_STATE_UNSPECIFIED
+	return nil
 }
 
-func (x *ColorGameGetCurrentRoundRsp) GetBettingEndTimestamp() int64 {
+func (x *ColorGameStartAutoBetRsp) GetRoundsLeft() int32 {
 	if x != nil {
-		return x.BettingEndTimestamp
+		return x.RoundsLeft
 	}
 	return 0
 }
 
-func (x *ColorGameGetCurrentRoundRsp) GetPlayerBets() []*ColorGamePlayerBet {
-	if x != nil {
-		return x.PlayerBets
-	}
-	return nil
-}
-
-func (x *ColorGameGetCurrentRoundRsp) GetLeftTime() int64 {
+func (x *ColorGameStartAutoBetRsp) GetError() string {
 	if x != nil {
-		return x.LeftTime
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-type ColorGameRoundStateBRC struct {
+type ColorGameStopAutoBetReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoundId             string         `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
-	State               ColorGameState `protobuf:"varint,2,opt,name=state,proto3,enum=colorgame.ColorGameState" json:"state,omitempty"`
-	BettingEndTimestamp int64          `protobuf:"varint,3,opt,name=betting_end_timestamp,json=bettingEndTimestamp,proto3" json:"betting_end_timestamp,omitempty"`
-	LeftTime            int64          `protobuf:"varint,4,opt,name=left_time,json=leftTime,proto3" json:"left_time,omitempty"`
+	UserId int64 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *ColorGameRoundStateBRC) Reset() {
-	*x = ColorGameRoundStateBRC{}
+func (x *ColorGameStopAutoBetReq) Reset() {
+	*x = ColorGameStopAutoBetReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -700,13 +799,13 @@ func (x *ColorGameRoundStateBRC) Reset() {
 	}
 }
 
-func (x *ColorGameRoundStateBRC) String() string {
+func (x *ColorGameStopAutoBetReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColorGameRoundStateBRC) ProtoMessage() {}
+func (*ColorGameStopAutoBetReq) ProtoMessage() {}
 
-func (x *ColorGameRoundStateBRC) ProtoReflect() protoreflect.Message {
+func (x *ColorGameStopAutoBetReq) ProtoReflect() protoreflect.Message {
 	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -718,73 +817,110 @@ func (x *ColorGameRoundStateBRC) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColorGameRoundStateBRC.ProtoReflect.Descriptor instead.
-func (*ColorGameRoundStateBRC) Descriptor() ([]byte, []int) {
+// Deprecated: Use ColorGameStopAutoBetReq.ProtoReflect.Descriptor instead.
+func (*ColorGameStopAutoBetReq) Descriptor() ([]byte, []int) {
 	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ColorGameRoundStateBRC) GetRoundId() string {
+func (x *ColorGameStopAutoBetReq) GetUserId() int64 {
 	if x != nil {
-		return x.RoundId
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *ColorGameRoundStateBRC) GetState() ColorGameState {
-	if x != nil {
-		return x.State
-	}
-	return ColorGameState_GAME_STATE_UNSPECIFIED
+type ColorGameStopAutoBetRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode common.ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error     string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *ColorGameRoundStateBRC) GetBettingEndTimestamp() int64 {
-	if x != nil {
-		return x.BettingEndTimestamp
+func (x *ColorGameStopAutoBetRsp) Reset() {
+	*x = ColorGameStopAutoBetRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *ColorGameRoundStateBRC) GetLeftTime() int64 {
+func (x *ColorGameStopAutoBetRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameStopAutoBetRsp) ProtoMessage() {}
+
+func (x *ColorGameStopAutoBetRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameStopAutoBetRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameStopAutoBetRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ColorGameStopAutoBetRsp) GetErrorCode() common.ErrorCode {
 	if x != nil {
-		return x.LeftTime
+		return x.ErrorCode
 	}
-	return 0
+	return common.ErrorCode(0)
 }
 
-// ColorGameSettlementBRC is sent to users after round settlement
-// 有下注和無下注的玩家收到的欄位不同
-type ColorGameSettlementBRC struct {
+func (x *ColorGameStopAutoBetRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ColorGameBetRecord is a bet order with the result of its round
+type ColorGameBetRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoundId      string          `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
-	WinningColor ColorGameReward `protobuf:"varint,2,opt,name=winning_color,json=winningColor,proto3,enum=colorgame.ColorGameReward" json:"winning_color,omitempty"`
-	// 以下欄位只有下注的玩家才有值
-	BetId     string          `protobuf:"bytes,3,opt,name=bet_id,json=betId,proto3" json:"bet_id,omitempty"`                                          // 下注 ID（無下注時為空）
-	BetColor  ColorGameReward `protobuf:"varint,4,opt,name=bet_color,json=betColor,proto3,enum=colorgame.ColorGameReward" json:"bet_color,omitempty"` // 下注顏色（無下注時為空）
-	BetAmount int64           `protobuf:"varint,5,opt,name=bet_amount,json=betAmount,proto3" json:"bet_amount,omitempty"`                             // 下注金額（無下注時為 0）
-	WinAmount int64           `protobuf:"varint,6,opt,name=win_amount,json=winAmount,proto3" json:"win_amount,omitempty"`                             // 贏得金額（無下注或輸了時為 0）
-	IsWinner  bool            `protobuf:"varint,7,opt,name=is_winner,json=isWinner,proto3" json:"is_winner,omitempty"`                                // 是否贏家（無下注時為 false）
+	BetId      string            `protobuf:"bytes,1,opt,name=bet_id,json=betId,proto3" json:"bet_id,omitempty"`
+	UserId     int64             `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RoundId    string            `protobuf:"bytes,3,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	GameCode   string            `protobuf:"bytes,4,opt,name=game_code,json=gameCode,proto3" json:"game_code,omitempty"`
+	Color      ColorGameReward   `protobuf:"varint,5,opt,name=color,proto3,enum=colorgame.ColorGameReward" json:"color,omitempty"`
+	Amount     int64             `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Payout     int64             `protobuf:"varint,7,opt,name=payout,proto3" json:"payout,omitempty"`                                   // 派彩金額，未中獎為 0
+	Status     string            `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`                                    // PENDING | SETTLED | REFUNDED | CANCELLED
+	Dice       []ColorGameReward `protobuf:"varint,9,rep,packed,name=dice,proto3,enum=colorgame.ColorGameReward" json:"dice,omitempty"` // 回合開獎結果，未開獎或作廢為空
+	VoidReason string            `protobuf:"bytes,10,opt,name=void_reason,json=voidReason,proto3" json:"void_reason,omitempty"`         // 非空代表回合作廢退款
+	CreatedAt  int64             `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`           // Unix 秒
+	SettledAt  int64             `protobuf:"varint,12,opt,name=settled_at,json=settledAt,proto3" json:"settled_at,omitempty"`           // Unix 秒，未結算為 0
 }
 
-func (x *ColorGameSettlementBRC) Reset() {
-	*x = ColorGameSettlementBRC{}
+func (x *ColorGameBetRecord) Reset() {
+	*x = ColorGameBetRecord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[10]
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ColorGameSettlementBRC) String() string {
+func (x *ColorGameBetRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColorGameSettlementBRC) ProtoMessage() {}
+func (*ColorGameBetRecord) ProtoMessage() {}
 
-func (x *ColorGameSettlementBRC) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[10]
+func (x *ColorGameBetRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -795,86 +931,126 @@ func (x *ColorGameSettlementBRC) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColorGameSettlementBRC.ProtoReflect.Descriptor instead.
-func (*ColorGameSettlementBRC) Descriptor() ([]byte, []int) {
-	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ColorGameBetRecord.ProtoReflect.Descriptor instead.
+func (*ColorGameBetRecord) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ColorGameSettlementBRC) GetRoundId() string {
+func (x *ColorGameBetRecord) GetBetId() string {
 	if x != nil {
-		return x.RoundId
+		return x.BetId
 	}
 	return ""
 }
 
-func (x *ColorGameSettlementBRC) GetWinningColor() ColorGameReward {
+func (x *ColorGameBetRecord) GetUserId() int64 {
 	if x != nil {
-		return x.WinningColor
+		return x.UserId
 	}
-	return ColorGameReward_REWARD_UNSPECIFIED
+	return 0
 }
 
-func (x *ColorGameSettlementBRC) GetBetId() string {
+func (x *ColorGameBetRecord) GetRoundId() string {
 	if x != nil {
-		return x.BetId
+		return x.RoundId
 	}
 	return ""
 }
 
-func (x *ColorGameSettlementBRC) GetBetColor() ColorGameReward {
+func (x *ColorGameBetRecord) GetGameCode() string {
 	if x != nil {
-		return x.BetColor
+		return x.GameCode
+	}
+	return ""
+}
+
+func (x *ColorGameBetRecord) GetColor() ColorGameReward {
+	if x != nil {
+		return x.Color
 	}
 	return ColorGameReward_REWARD_UNSPECIFIED
 }
 
-func (x *ColorGameSettlementBRC) GetBetAmount() int64 {
+func (x *ColorGameBetRecord) GetAmount() int64 {
 	if x != nil {
-		return x.BetAmount
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *ColorGameSettlementBRC) GetWinAmount() int64 {
+func (x *ColorGameBetRecord) GetPayout() int64 {
 	if x != nil {
-		return x.WinAmount
+		return x.Payout
 	}
 	return 0
 }
 
-func (x *ColorGameSettlementBRC) GetIsWinner() bool {
+func (x *ColorGameBetRecord) GetStatus() string {
 	if x != nil {
-		return x.IsWinner
+		return x.Status
 	}
-	return false
+	return ""
 }
 
-type ColorGameRoundResultReq struct {
+func (x *ColorGameBetRecord) GetDice() []ColorGameReward {
+	if x != nil {
+		return x.Dice
+	}
+	return nil
+}
+
+func (x *ColorGameBetRecord) GetVoidReason() string {
+	if x != nil {
+		return x.VoidReason
+	}
+	return ""
+}
+
+func (x *ColorGameBetRecord) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ColorGameBetRecord) GetSettledAt() int64 {
+	if x != nil {
+		return x.SettledAt
+	}
+	return 0
+}
+
+type ColorGameGetBetHistoryReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RoundId string          `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
-	Result  ColorGameReward `protobuf:"varint,2,opt,name=result,proto3,enum=colorgame.ColorGameReward" json:"result,omitempty"`
+	UserId   int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`      // 玩家 ID，GetBetHistory 必填
+	RoundId  string `protobuf:"bytes,2,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`    // 指定回合時返回該回合全部注單 (不分頁)
+	GameCode string `protobuf:"bytes,3,opt,name=game_code,json=gameCode,proto3" json:"game_code,omitempty"` // 空值為全部遊戲
+	From     int64  `protobuf:"varint,4,opt,name=from,proto3" json:"from,omitempty"`                        // Unix 秒，0 為不限
+	To       int64  `protobuf:"varint,5,opt,name=to,proto3" json:"to,omitempty"`                            // Unix 秒 (不含)，0 為不限
+	Cursor   string `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`                     // 上一頁的 next_cursor，空值為第一頁
+	Limit    int32  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`                      // 預設 20，最多 100
 }
 
-func (x *ColorGameRoundResultReq) Reset() {
-	*x = ColorGameRoundResultReq{}
+func (x *ColorGameGetBetHistoryReq) Reset() {
+	*x = ColorGameGetBetHistoryReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[11]
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ColorGameRoundResultReq) String() string {
+func (x *ColorGameGetBetHistoryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColorGameRoundResultReq) ProtoMessage() {}
+func (*ColorGameGetBetHistoryReq) ProtoMessage() {}
 
-func (x *ColorGameRoundResultReq) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[11]
+func (x *ColorGameGetBetHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -885,51 +1061,88 @@ func (x *ColorGameRoundResultReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColorGameRoundResultReq.ProtoReflect.Descriptor instead.
-func (*ColorGameRoundResultReq) Descriptor() ([]byte, []int) {
-	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ColorGameGetBetHistoryReq.ProtoReflect.Descriptor instead.
+func (*ColorGameGetBetHistoryReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ColorGameRoundResultReq) GetRoundId() string {
+func (x *ColorGameGetBetHistoryReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ColorGameGetBetHistoryReq) GetRoundId() string {
 	if x != nil {
 		return x.RoundId
 	}
 	return ""
 }
 
-func (x *ColorGameRoundResultReq) GetResult() ColorGameReward {
+func (x *ColorGameGetBetHistoryReq) GetGameCode() string {
 	if x != nil {
-		return x.Result
+		return x.GameCode
 	}
-	return ColorGameReward_REWARD_UNSPECIFIED
+	return ""
 }
 
-type ColorGameRoundResultRsp struct {
+func (x *ColorGameGetBetHistoryReq) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *ColorGameGetBetHistoryReq) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *ColorGameGetBetHistoryReq) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ColorGameGetBetHistoryReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ColorGameGetBetHistoryRsp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ErrorCode common.ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
-	Error     string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode  common.ErrorCode      `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error      string                `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Records    []*ColorGameBetRecord `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`                         // 由新到舊
+	NextCursor string                `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"` // 空值代表最後一頁
 }
 
-func (x *ColorGameRoundResultRsp) Reset() {
-	*x = ColorGameRoundResultRsp{}
+func (x *ColorGameGetBetHistoryRsp) Reset() {
+	*x = ColorGameGetBetHistoryRsp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[12]
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ColorGameRoundResultRsp) String() string {
+func (x *ColorGameGetBetHistoryRsp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColorGameRoundResultRsp) ProtoMessage() {}
+func (*ColorGameGetBetHistoryRsp) ProtoMessage() {}
 
-func (x *ColorGameRoundResultRsp) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[12]
+func (x *ColorGameGetBetHistoryRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -940,286 +1153,5482 @@ func (x *ColorGameRoundResultRsp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColorGameRoundResultRsp.ProtoReflect.Descriptor instead.
-func (*ColorGameRoundResultRsp) Descriptor() ([]byte, []int) {
-	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ColorGameGetBetHistoryRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameGetBetHistoryRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ColorGameRoundResultRsp) GetErrorCode() common.ErrorCode {
+func (x *ColorGameGetBetHistoryRsp) GetErrorCode() common.ErrorCode {
 	if x != nil {
 		return x.ErrorCode
 	}
 	return common.ErrorCode(0)
 }
 
-func (x *ColorGameRoundResultRsp) GetError() string {
+func (x *ColorGameGetBetHistoryRsp) GetError() string {
 	if x != nil {
 		return x.Error
 	}
 	return ""
 }
 
-var File_shared_proto_colorgame_colorgame_proto protoreflect.FileDescriptor
+func (x *ColorGameGetBetHistoryRsp) GetRecords() []*ColorGameBetRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
 
-var file_shared_proto_colorgame_colorgame_proto_rawDesc = []byte{
-	0x0a, 0x26, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
-	0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61,
-	0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67,
-	0x61, 0x6d, 0x65, 0x1a, 0x20, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x79, 0x0a, 0x14, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61,
-	0x6d, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d,
-	0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
-	0x64, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x75, 0x0a, 0x14, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61,
-	0x63, 0x65, 0x42, 0x65, 0x74, 0x52, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x65,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x65, 0x74, 0x49,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x2f, 0x0a, 0x14, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
-	0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x14, 0x43, 0x6f, 0x6c, 0x6f,
-	0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x73, 0x70,
-	0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6a, 0x73, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4a, 0x73, 0x6f,
-	0x6e, 0x22, 0x95, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52,
-	0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x30, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
-	0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
-	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f,
-	0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5f, 0x0a, 0x15, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x65, 0x74, 0x52,
-	0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x36, 0x0a, 0x1b, 0x43, 0x6f,
-	0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x5e, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67,
-	0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0xac, 0x02, 0x0a, 0x1b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65,
-	0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52,
-	0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12,
-	0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
-	0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
-	0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x32, 0x0a, 0x15, 0x62, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x13, 0x62, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x3e, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x62,
-	0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6c, 0x6f,
-	0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x50,
-	0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x65, 0x74, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
-	0x42, 0x65, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x22, 0xb5, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x52, 0x43, 0x12, 0x19, 0x0a, 0x08,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61,
-	0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x62, 0x65, 0x74, 0x74,
-	0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x62, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
-	0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x09,
-	0x6c, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x6c, 0x65, 0x66, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x9f, 0x02, 0x0a, 0x16, 0x43, 0x6f,
-	0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x42, 0x52, 0x43, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12,
-	0x3f, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61,
-	0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61,
-	0x72, 0x64, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
-	0x12, 0x15, 0x0a, 0x06, 0x62, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x62, 0x65, 0x74, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x62, 0x65, 0x74, 0x5f, 0x63,
-	0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c,
-	0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65,
-	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x08, 0x62, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
-	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x69, 0x73, 0x5f, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x69, 0x73, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x22, 0x68, 0x0a, 0x17, 0x43,
-	0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49,
-	0x64, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f,
-	0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x61, 0x0a, 0x17, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61,
-	0x6d, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70,
-	0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0xc5, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x47,
-	0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
-	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x41, 0x4d, 0x45, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x52,
-	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x5f, 0x42, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x16, 0x0a,
-	0x12, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x52, 0x41, 0x57,
-	0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16,
-	0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44,
-	0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x41, 0x4d, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x06,
-	0x2a, 0x6f, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77,
-	0x61, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52,
-	0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x52,
-	0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x47, 0x52, 0x45, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x0f, 0x0a,
-	0x0b, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x42, 0x4c, 0x55, 0x45, 0x10, 0x03, 0x12, 0x11,
-	0x0a, 0x0d, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x59, 0x45, 0x4c, 0x4c, 0x4f, 0x57, 0x10,
-	0x04, 0x32, 0x87, 0x02, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47,
-	0x53, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x42, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65,
-	0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x42,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d,
-	0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65,
-	0x42, 0x65, 0x74, 0x52, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43,
-	0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e,
-	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x0b, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e,
-	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67,
-	0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x6f, 0x75,
-	0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x32, 0xc9, 0x01, 0x0a, 0x13,
-	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x4d, 0x53, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x4f, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x65, 0x74,
-	0x12, 0x20, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c,
-	0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x65, 0x74, 0x52,
-	0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43,
-	0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x65,
-	0x74, 0x52, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67,
-	0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a,
-	0x26, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f,
-	0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52,
-	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x73, 0x70, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x65, 0x6c, 0x69, 0x2f,
-	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2f, 0x73, 0x68, 0x61,
-	0x72, 0x65, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67,
-	0x61, 0x6d, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *ColorGameGetBetHistoryRsp) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
 }
 
-var (
-	file_shared_proto_colorgame_colorgame_proto_rawDescOnce sync.Once
-	file_shared_proto_colorgame_colorgame_proto_rawDescData = file_shared_proto_colorgame_colorgame_proto_rawDesc
-)
+type ColorGameCancelBetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_shared_proto_colorgame_colorgame_proto_rawDescGZIP() []byte {
-	file_shared_proto_colorgame_colorgame_proto_rawDescOnce.Do(func() {
-		file_shared_proto_colorgame_colorgame_proto_rawDescData = protoimpl.X.CompressGZIP(file_shared_proto_colorgame_colorgame_proto_rawDescData)
-	})
-	return file_shared_proto_colorgame_colorgame_proto_rawDescData
+	UserId         int64           `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Color          ColorGameReward `protobuf:"varint,2,opt,name=color,proto3,enum=colorgame.ColorGameReward" json:"color,omitempty"`
+	Amount         int64           `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                                      // 取消金額，0 表示取消整注
+	TableId        string          `protobuf:"bytes,4,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`                      // 桌號，空值為預設桌
+	IdempotencyKey string          `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // 客戶端請求鍵，重送時不重複退款 (空值不去重)
 }
 
-var file_shared_proto_colorgame_colorgame_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_shared_proto_colorgame_colorgame_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-var file_shared_proto_colorgame_colorgame_proto_goTypes = []interface{}{
-	(ColorGameState)(0),                 // 0: colorgame.ColorGameState
-	(ColorGameReward)(0),                // 1: colorgame.ColorGameReward
-	(*ColorGamePlaceBetReq)(nil),        // 2: colorgame.ColorGamePlaceBetReq
-	(*ColorGamePlaceBetRsp)(nil),        // 3: colorgame.ColorGamePlaceBetRsp
-	(*ColorGameGetStateReq)(nil),        // 4: colorgame.ColorGameGetStateReq
-	(*ColorGameGetStateRsp)(nil),        // 5: colorgame.ColorGameGetStateRsp
-	(*ColorGameRecordBetReq)(nil),       // 6: colorgame.ColorGameRecordBetReq
-	(*ColorGameRecordBetRsp)(nil),       // 7: colorgame.ColorGameRecordBetRsp
-	(*ColorGameGetCurrentRoundReq)(nil), // 8: colorgame.ColorGameGetCurrentRoundReq
-	(*ColorGamePlayerBet)(nil),          // 9: colorgame.ColorGamePlayerBet
-	(*ColorGameGetCurrentRoundRsp)(nil), // 10: colorgame.ColorGameGetCurrentRoundRsp
-	(*ColorGameRoundStateBRC)(nil),      // 11: colorgame.ColorGameRoundStateBRC
-	(*ColorGameSettlementBRC)(nil),      // 12: colorgame.ColorGameSettlementBRC
-	(*ColorGameRoundResultReq)(nil),     // 13: colorgame.ColorGameRoundResultReq
-	(*ColorGameRoundResultRsp)(nil),     // 14: colorgame.ColorGameRoundResultRsp
-	(common.ErrorCode)(0),               // 15: common.ErrorCode
+func (x *ColorGameCancelBetReq) Reset() {
+	*x = ColorGameCancelBetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_shared_proto_colorgame_colorgame_proto_depIdxs = []int32{
-	1,  // 0: colorgame.ColorGamePlaceBetReq.color:type_name -> colorgame.ColorGameReward
-	15, // 1: colorgame.ColorGamePlaceBetRsp.error_code:type_name -> common.ErrorCode
-	15, // 2: colorgame.ColorGameGetStateRsp.error_code:type_name -> common.ErrorCode
-	1,  // 3: colorgame.ColorGameRecordBetReq.color:type_name -> colorgame.ColorGameReward
-	15, // 4: colorgame.ColorGameRecordBetRsp.error_code:type_name -> common.ErrorCode
-	1,  // 5: colorgame.ColorGamePlayerBet.color:type_name -> colorgame.ColorGameReward
-	15, // 6: colorgame.ColorGameGetCurrentRoundRsp.error_code:type_name -> common.ErrorCode
-	0,  // 7: colorgame.ColorGameGetCurrentRoundRsp.state:type_name -> colorgame.ColorGameState
-	9,  // 8: colorgame.ColorGameGetCurrentRoundRsp.player_bets:type_name -> colorgame.ColorGamePlayerBet
-	0,  // 9: colorgame.ColorGameRoundStateBRC.state:type_name -> colorgame.ColorGameState
-	1,  // 10: colorgame.ColorGameSettlementBRC.winning_color:type_name -> colorgame.ColorGameReward
-	1,  // 11: colorgame.ColorGameSettlementBRC.bet_color:type_name -> colorgame.ColorGameReward
-	1,  // 12: colorgame.ColorGameRoundResultReq.result:type_name -> colorgame.ColorGameReward
-	15, // 13: colorgame.ColorGameRoundResultRsp.error_code:type_name -> common.ErrorCode
-	2,  // 14: colorgame.ColorGameGSService.PlaceBet:input_type -> colorgame.ColorGamePlaceBetReq
-	4,  // 15: colorgame.ColorGameGSService.GetState:input_type -> colorgame.ColorGameGetStateReq
-	13, // 16: colorgame.ColorGameGSService.RoundResult:input_type -> colorgame.ColorGameRoundResultReq
-	6,  // 17: colorgame.ColorGameGMSService.RecordBet:input_type -> colorgame.ColorGameRecordBetReq
-	8,  // 18: colorgame.ColorGameGMSService.GetCurrentRound:input_type -> colorgame.ColorGameGetCurrentRoundReq
-	3,  // 19: colorgame.ColorGameGSService.PlaceBet:output_type -> colorgame.ColorGamePlaceBetRsp
-	5,  // 20: colorgame.ColorGameGSService.GetState:output_type -> colorgame.ColorGameGetStateRsp
-	14, // 21: colorgame.ColorGameGSService.RoundResult:output_type -> colorgame.ColorGameRoundResultRsp
-	7,  // 22: colorgame.ColorGameGMSService.RecordBet:output_type -> colorgame.ColorGameRecordBetRsp
-	10, // 23: colorgame.ColorGameGMSService.GetCurrentRound:output_type -> colorgame.ColorGameGetCurrentRoundRsp
-	19, // [19:24] is the sub-list for method output_type
-	14, // [14:19] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+
+func (x *ColorGameCancelBetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_shared_proto_colorgame_colorgame_proto_init() }
-func file_shared_proto_colorgame_colorgame_proto_init() {
-	if File_shared_proto_colorgame_colorgame_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_shared_proto_colorgame_colorgame_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColorGamePlaceBetReq); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+func (*ColorGameCancelBetReq) ProtoMessage() {}
+
+func (x *ColorGameCancelBetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_shared_proto_colorgame_colorgame_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColorGamePlaceBetRsp); i {
-			case 0:
-				return &v.state
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameCancelBetReq.ProtoReflect.Descriptor instead.
+func (*ColorGameCancelBetReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ColorGameCancelBetReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ColorGameCancelBetReq) GetColor() ColorGameReward {
+	if x != nil {
+		return x.Color
+	}
+	return ColorGameReward_REWARD_UNSPECIFIED
+}
+
+func (x *ColorGameCancelBetReq) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ColorGameCancelBetReq) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGameCancelBetReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ColorGameCancelBetRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode       common.ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	BetId           string           `protobuf:"bytes,2,opt,name=bet_id,json=betId,proto3" json:"bet_id,omitempty"`
+	RefundAmount    int64            `protobuf:"varint,3,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`          // 本次退還金額
+	RemainingAmount int64            `protobuf:"varint,4,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount,omitempty"` // 注單剩餘金額，0 表示整注已取消
+	Error           string           `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ColorGameCancelBetRsp) Reset() {
+	*x = ColorGameCancelBetRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameCancelBetRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameCancelBetRsp) ProtoMessage() {}
+
+func (x *ColorGameCancelBetRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameCancelBetRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameCancelBetRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ColorGameCancelBetRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameCancelBetRsp) GetBetId() string {
+	if x != nil {
+		return x.BetId
+	}
+	return ""
+}
+
+func (x *ColorGameCancelBetRsp) GetRefundAmount() int64 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
+}
+
+func (x *ColorGameCancelBetRsp) GetRemainingAmount() int64 {
+	if x != nil {
+		return x.RemainingAmount
+	}
+	return 0
+}
+
+func (x *ColorGameCancelBetRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ColorGameGetStateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TableId string `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+}
+
+func (x *ColorGameGetStateReq) Reset() {
+	*x = ColorGameGetStateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameGetStateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameGetStateReq) ProtoMessage() {}
+
+func (x *ColorGameGetStateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameGetStateReq.ProtoReflect.Descriptor instead.
+func (*ColorGameGetStateReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ColorGameGetStateReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ColorGameGetStateReq) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+type ColorGameGetStateRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode common.ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	StateJson []byte           `protobuf:"bytes,2,opt,name=state_json,json=stateJson,proto3" json:"state_json,omitempty"`
+}
+
+func (x *ColorGameGetStateRsp) Reset() {
+	*x = ColorGameGetStateRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameGetStateRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameGetStateRsp) ProtoMessage() {}
+
+func (x *ColorGameGetStateRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameGetStateRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameGetStateRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ColorGameGetStateRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameGetStateRsp) GetStateJson() []byte {
+	if x != nil {
+		return x.StateJson
+	}
+	return nil
+}
+
+type ColorGameRecordBetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundId string          `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	UserId  int64           `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Color   ColorGameReward `protobuf:"varint,3,opt,name=color,proto3,enum=colorgame.ColorGameReward" json:"color,omitempty"`
+	Amount  int64           `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	TableId string          `protobuf:"bytes,5,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+}
+
+func (x *ColorGameRecordBetReq) Reset() {
+	*x = ColorGameRecordBetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameRecordBetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameRecordBetReq) ProtoMessage() {}
+
+func (x *ColorGameRecordBetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameRecordBetReq.ProtoReflect.Descriptor instead.
+func (*ColorGameRecordBetReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ColorGameRecordBetReq) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameRecordBetReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ColorGameRecordBetReq) GetColor() ColorGameReward {
+	if x != nil {
+		return x.Color
+	}
+	return ColorGameReward_REWARD_UNSPECIFIED
+}
+
+func (x *ColorGameRecordBetReq) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ColorGameRecordBetReq) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+type ColorGameRecordBetRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode common.ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error     string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ColorGameRecordBetRsp) Reset() {
+	*x = ColorGameRecordBetRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameRecordBetRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameRecordBetRsp) ProtoMessage() {}
+
+func (x *ColorGameRecordBetRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameRecordBetRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameRecordBetRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ColorGameRecordBetRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameRecordBetRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ColorGameRevokeBetReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundId string          `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	UserId  int64           `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Color   ColorGameReward `protobuf:"varint,3,opt,name=color,proto3,enum=colorgame.ColorGameReward" json:"color,omitempty"`
+	Amount  int64           `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	TableId string          `protobuf:"bytes,5,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Removed bool            `protobuf:"varint,6,opt,name=removed,proto3" json:"removed,omitempty"` // 整注取消，下注數減一
+	Restore bool            `protobuf:"varint,7,opt,name=restore,proto3" json:"restore,omitempty"` // 回補未能取消的下注 (amount 加回，removed 時下注數加一)，下注截止後仍接受
+}
+
+func (x *ColorGameRevokeBetReq) Reset() {
+	*x = ColorGameRevokeBetReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameRevokeBetReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameRevokeBetReq) ProtoMessage() {}
+
+func (x *ColorGameRevokeBetReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameRevokeBetReq.ProtoReflect.Descriptor instead.
+func (*ColorGameRevokeBetReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ColorGameRevokeBetReq) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameRevokeBetReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ColorGameRevokeBetReq) GetColor() ColorGameReward {
+	if x != nil {
+		return x.Color
+	}
+	return ColorGameReward_REWARD_UNSPECIFIED
+}
+
+func (x *ColorGameRevokeBetReq) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ColorGameRevokeBetReq) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGameRevokeBetReq) GetRemoved() bool {
+	if x != nil {
+		return x.Removed
+	}
+	return false
+}
+
+func (x *ColorGameRevokeBetReq) GetRestore() bool {
+	if x != nil {
+		return x.Restore
+	}
+	return false
+}
+
+type ColorGameRevokeBetRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode common.ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error     string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ColorGameRevokeBetRsp) Reset() {
+	*x = ColorGameRevokeBetRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameRevokeBetRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameRevokeBetRsp) ProtoMessage() {}
+
+func (x *ColorGameRevokeBetRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameRevokeBetRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameRevokeBetRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ColorGameRevokeBetRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameRevokeBetRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ColorGameGetCurrentRoundReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  int64  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TableId string `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+}
+
+func (x *ColorGameGetCurrentRoundReq) Reset() {
+	*x = ColorGameGetCurrentRoundReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameGetCurrentRoundReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameGetCurrentRoundReq) ProtoMessage() {}
+
+func (x *ColorGameGetCurrentRoundReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameGetCurrentRoundReq.ProtoReflect.Descriptor instead.
+func (*ColorGameGetCurrentRoundReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ColorGameGetCurrentRoundReq) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ColorGameGetCurrentRoundReq) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+type ColorGameGetRecentEventsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableId  string `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	RoundId  string `protobuf:"bytes,2,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`     // 最後收到的事件所屬回合，空值回傳整個緩衝區
+	AfterSeq int64  `protobuf:"varint,3,opt,name=after_seq,json=afterSeq,proto3" json:"after_seq,omitempty"` // 最後收到的事件序號，只回傳之後的事件
+}
+
+func (x *ColorGameGetRecentEventsReq) Reset() {
+	*x = ColorGameGetRecentEventsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameGetRecentEventsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameGetRecentEventsReq) ProtoMessage() {}
+
+func (x *ColorGameGetRecentEventsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameGetRecentEventsReq.ProtoReflect.Descriptor instead.
+func (*ColorGameGetRecentEventsReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ColorGameGetRecentEventsReq) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGameGetRecentEventsReq) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameGetRecentEventsReq) GetAfterSeq() int64 {
+	if x != nil {
+		return x.AfterSeq
+	}
+	return 0
+}
+
+type ColorGameGetRecentEventsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode common.ErrorCode          `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Events    []*ColorGameRoundStateBRC `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`      // 依發送順序排列
+	Complete  bool                      `protobuf:"varint,3,opt,name=complete,proto3" json:"complete,omitempty"` // false: 缺少的事件已不在緩衝區，請改用 GetCurrentRound 重新同步
+}
+
+func (x *ColorGameGetRecentEventsRsp) Reset() {
+	*x = ColorGameGetRecentEventsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameGetRecentEventsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameGetRecentEventsRsp) ProtoMessage() {}
+
+func (x *ColorGameGetRecentEventsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameGetRecentEventsRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameGetRecentEventsRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ColorGameGetRecentEventsRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameGetRecentEventsRsp) GetEvents() []*ColorGameRoundStateBRC {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ColorGameGetRecentEventsRsp) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+// ColorGameRoadmapEntry is a finished round of the results roadmap
+type ColorGameRoadmapEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundId string            `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	Dice    []ColorGameReward `protobuf:"varint,2,rep,packed,name=dice,proto3,enum=colorgame.ColorGameReward" json:"dice,omitempty"` // 開獎結果，作廢回合為空
+	Voided  bool              `protobuf:"varint,3,opt,name=voided,proto3" json:"voided,omitempty"`
+	EndTime int64             `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"` // Unix 秒
+}
+
+func (x *ColorGameRoadmapEntry) Reset() {
+	*x = ColorGameRoadmapEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameRoadmapEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameRoadmapEntry) ProtoMessage() {}
+
+func (x *ColorGameRoadmapEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameRoadmapEntry.ProtoReflect.Descriptor instead.
+func (*ColorGameRoadmapEntry) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ColorGameRoadmapEntry) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameRoadmapEntry) GetDice() []ColorGameReward {
+	if x != nil {
+		return x.Dice
+	}
+	return nil
+}
+
+func (x *ColorGameRoadmapEntry) GetVoided() bool {
+	if x != nil {
+		return x.Voided
+	}
+	return false
+}
+
+func (x *ColorGameRoadmapEntry) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+// ColorGameColorStat is how often a color was drawn in the rounds of a roadmap
+type ColorGameColorStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Color  ColorGameReward `protobuf:"varint,1,opt,name=color,proto3,enum=colorgame.ColorGameReward" json:"color,omitempty"`
+	Count  int32           `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`   // 開出該顏色的骰子數
+	Rounds int32           `protobuf:"varint,3,opt,name=rounds,proto3" json:"rounds,omitempty"` // 開出該顏色的回合數
+}
+
+func (x *ColorGameColorStat) Reset() {
+	*x = ColorGameColorStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameColorStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameColorStat) ProtoMessage() {}
+
+func (x *ColorGameColorStat) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameColorStat.ProtoReflect.Descriptor instead.
+func (*ColorGameColorStat) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ColorGameColorStat) GetColor() ColorGameReward {
+	if x != nil {
+		return x.Color
+	}
+	return ColorGameReward_REWARD_UNSPECIFIED
+}
+
+func (x *ColorGameColorStat) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ColorGameColorStat) GetRounds() int32 {
+	if x != nil {
+		return x.Rounds
+	}
+	return 0
+}
+
+type ColorGameGetRoundHistoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableId string `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"` // 桌號，空值為預設桌
+	Limit   int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                   // 預設 20，最多 100
+}
+
+func (x *ColorGameGetRoundHistoryReq) Reset() {
+	*x = ColorGameGetRoundHistoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameGetRoundHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameGetRoundHistoryReq) ProtoMessage() {}
+
+func (x *ColorGameGetRoundHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameGetRoundHistoryReq.ProtoReflect.Descriptor instead.
+func (*ColorGameGetRoundHistoryReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ColorGameGetRoundHistoryReq) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGameGetRoundHistoryReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ColorGameGetRoundHistoryRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode common.ErrorCode         `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error     string                   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	TableId   string                   `protobuf:"bytes,3,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Rounds    []*ColorGameRoadmapEntry `protobuf:"bytes,4,rep,name=rounds,proto3" json:"rounds,omitempty"` // 由新到舊
+	Stats     []*ColorGameColorStat    `protobuf:"bytes,5,rep,name=stats,proto3" json:"stats,omitempty"`   // 依賠率表顏色順序，作廢回合不計
+}
+
+func (x *ColorGameGetRoundHistoryRsp) Reset() {
+	*x = ColorGameGetRoundHistoryRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameGetRoundHistoryRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameGetRoundHistoryRsp) ProtoMessage() {}
+
+func (x *ColorGameGetRoundHistoryRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameGetRoundHistoryRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameGetRoundHistoryRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ColorGameGetRoundHistoryRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameGetRoundHistoryRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ColorGameGetRoundHistoryRsp) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGameGetRoundHistoryRsp) GetRounds() []*ColorGameRoadmapEntry {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+func (x *ColorGameGetRoundHistoryRsp) GetStats() []*ColorGameColorStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type ColorGamePlayerBet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Color  ColorGameReward `protobuf:"varint,1,opt,name=color,proto3,enum=colorgame.ColorGameReward" json:"color,omitempty"`
+	Amount int64           `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *ColorGamePlayerBet) Reset() {
+	*x = ColorGamePlayerBet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGamePlayerBet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGamePlayerBet) ProtoMessage() {}
+
+func (x *ColorGamePlayerBet) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGamePlayerBet.ProtoReflect.Descriptor instead.
+func (*ColorGamePlayerBet) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ColorGamePlayerBet) GetColor() ColorGameReward {
+	if x != nil {
+		return x.Color
+	}
+	return ColorGameReward_REWARD_UNSPECIFIED
+}
+
+func (x *ColorGamePlayerBet) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type ColorGameGetCurrentRoundRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode           common.ErrorCode      `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	RoundId             string                `protobuf:"bytes,2,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	State               ColorGameState        `protobuf:"varint,3,opt,name=state,proto3,enum=colorgame.ColorGameState" json:"state,omitempty"`
+	BettingEndTimestamp int64                 `protobuf:"varint,4,opt,name=betting_end_timestamp,json=bettingEndTimestamp,proto3" json:"betting_end_timestamp,omitempty"`
+	PlayerBets          []*ColorGamePlayerBet `protobuf:"bytes,5,rep,name=player_bets,json=playerBets,proto3" json:"player_bets,omitempty"`
+	LeftTime            int64                 `protobuf:"varint,6,opt,name=left_time,json=leftTime,proto3" json:"left_time,omitempty"`
+	TableId             string                `protobuf:"bytes,7,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+}
+
+func (x *ColorGameGetCurrentRoundRsp) Reset() {
+	*x = ColorGameGetCurrentRoundRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameGetCurrentRoundRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameGetCurrentRoundRsp) ProtoMessage() {}
+
+func (x *ColorGameGetCurrentRoundRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameGetCurrentRoundRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameGetCurrentRoundRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ColorGameGetCurrentRoundRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameGetCurrentRoundRsp) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameGetCurrentRoundRsp) GetState() ColorGameState {
+	if x != nil {
+		return x.State
+	}
+	return ColorGameState_GAME_STATE_UNSPECIFIED
+}
+
+func (x *ColorGameGetCurrentRoundRsp) GetBettingEndTimestamp() int64 {
+	if x != nil {
+		return x.BettingEndTimestamp
+	}
+	return 0
+}
+
+func (x *ColorGameGetCurrentRoundRsp) GetPlayerBets() []*ColorGamePlayerBet {
+	if x != nil {
+		return x.PlayerBets
+	}
+	return nil
+}
+
+func (x *ColorGameGetCurrentRoundRsp) GetLeftTime() int64 {
+	if x != nil {
+		return x.LeftTime
+	}
+	return 0
+}
+
+func (x *ColorGameGetCurrentRoundRsp) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+type ColorGameRoundStateBRC struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundId             string         `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	State               ColorGameState `protobuf:"varint,2,opt,name=state,proto3,enum=colorgame.ColorGameState" json:"state,omitempty"`
+	BettingEndTimestamp int64          `protobuf:"varint,3,opt,name=betting_end_timestamp,json=bettingEndTimestamp,proto3" json:"betting_end_timestamp,omitempty"`
+	LeftTime            int64          `protobuf:"varint,4,opt,name=left_time,json=leftTime,proto3" json:"left_time,omitempty"`
+	// Provably fair (公平性驗證)
+	ServerSeedHash string `protobuf:"bytes,5,opt,name=server_seed_hash,json=serverSeedHash,proto3" json:"server_seed_hash,omitempty"` // sha256(server_seed)，回合開始即公布
+	ClientSeed     string `protobuf:"bytes,6,opt,name=client_seed,json=clientSeed,proto3" json:"client_seed,omitempty"`               // 公開種子
+	ServerSeed     string `protobuf:"bytes,7,opt,name=server_seed,json=serverSeed,proto3" json:"server_seed,omitempty"`               // 開獎後才揭露 (RESULT 之後)
+	TableId        string `protobuf:"bytes,8,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`                        // 回合所屬桌號
+	Seq            int64  `protobuf:"varint,9,opt,name=seq,proto3" json:"seq,omitempty"`                                              // 回合內事件序號，從 1 開始遞增 (PAUSED / STOPPED 延續上一回合的序號)
+}
+
+func (x *ColorGameRoundStateBRC) Reset() {
+	*x = ColorGameRoundStateBRC{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameRoundStateBRC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameRoundStateBRC) ProtoMessage() {}
+
+func (x *ColorGameRoundStateBRC) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameRoundStateBRC.ProtoReflect.Descriptor instead.
+func (*ColorGameRoundStateBRC) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ColorGameRoundStateBRC) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameRoundStateBRC) GetState() ColorGameState {
+	if x != nil {
+		return x.State
+	}
+	return ColorGameState_GAME_STATE_UNSPECIFIED
+}
+
+func (x *ColorGameRoundStateBRC) GetBettingEndTimestamp() int64 {
+	if x != nil {
+		return x.BettingEndTimestamp
+	}
+	return 0
+}
+
+func (x *ColorGameRoundStateBRC) GetLeftTime() int64 {
+	if x != nil {
+		return x.LeftTime
+	}
+	return 0
+}
+
+func (x *ColorGameRoundStateBRC) GetServerSeedHash() string {
+	if x != nil {
+		return x.ServerSeedHash
+	}
+	return ""
+}
+
+func (x *ColorGameRoundStateBRC) GetClientSeed() string {
+	if x != nil {
+		return x.ClientSeed
+	}
+	return ""
+}
+
+func (x *ColorGameRoundStateBRC) GetServerSeed() string {
+	if x != nil {
+		return x.ServerSeed
+	}
+	return ""
+}
+
+func (x *ColorGameRoundStateBRC) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGameRoundStateBRC) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+// ColorGameSettlementBRC is sent to users after round settlement
+// 有下注和無下注的玩家收到的欄位不同
+type ColorGameSettlementBRC struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundId      string          `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	WinningColor ColorGameReward `protobuf:"varint,2,opt,name=winning_color,json=winningColor,proto3,enum=colorgame.ColorGameReward" json:"winning_color,omitempty"`
+	// 以下欄位只有下注的玩家才有值
+	BetId     string            `protobuf:"bytes,3,opt,name=bet_id,json=betId,proto3" json:"bet_id,omitempty"`                                          // 下注 ID（無下注時為空）
+	BetColor  ColorGameReward   `protobuf:"varint,4,opt,name=bet_color,json=betColor,proto3,enum=colorgame.ColorGameReward" json:"bet_color,omitempty"` // 下注顏色（無下注時為空）
+	BetAmount int64             `protobuf:"varint,5,opt,name=bet_amount,json=betAmount,proto3" json:"bet_amount,omitempty"`                             // 下注金額（無下注時為 0）
+	WinAmount int64             `protobuf:"varint,6,opt,name=win_amount,json=winAmount,proto3" json:"win_amount,omitempty"`                             // 贏得金額（無下注或輸了時為 0）
+	IsWinner  bool              `protobuf:"varint,7,opt,name=is_winner,json=isWinner,proto3" json:"is_winner,omitempty"`                                // 是否贏家（無下注時為 false）
+	TableId   string            `protobuf:"bytes,8,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`                                    // 回合所屬桌號
+	Dice      []ColorGameReward `protobuf:"varint,9,rep,packed,name=dice,proto3,enum=colorgame.ColorGameReward" json:"dice,omitempty"`                  // 開出的所有骰子 (單色模式只有一個，winning_color 為第一個)
+	Matches   int32             `protobuf:"varint,10,opt,name=matches,proto3" json:"matches,omitempty"`                                                 // 與下注顏色相同的骰子數（無下注時為 0）
+}
+
+func (x *ColorGameSettlementBRC) Reset() {
+	*x = ColorGameSettlementBRC{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameSettlementBRC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameSettlementBRC) ProtoMessage() {}
+
+func (x *ColorGameSettlementBRC) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameSettlementBRC.ProtoReflect.Descriptor instead.
+func (*ColorGameSettlementBRC) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ColorGameSettlementBRC) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameSettlementBRC) GetWinningColor() ColorGameReward {
+	if x != nil {
+		return x.WinningColor
+	}
+	return ColorGameReward_REWARD_UNSPECIFIED
+}
+
+func (x *ColorGameSettlementBRC) GetBetId() string {
+	if x != nil {
+		return x.BetId
+	}
+	return ""
+}
+
+func (x *ColorGameSettlementBRC) GetBetColor() ColorGameReward {
+	if x != nil {
+		return x.BetColor
+	}
+	return ColorGameReward_REWARD_UNSPECIFIED
+}
+
+func (x *ColorGameSettlementBRC) GetBetAmount() int64 {
+	if x != nil {
+		return x.BetAmount
+	}
+	return 0
+}
+
+func (x *ColorGameSettlementBRC) GetWinAmount() int64 {
+	if x != nil {
+		return x.WinAmount
+	}
+	return 0
+}
+
+func (x *ColorGameSettlementBRC) GetIsWinner() bool {
+	if x != nil {
+		return x.IsWinner
+	}
+	return false
+}
+
+func (x *ColorGameSettlementBRC) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGameSettlementBRC) GetDice() []ColorGameReward {
+	if x != nil {
+		return x.Dice
+	}
+	return nil
+}
+
+func (x *ColorGameSettlementBRC) GetMatches() int32 {
+	if x != nil {
+		return x.Matches
+	}
+	return 0
+}
+
+// ColorGameRefundBRC is sent to each player whose bet was refunded because the round was voided
+type ColorGameRefundBRC struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableId      string          `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"` // 回合所屬桌號
+	RoundId      string          `protobuf:"bytes,2,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	BetId        string          `protobuf:"bytes,3,opt,name=bet_id,json=betId,proto3" json:"bet_id,omitempty"`
+	BetColor     ColorGameReward `protobuf:"varint,4,opt,name=bet_color,json=betColor,proto3,enum=colorgame.ColorGameReward" json:"bet_color,omitempty"`
+	RefundAmount int64           `protobuf:"varint,5,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"` // 退還金額 (= 下注金額)
+	Reason       string          `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`                                  // 作廢原因
+}
+
+func (x *ColorGameRefundBRC) Reset() {
+	*x = ColorGameRefundBRC{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameRefundBRC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameRefundBRC) ProtoMessage() {}
+
+func (x *ColorGameRefundBRC) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameRefundBRC.ProtoReflect.Descriptor instead.
+func (*ColorGameRefundBRC) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ColorGameRefundBRC) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGameRefundBRC) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameRefundBRC) GetBetId() string {
+	if x != nil {
+		return x.BetId
+	}
+	return ""
+}
+
+func (x *ColorGameRefundBRC) GetBetColor() ColorGameReward {
+	if x != nil {
+		return x.BetColor
+	}
+	return ColorGameReward_REWARD_UNSPECIFIED
+}
+
+func (x *ColorGameRefundBRC) GetRefundAmount() int64 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
+}
+
+func (x *ColorGameRefundBRC) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ColorGameBetPoolBRC is broadcast to the table during betting (at most every 500ms, only when the totals changed)
+type ColorGameBetPoolBRC struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableId      string              `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"` // 回合所屬桌號
+	RoundId      string              `protobuf:"bytes,2,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	Pools        []*ColorGameBetPool `protobuf:"bytes,3,rep,name=pools,proto3" json:"pools,omitempty"`                                    // 各顏色的下注總額，依顏色排序
+	TotalAmount  int64               `protobuf:"varint,4,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`    // 全部顏色的下注總額
+	TotalBettors int32               `protobuf:"varint,5,opt,name=total_bettors,json=totalBettors,proto3" json:"total_bettors,omitempty"` // 下注玩家數
+}
+
+func (x *ColorGameBetPoolBRC) Reset() {
+	*x = ColorGameBetPoolBRC{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameBetPoolBRC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameBetPoolBRC) ProtoMessage() {}
+
+func (x *ColorGameBetPoolBRC) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameBetPoolBRC.ProtoReflect.Descriptor instead.
+func (*ColorGameBetPoolBRC) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ColorGameBetPoolBRC) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGameBetPoolBRC) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameBetPoolBRC) GetPools() []*ColorGameBetPool {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *ColorGameBetPoolBRC) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *ColorGameBetPoolBRC) GetTotalBettors() int32 {
+	if x != nil {
+		return x.TotalBettors
+	}
+	return 0
+}
+
+type ColorGameBetPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Color   ColorGameReward `protobuf:"varint,1,opt,name=color,proto3,enum=colorgame.ColorGameReward" json:"color,omitempty"`
+	Amount  int64           `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`   // 該顏色的下注總額
+	Bettors int32           `protobuf:"varint,3,opt,name=bettors,proto3" json:"bettors,omitempty"` // 該顏色的下注玩家數
+}
+
+func (x *ColorGameBetPool) Reset() {
+	*x = ColorGameBetPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameBetPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameBetPool) ProtoMessage() {}
+
+func (x *ColorGameBetPool) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameBetPool.ProtoReflect.Descriptor instead.
+func (*ColorGameBetPool) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ColorGameBetPool) GetColor() ColorGameReward {
+	if x != nil {
+		return x.Color
+	}
+	return ColorGameReward_REWARD_UNSPECIFIED
+}
+
+func (x *ColorGameBetPool) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ColorGameBetPool) GetBettors() int32 {
+	if x != nil {
+		return x.Bettors
+	}
+	return 0
+}
+
+type ColorGameRoundResultReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundId string            `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	Result  ColorGameReward   `protobuf:"varint,2,opt,name=result,proto3,enum=colorgame.ColorGameReward" json:"result,omitempty"` // 第一個骰子 (單色模式的結果)
+	TableId string            `protobuf:"bytes,3,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Dice    []ColorGameReward `protobuf:"varint,4,rep,packed,name=dice,proto3,enum=colorgame.ColorGameReward" json:"dice,omitempty"` // 開出的所有骰子，舊版 GMS 未填時以 result 結算
+}
+
+func (x *ColorGameRoundResultReq) Reset() {
+	*x = ColorGameRoundResultReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameRoundResultReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameRoundResultReq) ProtoMessage() {}
+
+func (x *ColorGameRoundResultReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameRoundResultReq.ProtoReflect.Descriptor instead.
+func (*ColorGameRoundResultReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ColorGameRoundResultReq) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameRoundResultReq) GetResult() ColorGameReward {
+	if x != nil {
+		return x.Result
+	}
+	return ColorGameReward_REWARD_UNSPECIFIED
+}
+
+func (x *ColorGameRoundResultReq) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGameRoundResultReq) GetDice() []ColorGameReward {
+	if x != nil {
+		return x.Dice
+	}
+	return nil
+}
+
+type ColorGameRoundResultRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode common.ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error     string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ColorGameRoundResultRsp) Reset() {
+	*x = ColorGameRoundResultRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameRoundResultRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameRoundResultRsp) ProtoMessage() {}
+
+func (x *ColorGameRoundResultRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameRoundResultRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameRoundResultRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ColorGameRoundResultRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameRoundResultRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ColorGameVoidRoundReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundId string `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	TableId string `protobuf:"bytes,3,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+}
+
+func (x *ColorGameVoidRoundReq) Reset() {
+	*x = ColorGameVoidRoundReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameVoidRoundReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameVoidRoundReq) ProtoMessage() {}
+
+func (x *ColorGameVoidRoundReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameVoidRoundReq.ProtoReflect.Descriptor instead.
+func (*ColorGameVoidRoundReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ColorGameVoidRoundReq) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameVoidRoundReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ColorGameVoidRoundReq) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+type ColorGameVoidRoundRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode common.ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error     string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ColorGameVoidRoundRsp) Reset() {
+	*x = ColorGameVoidRoundRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameVoidRoundRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameVoidRoundRsp) ProtoMessage() {}
+
+func (x *ColorGameVoidRoundRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameVoidRoundRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameVoidRoundRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ColorGameVoidRoundRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameVoidRoundRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ColorGameCloseBettingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundId string `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	TableId string `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+}
+
+func (x *ColorGameCloseBettingReq) Reset() {
+	*x = ColorGameCloseBettingReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameCloseBettingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameCloseBettingReq) ProtoMessage() {}
+
+func (x *ColorGameCloseBettingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameCloseBettingReq.ProtoReflect.Descriptor instead.
+func (*ColorGameCloseBettingReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ColorGameCloseBettingReq) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameCloseBettingReq) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+type ColorGameCloseBettingRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode common.ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error     string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ColorGameCloseBettingRsp) Reset() {
+	*x = ColorGameCloseBettingRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameCloseBettingRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameCloseBettingRsp) ProtoMessage() {}
+
+func (x *ColorGameCloseBettingRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameCloseBettingRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameCloseBettingRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ColorGameCloseBettingRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameCloseBettingRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ColorGameVerifyRoundReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundId string `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+}
+
+func (x *ColorGameVerifyRoundReq) Reset() {
+	*x = ColorGameVerifyRoundReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameVerifyRoundReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameVerifyRoundReq) ProtoMessage() {}
+
+func (x *ColorGameVerifyRoundReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameVerifyRoundReq.ProtoReflect.Descriptor instead.
+func (*ColorGameVerifyRoundReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ColorGameVerifyRoundReq) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+type ColorGameVerifyRoundRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode      common.ErrorCode  `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error          string            `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	RoundId        string            `protobuf:"bytes,3,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	ServerSeed     string            `protobuf:"bytes,4,opt,name=server_seed,json=serverSeed,proto3" json:"server_seed,omitempty"`
+	ServerSeedHash string            `protobuf:"bytes,5,opt,name=server_seed_hash,json=serverSeedHash,proto3" json:"server_seed_hash,omitempty"`
+	ClientSeed     string            `protobuf:"bytes,6,opt,name=client_seed,json=clientSeed,proto3" json:"client_seed,omitempty"`
+	Result         ColorGameReward   `protobuf:"varint,7,opt,name=result,proto3,enum=colorgame.ColorGameReward" json:"result,omitempty"`                                         // 記錄的開獎結果
+	ComputedResult ColorGameReward   `protobuf:"varint,8,opt,name=computed_result,json=computedResult,proto3,enum=colorgame.ColorGameReward" json:"computed_result,omitempty"`   // 由種子重新計算的結果
+	HashMatched    bool              `protobuf:"varint,9,opt,name=hash_matched,json=hashMatched,proto3" json:"hash_matched,omitempty"`                                           // sha256(server_seed) == server_seed_hash
+	Verified       bool              `protobuf:"varint,10,opt,name=verified,proto3" json:"verified,omitempty"`                                                                   // hash_matched 且 computed_dice == dice
+	Dice           []ColorGameReward `protobuf:"varint,11,rep,packed,name=dice,proto3,enum=colorgame.ColorGameReward" json:"dice,omitempty"`                                     // 記錄的所有骰子
+	ComputedDice   []ColorGameReward `protobuf:"varint,12,rep,packed,name=computed_dice,json=computedDice,proto3,enum=colorgame.ColorGameReward" json:"computed_dice,omitempty"` // 由種子重新計算的所有骰子
+}
+
+func (x *ColorGameVerifyRoundRsp) Reset() {
+	*x = ColorGameVerifyRoundRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameVerifyRoundRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameVerifyRoundRsp) ProtoMessage() {}
+
+func (x *ColorGameVerifyRoundRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameVerifyRoundRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameVerifyRoundRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ColorGameVerifyRoundRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameVerifyRoundRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ColorGameVerifyRoundRsp) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameVerifyRoundRsp) GetServerSeed() string {
+	if x != nil {
+		return x.ServerSeed
+	}
+	return ""
+}
+
+func (x *ColorGameVerifyRoundRsp) GetServerSeedHash() string {
+	if x != nil {
+		return x.ServerSeedHash
+	}
+	return ""
+}
+
+func (x *ColorGameVerifyRoundRsp) GetClientSeed() string {
+	if x != nil {
+		return x.ClientSeed
+	}
+	return ""
+}
+
+func (x *ColorGameVerifyRoundRsp) GetResult() ColorGameReward {
+	if x != nil {
+		return x.Result
+	}
+	return ColorGameReward_REWARD_UNSPECIFIED
+}
+
+func (x *ColorGameVerifyRoundRsp) GetComputedResult() ColorGameReward {
+	if x != nil {
+		return x.ComputedResult
+	}
+	return ColorGameReward_REWARD_UNSPECIFIED
+}
+
+func (x *ColorGameVerifyRoundRsp) GetHashMatched() bool {
+	if x != nil {
+		return x.HashMatched
+	}
+	return false
+}
+
+func (x *ColorGameVerifyRoundRsp) GetVerified() bool {
+	if x != nil {
+		return x.Verified
+	}
+	return false
+}
+
+func (x *ColorGameVerifyRoundRsp) GetDice() []ColorGameReward {
+	if x != nil {
+		return x.Dice
+	}
+	return nil
+}
+
+func (x *ColorGameVerifyRoundRsp) GetComputedDice() []ColorGameReward {
+	if x != nil {
+		return x.ComputedDice
+	}
+	return nil
+}
+
+type ColorGameSubmitResultReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundId  string            `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	Result   ColorGameReward   `protobuf:"varint,2,opt,name=result,proto3,enum=colorgame.ColorGameReward" json:"result,omitempty"`
+	Operator string            `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"` // 提交結果的操作員 (審計用)
+	TableId  string            `protobuf:"bytes,4,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Dice     []ColorGameReward `protobuf:"varint,5,rep,packed,name=dice,proto3,enum=colorgame.ColorGameReward" json:"dice,omitempty"` // 三骰模式提交所有骰子，未填時為 [result]
+}
+
+func (x *ColorGameSubmitResultReq) Reset() {
+	*x = ColorGameSubmitResultReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameSubmitResultReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameSubmitResultReq) ProtoMessage() {}
+
+func (x *ColorGameSubmitResultReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameSubmitResultReq.ProtoReflect.Descriptor instead.
+func (*ColorGameSubmitResultReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ColorGameSubmitResultReq) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameSubmitResultReq) GetResult() ColorGameReward {
+	if x != nil {
+		return x.Result
+	}
+	return ColorGameReward_REWARD_UNSPECIFIED
+}
+
+func (x *ColorGameSubmitResultReq) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *ColorGameSubmitResultReq) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGameSubmitResultReq) GetDice() []ColorGameReward {
+	if x != nil {
+		return x.Dice
+	}
+	return nil
+}
+
+type ColorGameSubmitResultRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode common.ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error     string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ColorGameSubmitResultRsp) Reset() {
+	*x = ColorGameSubmitResultRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameSubmitResultRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameSubmitResultRsp) ProtoMessage() {}
+
+func (x *ColorGameSubmitResultRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameSubmitResultRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameSubmitResultRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ColorGameSubmitResultRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameSubmitResultRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ColorGamePauseTableReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableId  string `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (x *ColorGamePauseTableReq) Reset() {
+	*x = ColorGamePauseTableReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGamePauseTableReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGamePauseTableReq) ProtoMessage() {}
+
+func (x *ColorGamePauseTableReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGamePauseTableReq.ProtoReflect.Descriptor instead.
+func (*ColorGamePauseTableReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ColorGamePauseTableReq) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGamePauseTableReq) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+type ColorGamePauseTableRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode common.ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error     string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ColorGamePauseTableRsp) Reset() {
+	*x = ColorGamePauseTableRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGamePauseTableRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGamePauseTableRsp) ProtoMessage() {}
+
+func (x *ColorGamePauseTableRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGamePauseTableRsp.ProtoReflect.Descriptor instead.
+func (*ColorGamePauseTableRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ColorGamePauseTableRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGamePauseTableRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ColorGameResumeTableReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableId  string `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (x *ColorGameResumeTableReq) Reset() {
+	*x = ColorGameResumeTableReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameResumeTableReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameResumeTableReq) ProtoMessage() {}
+
+func (x *ColorGameResumeTableReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameResumeTableReq.ProtoReflect.Descriptor instead.
+func (*ColorGameResumeTableReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ColorGameResumeTableReq) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGameResumeTableReq) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+type ColorGameResumeTableRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode common.ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error     string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ColorGameResumeTableRsp) Reset() {
+	*x = ColorGameResumeTableRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameResumeTableRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameResumeTableRsp) ProtoMessage() {}
+
+func (x *ColorGameResumeTableRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameResumeTableRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameResumeTableRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ColorGameResumeTableRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameResumeTableRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ColorGameAdjustBettingReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableId      string `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	RoundId      string `protobuf:"bytes,2,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`                 // 必須是目前回合，避免調整到下一回合
+	DeltaSeconds int64  `protobuf:"varint,3,opt,name=delta_seconds,json=deltaSeconds,proto3" json:"delta_seconds,omitempty"` // 正數延長、負數縮短 (縮短到現在為止即立即停止下注)
+	Operator     string `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (x *ColorGameAdjustBettingReq) Reset() {
+	*x = ColorGameAdjustBettingReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameAdjustBettingReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameAdjustBettingReq) ProtoMessage() {}
+
+func (x *ColorGameAdjustBettingReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameAdjustBettingReq.ProtoReflect.Descriptor instead.
+func (*ColorGameAdjustBettingReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ColorGameAdjustBettingReq) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGameAdjustBettingReq) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameAdjustBettingReq) GetDeltaSeconds() int64 {
+	if x != nil {
+		return x.DeltaSeconds
+	}
+	return 0
+}
+
+func (x *ColorGameAdjustBettingReq) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+type ColorGameAdjustBettingRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode           common.ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error               string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	BettingEndTimestamp int64            `protobuf:"varint,3,opt,name=betting_end_timestamp,json=bettingEndTimestamp,proto3" json:"betting_end_timestamp,omitempty"` // 調整後的下注截止時間
+}
+
+func (x *ColorGameAdjustBettingRsp) Reset() {
+	*x = ColorGameAdjustBettingRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameAdjustBettingRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameAdjustBettingRsp) ProtoMessage() {}
+
+func (x *ColorGameAdjustBettingRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameAdjustBettingRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameAdjustBettingRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ColorGameAdjustBettingRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameAdjustBettingRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ColorGameAdjustBettingRsp) GetBettingEndTimestamp() int64 {
+	if x != nil {
+		return x.BettingEndTimestamp
+	}
+	return 0
+}
+
+type ColorGameVoidCurrentRoundReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableId  string `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	RoundId  string `protobuf:"bytes,2,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"` // 必須是目前回合
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Operator string `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (x *ColorGameVoidCurrentRoundReq) Reset() {
+	*x = ColorGameVoidCurrentRoundReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameVoidCurrentRoundReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameVoidCurrentRoundReq) ProtoMessage() {}
+
+func (x *ColorGameVoidCurrentRoundReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameVoidCurrentRoundReq.ProtoReflect.Descriptor instead.
+func (*ColorGameVoidCurrentRoundReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ColorGameVoidCurrentRoundReq) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGameVoidCurrentRoundReq) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameVoidCurrentRoundReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ColorGameVoidCurrentRoundReq) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+type ColorGameVoidCurrentRoundRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode common.ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error     string           `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *ColorGameVoidCurrentRoundRsp) Reset() {
+	*x = ColorGameVoidCurrentRoundRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameVoidCurrentRoundRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameVoidCurrentRoundRsp) ProtoMessage() {}
+
+func (x *ColorGameVoidCurrentRoundRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameVoidCurrentRoundRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameVoidCurrentRoundRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ColorGameVoidCurrentRoundRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameVoidCurrentRoundRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ColorGameRoundRecord is a persisted round as support looks it up
+type ColorGameRoundRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundId        string            `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	TableId        string            `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Status         string            `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                                    // IN_PROGRESS | ENDED | VOIDED
+	Dice           []ColorGameReward `protobuf:"varint,4,rep,packed,name=dice,proto3,enum=colorgame.ColorGameReward" json:"dice,omitempty"` // 開獎結果，未結束或作廢為空
+	StartTime      int64             `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`            // Unix 秒
+	EndTime        int64             `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`                  // Unix 秒，未結束為 0
+	TotalBets      int32             `protobuf:"varint,7,opt,name=total_bets,json=totalBets,proto3" json:"total_bets,omitempty"`
+	TotalPlayers   int32             `protobuf:"varint,8,opt,name=total_players,json=totalPlayers,proto3" json:"total_players,omitempty"`
+	TotalBetAmount int64             `protobuf:"varint,9,opt,name=total_bet_amount,json=totalBetAmount,proto3" json:"total_bet_amount,omitempty"`
+	ServerSeedHash string            `protobuf:"bytes,10,opt,name=server_seed_hash,json=serverSeedHash,proto3" json:"server_seed_hash,omitempty"`
+	ServerSeed     string            `protobuf:"bytes,11,opt,name=server_seed,json=serverSeed,proto3" json:"server_seed,omitempty"` // 開獎後公開
+	ClientSeed     string            `protobuf:"bytes,12,opt,name=client_seed,json=clientSeed,proto3" json:"client_seed,omitempty"`
+}
+
+func (x *ColorGameRoundRecord) Reset() {
+	*x = ColorGameRoundRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameRoundRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameRoundRecord) ProtoMessage() {}
+
+func (x *ColorGameRoundRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameRoundRecord.ProtoReflect.Descriptor instead.
+func (*ColorGameRoundRecord) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ColorGameRoundRecord) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameRoundRecord) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGameRoundRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ColorGameRoundRecord) GetDice() []ColorGameReward {
+	if x != nil {
+		return x.Dice
+	}
+	return nil
+}
+
+func (x *ColorGameRoundRecord) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ColorGameRoundRecord) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *ColorGameRoundRecord) GetTotalBets() int32 {
+	if x != nil {
+		return x.TotalBets
+	}
+	return 0
+}
+
+func (x *ColorGameRoundRecord) GetTotalPlayers() int32 {
+	if x != nil {
+		return x.TotalPlayers
+	}
+	return 0
+}
+
+func (x *ColorGameRoundRecord) GetTotalBetAmount() int64 {
+	if x != nil {
+		return x.TotalBetAmount
+	}
+	return 0
+}
+
+func (x *ColorGameRoundRecord) GetServerSeedHash() string {
+	if x != nil {
+		return x.ServerSeedHash
+	}
+	return ""
+}
+
+func (x *ColorGameRoundRecord) GetServerSeed() string {
+	if x != nil {
+		return x.ServerSeed
+	}
+	return ""
+}
+
+func (x *ColorGameRoundRecord) GetClientSeed() string {
+	if x != nil {
+		return x.ClientSeed
+	}
+	return ""
+}
+
+type ColorGameGetRoundReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundId string `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+}
+
+func (x *ColorGameGetRoundReq) Reset() {
+	*x = ColorGameGetRoundReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameGetRoundReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameGetRoundReq) ProtoMessage() {}
+
+func (x *ColorGameGetRoundReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameGetRoundReq.ProtoReflect.Descriptor instead.
+func (*ColorGameGetRoundReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ColorGameGetRoundReq) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+type ColorGameGetRoundRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode common.ErrorCode      `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error     string                `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Round     *ColorGameRoundRecord `protobuf:"bytes,3,opt,name=round,proto3" json:"round,omitempty"`
+}
+
+func (x *ColorGameGetRoundRsp) Reset() {
+	*x = ColorGameGetRoundRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameGetRoundRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameGetRoundRsp) ProtoMessage() {}
+
+func (x *ColorGameGetRoundRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameGetRoundRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameGetRoundRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ColorGameGetRoundRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameGetRoundRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ColorGameGetRoundRsp) GetRound() *ColorGameRoundRecord {
+	if x != nil {
+		return x.Round
+	}
+	return nil
+}
+
+type ColorGameListRoundsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TableId string `protobuf:"bytes,1,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"` // 空值為全部桌台
+	From    int64  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`                     // Unix 秒，預設 to 前 24 小時
+	To      int64  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`                         // Unix 秒 (不含)，預設現在
+	Limit   int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`                   // 預設 100，最多 500
+}
+
+func (x *ColorGameListRoundsReq) Reset() {
+	*x = ColorGameListRoundsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameListRoundsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameListRoundsReq) ProtoMessage() {}
+
+func (x *ColorGameListRoundsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameListRoundsReq.ProtoReflect.Descriptor instead.
+func (*ColorGameListRoundsReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ColorGameListRoundsReq) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGameListRoundsReq) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *ColorGameListRoundsReq) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *ColorGameListRoundsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ColorGameListRoundsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode common.ErrorCode        `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error     string                  `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Rounds    []*ColorGameRoundRecord `protobuf:"bytes,3,rep,name=rounds,proto3" json:"rounds,omitempty"` // 由新到舊
+}
+
+func (x *ColorGameListRoundsRsp) Reset() {
+	*x = ColorGameListRoundsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameListRoundsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameListRoundsRsp) ProtoMessage() {}
+
+func (x *ColorGameListRoundsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameListRoundsRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameListRoundsRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ColorGameListRoundsRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameListRoundsRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ColorGameListRoundsRsp) GetRounds() []*ColorGameRoundRecord {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+// ColorGameCompensation is a bet intent of the PlaceBet saga (a stake deduction whose bet was not placed)
+type ColorGameCompensation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId        string          `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"` // 錢包扣款交易 ID
+	RoundId     string          `protobuf:"bytes,2,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	UserId      int64           `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Color       ColorGameReward `protobuf:"varint,4,opt,name=color,proto3,enum=colorgame.ColorGameReward" json:"color,omitempty"`
+	Amount      int64           `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status      string          `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`      // PENDING | PLACED | COMPENSATING | COMPENSATED
+	Attempts    int32           `protobuf:"varint,7,opt,name=attempts,proto3" json:"attempts,omitempty"` // 已嘗試回滾次數
+	LastError   string          `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	NextRetryAt int64           `protobuf:"varint,9,opt,name=next_retry_at,json=nextRetryAt,proto3" json:"next_retry_at,omitempty"` // Unix 秒
+	CreatedAt   int64           `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`        // Unix 秒
+}
+
+func (x *ColorGameCompensation) Reset() {
+	*x = ColorGameCompensation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameCompensation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameCompensation) ProtoMessage() {}
+
+func (x *ColorGameCompensation) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameCompensation.ProtoReflect.Descriptor instead.
+func (*ColorGameCompensation) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ColorGameCompensation) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *ColorGameCompensation) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameCompensation) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ColorGameCompensation) GetColor() ColorGameReward {
+	if x != nil {
+		return x.Color
+	}
+	return ColorGameReward_REWARD_UNSPECIFIED
+}
+
+func (x *ColorGameCompensation) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ColorGameCompensation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ColorGameCompensation) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *ColorGameCompensation) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ColorGameCompensation) GetNextRetryAt() int64 {
+	if x != nil {
+		return x.NextRetryAt
+	}
+	return 0
+}
+
+func (x *ColorGameCompensation) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ColorGameListCompensationsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // 預設 COMPENSATING
+	Limit  int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`  // 預設 100
+}
+
+func (x *ColorGameListCompensationsReq) Reset() {
+	*x = ColorGameListCompensationsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameListCompensationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameListCompensationsReq) ProtoMessage() {}
+
+func (x *ColorGameListCompensationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameListCompensationsReq.ProtoReflect.Descriptor instead.
+func (*ColorGameListCompensationsReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ColorGameListCompensationsReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ColorGameListCompensationsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ColorGameListCompensationsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode     common.ErrorCode         `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error         string                   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Compensations []*ColorGameCompensation `protobuf:"bytes,3,rep,name=compensations,proto3" json:"compensations,omitempty"`
+}
+
+func (x *ColorGameListCompensationsRsp) Reset() {
+	*x = ColorGameListCompensationsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameListCompensationsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameListCompensationsRsp) ProtoMessage() {}
+
+func (x *ColorGameListCompensationsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameListCompensationsRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameListCompensationsRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ColorGameListCompensationsRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameListCompensationsRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ColorGameListCompensationsRsp) GetCompensations() []*ColorGameCompensation {
+	if x != nil {
+		return x.Compensations
+	}
+	return nil
+}
+
+type ColorGameRetryCompensationReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId     string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (x *ColorGameRetryCompensationReq) Reset() {
+	*x = ColorGameRetryCompensationReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameRetryCompensationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameRetryCompensationReq) ProtoMessage() {}
+
+func (x *ColorGameRetryCompensationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameRetryCompensationReq.ProtoReflect.Descriptor instead.
+func (*ColorGameRetryCompensationReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ColorGameRetryCompensationReq) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *ColorGameRetryCompensationReq) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+type ColorGameRetryCompensationRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode    common.ErrorCode       `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error        string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Compensation *ColorGameCompensation `protobuf:"bytes,3,opt,name=compensation,proto3" json:"compensation,omitempty"` // 重試後的狀態
+}
+
+func (x *ColorGameRetryCompensationRsp) Reset() {
+	*x = ColorGameRetryCompensationRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameRetryCompensationRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameRetryCompensationRsp) ProtoMessage() {}
+
+func (x *ColorGameRetryCompensationRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameRetryCompensationRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameRetryCompensationRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ColorGameRetryCompensationRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameRetryCompensationRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ColorGameRetryCompensationRsp) GetCompensation() *ColorGameCompensation {
+	if x != nil {
+		return x.Compensation
+	}
+	return nil
+}
+
+// ColorGameRoundSettlement is the settlement checkpoint of a round (GS)
+type ColorGameRoundSettlement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundId     string            `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+	TableId     string            `protobuf:"bytes,2,opt,name=table_id,json=tableId,proto3" json:"table_id,omitempty"`
+	Status      string            `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                                    // SETTLING | SETTLED
+	Dice        []ColorGameReward `protobuf:"varint,4,rep,packed,name=dice,proto3,enum=colorgame.ColorGameReward" json:"dice,omitempty"` // 開獎結果，作廢回合為空
+	VoidReason  string            `protobuf:"bytes,5,opt,name=void_reason,json=voidReason,proto3" json:"void_reason,omitempty"`          // 非空代表作廢退款
+	Owner       string            `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`                                      // 持有租約的 GS 實例
+	LeaseUntil  int64             `protobuf:"varint,7,opt,name=lease_until,json=leaseUntil,proto3" json:"lease_until,omitempty"`         // Unix 秒
+	Batches     int32             `protobuf:"varint,8,opt,name=batches,proto3" json:"batches,omitempty"`                                 // 已寫入的批次
+	BetCount    int32             `protobuf:"varint,9,opt,name=bet_count,json=betCount,proto3" json:"bet_count,omitempty"`               // 已結算 (退款) 注單數
+	TotalPayout int64             `protobuf:"varint,10,opt,name=total_payout,json=totalPayout,proto3" json:"total_payout,omitempty"`     // 已結算注單的派彩 (退款) 總額
+	Attempts    int32             `protobuf:"varint,11,opt,name=attempts,proto3" json:"attempts,omitempty"`                              // 認領次數，大於 1 代表曾被接管
+	LastError   string            `protobuf:"bytes,12,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt   int64             `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`    // Unix 秒
+	FinishedAt  int64             `protobuf:"varint,14,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"` // Unix 秒，未完成為 0
+}
+
+func (x *ColorGameRoundSettlement) Reset() {
+	*x = ColorGameRoundSettlement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameRoundSettlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameRoundSettlement) ProtoMessage() {}
+
+func (x *ColorGameRoundSettlement) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameRoundSettlement.ProtoReflect.Descriptor instead.
+func (*ColorGameRoundSettlement) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ColorGameRoundSettlement) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+func (x *ColorGameRoundSettlement) GetTableId() string {
+	if x != nil {
+		return x.TableId
+	}
+	return ""
+}
+
+func (x *ColorGameRoundSettlement) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ColorGameRoundSettlement) GetDice() []ColorGameReward {
+	if x != nil {
+		return x.Dice
+	}
+	return nil
+}
+
+func (x *ColorGameRoundSettlement) GetVoidReason() string {
+	if x != nil {
+		return x.VoidReason
+	}
+	return ""
+}
+
+func (x *ColorGameRoundSettlement) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ColorGameRoundSettlement) GetLeaseUntil() int64 {
+	if x != nil {
+		return x.LeaseUntil
+	}
+	return 0
+}
+
+func (x *ColorGameRoundSettlement) GetBatches() int32 {
+	if x != nil {
+		return x.Batches
+	}
+	return 0
+}
+
+func (x *ColorGameRoundSettlement) GetBetCount() int32 {
+	if x != nil {
+		return x.BetCount
+	}
+	return 0
+}
+
+func (x *ColorGameRoundSettlement) GetTotalPayout() int64 {
+	if x != nil {
+		return x.TotalPayout
+	}
+	return 0
+}
+
+func (x *ColorGameRoundSettlement) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *ColorGameRoundSettlement) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ColorGameRoundSettlement) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ColorGameRoundSettlement) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+type ColorGameGetSettlementReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundId string `protobuf:"bytes,1,opt,name=round_id,json=roundId,proto3" json:"round_id,omitempty"`
+}
+
+func (x *ColorGameGetSettlementReq) Reset() {
+	*x = ColorGameGetSettlementReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameGetSettlementReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameGetSettlementReq) ProtoMessage() {}
+
+func (x *ColorGameGetSettlementReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameGetSettlementReq.ProtoReflect.Descriptor instead.
+func (*ColorGameGetSettlementReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ColorGameGetSettlementReq) GetRoundId() string {
+	if x != nil {
+		return x.RoundId
+	}
+	return ""
+}
+
+type ColorGameGetSettlementRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode  common.ErrorCode          `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error      string                    `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Settlement *ColorGameRoundSettlement `protobuf:"bytes,3,opt,name=settlement,proto3" json:"settlement,omitempty"`
+}
+
+func (x *ColorGameGetSettlementRsp) Reset() {
+	*x = ColorGameGetSettlementRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameGetSettlementRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameGetSettlementRsp) ProtoMessage() {}
+
+func (x *ColorGameGetSettlementRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameGetSettlementRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameGetSettlementRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ColorGameGetSettlementRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameGetSettlementRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ColorGameGetSettlementRsp) GetSettlement() *ColorGameRoundSettlement {
+	if x != nil {
+		return x.Settlement
+	}
+	return nil
+}
+
+type ColorGameListSettlementsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // 預設 SETTLING
+	Limit  int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`  // 預設 100
+}
+
+func (x *ColorGameListSettlementsReq) Reset() {
+	*x = ColorGameListSettlementsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameListSettlementsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameListSettlementsReq) ProtoMessage() {}
+
+func (x *ColorGameListSettlementsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameListSettlementsReq.ProtoReflect.Descriptor instead.
+func (*ColorGameListSettlementsReq) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ColorGameListSettlementsReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ColorGameListSettlementsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ColorGameListSettlementsRsp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorCode   common.ErrorCode            `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=common.ErrorCode" json:"error_code,omitempty"`
+	Error       string                      `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Settlements []*ColorGameRoundSettlement `protobuf:"bytes,3,rep,name=settlements,proto3" json:"settlements,omitempty"`
+}
+
+func (x *ColorGameListSettlementsRsp) Reset() {
+	*x = ColorGameListSettlementsRsp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColorGameListSettlementsRsp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColorGameListSettlementsRsp) ProtoMessage() {}
+
+func (x *ColorGameListSettlementsRsp) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_colorgame_colorgame_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColorGameListSettlementsRsp.ProtoReflect.Descriptor instead.
+func (*ColorGameListSettlementsRsp) Descriptor() ([]byte, []int) {
+	return file_shared_proto_colorgame_colorgame_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ColorGameListSettlementsRsp) GetErrorCode() common.ErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return common.ErrorCode(0)
+}
+
+func (x *ColorGameListSettlementsRsp) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ColorGameListSettlementsRsp) GetSettlements() []*ColorGameRoundSettlement {
+	if x != nil {
+		return x.Settlements
+	}
+	return nil
+}
+
+var File_shared_proto_colorgame_colorgame_proto protoreflect.FileDescriptor
+
+var file_shared_proto_colorgame_colorgame_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67,
+	0x61, 0x6d, 0x65, 0x1a, 0x20, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbd, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47,
+	0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x75, 0x0a, 0x14, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61,
+	0x6d, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x65, 0x74, 0x52, 0x73, 0x70, 0x12, 0x30, 0x0a,
+	0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x15, 0x0a, 0x06, 0x62, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x62, 0x65, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x73, 0x0a, 0x10,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x42, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65,
+	0x12, 0x30, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x05, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x65, 0x74, 0x49,
+	0x64, 0x22, 0xaa, 0x01, 0x0a, 0x18, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x42, 0x65, 0x74, 0x53, 0x6c, 0x69, 0x70, 0x52, 0x65, 0x71, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x49, 0x64, 0x12, 0x31, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x42, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x95,
+	0x01, 0x0a, 0x18, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x42, 0x65, 0x74, 0x53, 0x6c, 0x69, 0x70, 0x52, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a,
+	0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61,
+	0x6d, 0x65, 0x42, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x90, 0x01, 0x0a, 0x11, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72,
+	0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x8e, 0x01, 0x0a, 0x11, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x65, 0x74, 0x52, 0x73, 0x70, 0x12,
+	0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x31, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x42, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c,
+	0x69, 0x6e, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xbe, 0x01, 0x0a, 0x18, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x75, 0x74,
+	0x6f, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x70, 0x4c, 0x6f, 0x73, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x70, 0x57, 0x69, 0x6e, 0x22, 0xb6, 0x01, 0x0a, 0x18,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x75,
+	0x74, 0x6f, 0x42, 0x65, 0x74, 0x52, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x6c, 0x69,
+	0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x42,
+	0x65, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x4c, 0x65, 0x66, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x22, 0x32, 0x0a, 0x17, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d,
+	0x65, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x17, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x65, 0x74,
+	0x52, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x85, 0x03, 0x0a, 0x12,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x62, 0x65, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x04,
+	0x64, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x69, 0x64, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xbe, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d,
+	0x65, 0x47, 0x65, 0x74, 0x42, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x6d, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0xbd, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61,
+	0x6d, 0x65, 0x47, 0x65, 0x74, 0x42, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d,
+	0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x22, 0xbe, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61,
+	0x6d, 0x65, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0xc6, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47,
+	0x61, 0x6d, 0x65, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x65, 0x74, 0x52, 0x73, 0x70, 0x12,
+	0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x62, 0x65, 0x74, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a,
+	0x10, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4a,
+	0x0a, 0x14, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x67, 0x0a, 0x14, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x4a,
+	0x73, 0x6f, 0x6e, 0x22, 0xb0, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a,
+	0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x30, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x05, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x15, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x65, 0x74, 0x52, 0x73, 0x70, 0x12,
+	0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xe4, 0x01, 0x0a, 0x15, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64,
+	0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x5f,
+	0x0a, 0x15, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x42, 0x65, 0x74, 0x52, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x51, 0x0a, 0x1b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x49, 0x64, 0x22, 0x70, 0x0a, 0x1b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x53, 0x65, 0x71, 0x22, 0xa6, 0x01, 0x0a, 0x1b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61,
+	0x6d, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x52, 0x43, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x95, 0x01,
+	0x0a, 0x15, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x6f, 0x61, 0x64, 0x6d,
+	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x04, 0x64, 0x69,
+	0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x69, 0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x76, 0x6f, 0x69, 0x64, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x74, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61,
+	0x6d, 0x65, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0x4e, 0x0a, 0x1b, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xef, 0x01, 0x0a, 0x1b,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x38,
+	0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x6f, 0x61, 0x64, 0x6d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x33, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x5e, 0x0a,
+	0x12, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x42, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x05,
+	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc7, 0x02,
+	0x0a, 0x1b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x73, 0x70, 0x12, 0x30, 0x0a,
+	0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x62,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x62, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x3e, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x62, 0x65, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x42, 0x65, 0x74, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x42, 0x65, 0x74, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x22, 0xce, 0x02, 0x0a, 0x16, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42,
+	0x52, 0x43, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x2f, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x32,
+	0x0a, 0x15, 0x62, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x62,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x65, 0x66, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x65, 0x66, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x28, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x53, 0x65, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22, 0x84, 0x03, 0x0a, 0x16, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x42, 0x52, 0x43, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x3f,
+	0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12,
+	0x15, 0x0a, 0x06, 0x62, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x62, 0x65, 0x74, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x62, 0x65, 0x74, 0x5f, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x08, 0x62, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x73, 0x5f, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x69, 0x73, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52,
+	0x04, 0x64, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22,
+	0xd7, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x42, 0x52, 0x43, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49,
+	0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06,
+	0x62, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x62, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61,
+	0x72, 0x64, 0x52, 0x08, 0x62, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xc6, 0x01, 0x0a, 0x13, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x42, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x42, 0x52,
+	0x43, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x42, 0x65, 0x74, 0x50,
+	0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x65, 0x74, 0x74, 0x6f,
+	0x72, 0x73, 0x22, 0x76, 0x0a, 0x10, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x42,
+	0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x30, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x62, 0x65, 0x74, 0x74, 0x6f, 0x72, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x17, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49,
+	0x64, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64,
+	0x12, 0x2e, 0x0a, 0x04, 0x64, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x04, 0x64, 0x69, 0x63, 0x65,
+	0x22, 0x61, 0x0a, 0x17, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x65, 0x0a, 0x15, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65,
+	0x56, 0x6f, 0x69, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x15, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x6f, 0x69, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x52, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x50, 0x0a, 0x18, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x62, 0x0a,
+	0x18, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x34, 0x0a, 0x17, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x91, 0x04, 0x0a, 0x17, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x52, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x43, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x0e, 0x63, 0x6f, 0x6d,
+	0x70, 0x75, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x68,
+	0x61, 0x73, 0x68, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x68, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x69,
+	0x63, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x52, 0x04, 0x64, 0x69, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x63, 0x65, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x0c, 0x63,
+	0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x64, 0x44, 0x69, 0x63, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x18,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x04, 0x64, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x04, 0x64, 0x69, 0x63, 0x65, 0x22, 0x62,
+	0x0a, 0x18, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x4f, 0x0a, 0x16, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x22, 0x60, 0x0a, 0x16, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x12, 0x30, 0x0a,
+	0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x50, 0x0a, 0x17, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x61, 0x0a, 0x17, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x92, 0x01, 0x0a, 0x19, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x42, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22,
+	0x97, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x64, 0x6a,
+	0x75, 0x73, 0x74, 0x42, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x73, 0x70, 0x12, 0x30, 0x0a,
+	0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x32, 0x0a, 0x15, 0x62, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x62, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x88, 0x01, 0x0a, 0x1c, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x6f, 0x69, 0x64, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x22, 0x66, 0x0a, 0x1c, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d,
+	0x65, 0x56, 0x6f, 0x69, 0x64, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x52, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa8, 0x03, 0x0a,
+	0x14, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x04, 0x64,
+	0x69, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x65, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x65, 0x74, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x42, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x65,
+	0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x65, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x65, 0x64, 0x22, 0x31, 0x0a, 0x14, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x95, 0x01, 0x0a, 0x14, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x52, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x35, 0x0a, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x22, 0x6d, 0x0a, 0x16, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x99, 0x01, 0x0a, 0x16, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x22, 0xc0, 0x02,
+	0x0a, 0x15, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x65,
+	0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x30, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x05, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x4d, 0x0a, 0x1d, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0xaf, 0x01, 0x0a, 0x1d, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x73,
+	0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x46, 0x0a, 0x0d, 0x63, 0x6f, 0x6d,
+	0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x50, 0x0a, 0x1d, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x22, 0xad, 0x01, 0x0a, 0x1d, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x44, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xc5, 0x03, 0x0a, 0x18, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e,
+	0x0a, 0x04, 0x64, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x52, 0x04, 0x64, 0x69, 0x63, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x76, 0x6f, 0x69, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x69, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x62, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x62, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x36, 0x0a, 0x19, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x49, 0x64, 0x22, 0xa8, 0x01, 0x0a, 0x19, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d,
+	0x65, 0x47, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x73,
+	0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x43, 0x0a, 0x0a, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x4b,
+	0x0a, 0x1b, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xac, 0x01, 0x0a, 0x1b,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x73, 0x70, 0x12, 0x30, 0x0a, 0x0a, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x45, 0x0a, 0x0b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x73,
+	0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0xf3, 0x01, 0x0a, 0x0e, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a,
+	0x16, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x41, 0x4d,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x53, 0x54,
+	0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x41, 0x4d, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x42, 0x45, 0x54, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12,
+	0x16, 0x0a, 0x12, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x52,
+	0x41, 0x57, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x41, 0x4d, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x10, 0x04, 0x12, 0x1a,
+	0x0a, 0x16, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x55,
+	0x4e, 0x44, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x41,
+	0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44,
+	0x10, 0x06, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x56, 0x4f, 0x49, 0x44, 0x45, 0x44, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x41, 0x4d,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x08,
+	0x2a, 0x92, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c,
+	0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x47, 0x52, 0x45, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x0f,
+	0x0a, 0x0b, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x42, 0x4c, 0x55, 0x45, 0x10, 0x03, 0x12,
+	0x11, 0x0a, 0x0d, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x59, 0x45, 0x4c, 0x4c, 0x4f, 0x57,
+	0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x57, 0x48, 0x49,
+	0x54, 0x45, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x50,
+	0x49, 0x4e, 0x4b, 0x10, 0x06, 0x32, 0xb0, 0x07, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47,
+	0x61, 0x6d, 0x65, 0x47, 0x53, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x08,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x42, 0x65, 0x74, 0x52, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x08, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x0b, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d,
+	0x65, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x12,
+	0x4f, 0x0a, 0x09, 0x56, 0x6f, 0x69, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x20, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61,
+	0x6d, 0x65, 0x56, 0x6f, 0x69, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x20,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x47, 0x61, 0x6d, 0x65, 0x56, 0x6f, 0x69, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x73, 0x70,
+	0x12, 0x58, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x23, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x42, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x73, 0x70, 0x12, 0x4f, 0x0a, 0x09, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x42, 0x65, 0x74, 0x12, 0x20, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x65, 0x74, 0x52, 0x73, 0x70, 0x12, 0x58, 0x0a, 0x0c, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x42, 0x65, 0x74, 0x53, 0x6c, 0x69, 0x70, 0x12, 0x23, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d,
+	0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x65, 0x74, 0x53, 0x6c, 0x69, 0x70, 0x52, 0x65, 0x71,
+	0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x42, 0x65, 0x74, 0x53, 0x6c,
+	0x69, 0x70, 0x52, 0x73, 0x70, 0x12, 0x43, 0x0a, 0x05, 0x52, 0x65, 0x62, 0x65, 0x74, 0x12, 0x1c,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x62, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x62, 0x65, 0x74, 0x52, 0x73, 0x70, 0x12, 0x58, 0x0a, 0x0c, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x23, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x65,
+	0x74, 0x52, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x75, 0x74, 0x6f,
+	0x42, 0x65, 0x74, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x75, 0x74,
+	0x6f, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x6f,
+	0x70, 0x41, 0x75, 0x74, 0x6f, 0x42, 0x65, 0x74, 0x52, 0x73, 0x70, 0x12, 0x5b, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x42, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61,
+	0x6d, 0x65, 0x47, 0x65, 0x74, 0x42, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x42, 0x65, 0x74, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x73, 0x70, 0x32, 0xb7, 0x04, 0x0a, 0x13, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x4d, 0x53, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x4f, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x65, 0x74, 0x12, 0x20, 0x2e,
+	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x20, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x42, 0x65, 0x74, 0x52, 0x73,
+	0x70, 0x12, 0x4f, 0x0a, 0x09, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x42, 0x65, 0x74, 0x12, 0x20,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x42, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x20, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x42, 0x65, 0x74, 0x52,
+	0x73, 0x70, 0x12, 0x61, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e,
+	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47,
+	0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x52, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x22, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x26, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x73, 0x70, 0x12,
+	0x61, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65,
+	0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x73, 0x70, 0x32, 0x84, 0x05, 0x0a, 0x18, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65,
+	0x47, 0x4d, 0x53, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x58, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x23, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x73, 0x70, 0x12, 0x52, 0x0a, 0x0a, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x73, 0x70, 0x12, 0x55, 0x0a,
+	0x0b, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x22, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x73, 0x70, 0x12, 0x5b, 0x0a, 0x0d, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x42, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x41, 0x64, 0x6a, 0x75, 0x73,
+	0x74, 0x42, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d,
+	0x65, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x42, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x73,
+	0x70, 0x12, 0x64, 0x0a, 0x10, 0x56, 0x6f, 0x69, 0x64, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x27, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d,
+	0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x56, 0x6f, 0x69, 0x64, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x27,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x47, 0x61, 0x6d, 0x65, 0x56, 0x6f, 0x69, 0x64, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x52,
+	0x6f, 0x75, 0x6e, 0x64, 0x52, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x1f, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x52, 0x73, 0x70, 0x12, 0x52, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x12, 0x21, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61,
+	0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x73, 0x70, 0x32, 0x8b, 0x04, 0x0a, 0x17, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x53, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x67, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x73, 0x70, 0x12, 0x67,
+	0x0a, 0x11, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f,
+	0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e,
+	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x6e, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x73, 0x70, 0x12, 0x5b, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x24,
+	0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
+	0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67,
+	0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x26, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x10, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x42, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x24, 0x2e, 0x63, 0x6f,
+	0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d,
+	0x65, 0x47, 0x65, 0x74, 0x42, 0x65, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x1a, 0x24, 0x2e, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6f, 0x72, 0x47, 0x61, 0x6d, 0x65, 0x47, 0x65, 0x74, 0x42, 0x65, 0x74, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x73, 0x70, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x65, 0x6c, 0x69, 0x2f,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2f, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x67,
+	0x61, 0x6d, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_shared_proto_colorgame_colorgame_proto_rawDescOnce sync.Once
+	file_shared_proto_colorgame_colorgame_proto_rawDescData = file_shared_proto_colorgame_colorgame_proto_rawDesc
+)
+
+func file_shared_proto_colorgame_colorgame_proto_rawDescGZIP() []byte {
+	file_shared_proto_colorgame_colorgame_proto_rawDescOnce.Do(func() {
+		file_shared_proto_colorgame_colorgame_proto_rawDescData = protoimpl.X.CompressGZIP(file_shared_proto_colorgame_colorgame_proto_rawDescData)
+	})
+	return file_shared_proto_colorgame_colorgame_proto_rawDescData
+}
+
+var file_shared_proto_colorgame_colorgame_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_shared_proto_colorgame_colorgame_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
+var file_shared_proto_colorgame_colorgame_proto_goTypes = []interface{}{
+	(ColorGameState)(0),                   // 0: colorgame.ColorGameState
+	(ColorGameReward)(0),                  // 1: colorgame.ColorGameReward
+	(*ColorGamePlaceBetReq)(nil),          // 2: colorgame.ColorGamePlaceBetReq
+	(*ColorGamePlaceBetRsp)(nil),          // 3: colorgame.ColorGamePlaceBetRsp
+	(*ColorGameBetLine)(nil),              // 4: colorgame.ColorGameBetLine
+	(*ColorGamePlaceBetSlipReq)(nil),      // 5: colorgame.ColorGamePlaceBetSlipReq
+	(*ColorGamePlaceBetSlipRsp)(nil),      // 6: colorgame.ColorGamePlaceBetSlipRsp
+	(*ColorGameRebetReq)(nil),             // 7: colorgame.ColorGameRebetReq
+	(*ColorGameRebetRsp)(nil),             // 8: colorgame.ColorGameRebetRsp
+	(*ColorGameStartAutoBetReq)(nil),      // 9: colorgame.ColorGameStartAutoBetReq
+	(*ColorGameStartAutoBetRsp)(nil),      // 10: colorgame.ColorGameStartAutoBetRsp
+	(*ColorGameStopAutoBetReq)(nil),       // 11: colorgame.ColorGameStopAutoBetReq
+	(*ColorGameStopAutoBetRsp)(nil),       // 12: colorgame.ColorGameStopAutoBetRsp
+	(*ColorGameBetRecord)(nil),            // 13: colorgame.ColorGameBetRecord
+	(*ColorGameGetBetHistoryReq)(nil),     // 14: colorgame.ColorGameGetBetHistoryReq
+	(*ColorGameGetBetHistoryRsp)(nil),     // 15: colorgame.ColorGameGetBetHistoryRsp
+	(*ColorGameCancelBetReq)(nil),         // 16: colorgame.ColorGameCancelBetReq
+	(*ColorGameCancelBetRsp)(nil),         // 17: colorgame.ColorGameCancelBetRsp
+	(*ColorGameGetStateReq)(nil),          // 18: colorgame.ColorGameGetStateReq
+	(*ColorGameGetStateRsp)(nil),          // 19: colorgame.ColorGameGetStateRsp
+	(*ColorGameRecordBetReq)(nil),         // 20: colorgame.ColorGameRecordBetReq
+	(*ColorGameRecordBetRsp)(nil),         // 21: colorgame.ColorGameRecordBetRsp
+	(*ColorGameRevokeBetReq)(nil),         // 22: colorgame.ColorGameRevokeBetReq
+	(*ColorGameRevokeBetRsp)(nil),         // 23: colorgame.ColorGameRevokeBetRsp
+	(*ColorGameGetCurrentRoundReq)(nil),   // 24: colorgame.ColorGameGetCurrentRoundReq
+	(*ColorGameGetRecentEventsReq)(nil),   // 25: colorgame.ColorGameGetRecentEventsReq
+	(*ColorGameGetRecentEventsRsp)(nil),   // 26: colorgame.ColorGameGetRecentEventsRsp
+	(*ColorGameRoadmapEntry)(nil),         // 27: colorgame.ColorGameRoadmapEntry
+	(*ColorGameColorStat)(nil),            // 28: colorgame.ColorGameColorStat
+	(*ColorGameGetRoundHistoryReq)(nil),   // 29: colorgame.ColorGameGetRoundHistoryReq
+	(*ColorGameGetRoundHistoryRsp)(nil),   // 30: colorgame.ColorGameGetRoundHistoryRsp
+	(*ColorGamePlayerBet)(nil),            // 31: colorgame.ColorGamePlayerBet
+	(*ColorGameGetCurrentRoundRsp)(nil),   // 32: colorgame.ColorGameGetCurrentRoundRsp
+	(*ColorGameRoundStateBRC)(nil),        // 33: colorgame.ColorGameRoundStateBRC
+	(*ColorGameSettlementBRC)(nil),        // 34: colorgame.ColorGameSettlementBRC
+	(*ColorGameRefundBRC)(nil),            // 35: colorgame.ColorGameRefundBRC
+	(*ColorGameBetPoolBRC)(nil),           // 36: colorgame.ColorGameBetPoolBRC
+	(*ColorGameBetPool)(nil),              // 37: colorgame.ColorGameBetPool
+	(*ColorGameRoundResultReq)(nil),       // 38: colorgame.ColorGameRoundResultReq
+	(*ColorGameRoundResultRsp)(nil),       // 39: colorgame.ColorGameRoundResultRsp
+	(*ColorGameVoidRoundReq)(nil),         // 40: colorgame.ColorGameVoidRoundReq
+	(*ColorGameVoidRoundRsp)(nil),         // 41: colorgame.ColorGameVoidRoundRsp
+	(*ColorGameCloseBettingReq)(nil),      // 42: colorgame.ColorGameCloseBettingReq
+	(*ColorGameCloseBettingRsp)(nil),      // 43: colorgame.ColorGameCloseBettingRsp
+	(*ColorGameVerifyRoundReq)(nil),       // 44: colorgame.ColorGameVerifyRoundReq
+	(*ColorGameVerifyRoundRsp)(nil),       // 45: colorgame.ColorGameVerifyRoundRsp
+	(*ColorGameSubmitResultReq)(nil),      // 46: colorgame.ColorGameSubmitResultReq
+	(*ColorGameSubmitResultRsp)(nil),      // 47: colorgame.ColorGameSubmitResultRsp
+	(*ColorGamePauseTableReq)(nil),        // 48: colorgame.ColorGamePauseTableReq
+	(*ColorGamePauseTableRsp)(nil),        // 49: colorgame.ColorGamePauseTableRsp
+	(*ColorGameResumeTableReq)(nil),       // 50: colorgame.ColorGameResumeTableReq
+	(*ColorGameResumeTableRsp)(nil),       // 51: colorgame.ColorGameResumeTableRsp
+	(*ColorGameAdjustBettingReq)(nil),     // 52: colorgame.ColorGameAdjustBettingReq
+	(*ColorGameAdjustBettingRsp)(nil),     // 53: colorgame.ColorGameAdjustBettingRsp
+	(*ColorGameVoidCurrentRoundReq)(nil),  // 54: colorgame.ColorGameVoidCurrentRoundReq
+	(*ColorGameVoidCurrentRoundRsp)(nil),  // 55: colorgame.ColorGameVoidCurrentRoundRsp
+	(*ColorGameRoundRecord)(nil),          // 56: colorgame.ColorGameRoundRecord
+	(*ColorGameGetRoundReq)(nil),          // 57: colorgame.ColorGameGetRoundReq
+	(*ColorGameGetRoundRsp)(nil),          // 58: colorgame.ColorGameGetRoundRsp
+	(*ColorGameListRoundsReq)(nil),        // 59: colorgame.ColorGameListRoundsReq
+	(*ColorGameListRoundsRsp)(nil),        // 60: colorgame.ColorGameListRoundsRsp
+	(*ColorGameCompensation)(nil),         // 61: colorgame.ColorGameCompensation
+	(*ColorGameListCompensationsReq)(nil), // 62: colorgame.ColorGameListCompensationsReq
+	(*ColorGameListCompensationsRsp)(nil), // 63: colorgame.ColorGameListCompensationsRsp
+	(*ColorGameRetryCompensationReq)(nil), // 64: colorgame.ColorGameRetryCompensationReq
+	(*ColorGameRetryCompensationRsp)(nil), // 65: colorgame.ColorGameRetryCompensationRsp
+	(*ColorGameRoundSettlement)(nil),      // 66: colorgame.ColorGameRoundSettlement
+	(*ColorGameGetSettlementReq)(nil),     // 67: colorgame.ColorGameGetSettlementReq
+	(*ColorGameGetSettlementRsp)(nil),     // 68: colorgame.ColorGameGetSettlementRsp
+	(*ColorGameListSettlementsReq)(nil),   // 69: colorgame.ColorGameListSettlementsReq
+	(*ColorGameListSettlementsRsp)(nil),   // 70: colorgame.ColorGameListSettlementsRsp
+	(common.ErrorCode)(0),                 // 71: common.ErrorCode
+}
+var file_shared_proto_colorgame_colorgame_proto_depIdxs = []int32{
+	1,   // 0: colorgame.ColorGamePlaceBetReq.color:type_name -> colorgame.ColorGameReward
+	71,  // 1: colorgame.ColorGamePlaceBetRsp.error_code:type_name -> common.ErrorCode
+	1,   // 2: colorgame.ColorGameBetLine.color:type_name -> colorgame.ColorGameReward
+	4,   // 3: colorgame.ColorGamePlaceBetSlipReq.lines:type_name -> colorgame.ColorGameBetLine
+	71,  // 4: colorgame.ColorGamePlaceBetSlipRsp.error_code:type_name -> common.ErrorCode
+	4,   // 5: colorgame.ColorGamePlaceBetSlipRsp.lines:type_name -> colorgame.ColorGameBetLine
+	71,  // 6: colorgame.ColorGameRebetRsp.error_code:type_name -> common.ErrorCode
+	4,   // 7: colorgame.ColorGameRebetRsp.lines:type_name -> colorgame.ColorGameBetLine
+	71,  // 8: colorgame.ColorGameStartAutoBetRsp.error_code:type_name -> common.ErrorCode
+	4,   // 9: colorgame.ColorGameStartAutoBetRsp.lines:type_name -> colorgame.ColorGameBetLine
+	71,  // 10: colorgame.ColorGameStopAutoBetRsp.error_code:type_name -> common.ErrorCode
+	1,   // 11: colorgame.ColorGameBetRecord.color:type_name -> colorgame.ColorGameReward
+	1,   // 12: colorgame.ColorGameBetRecord.dice:type_name -> colorgame.ColorGameReward
+	71,  // 13: colorgame.ColorGameGetBetHistoryRsp.error_code:type_name -> common.ErrorCode
+	13,  // 14: colorgame.ColorGameGetBetHistoryRsp.records:type_name -> colorgame.ColorGameBetRecord
+	1,   // 15: colorgame.ColorGameCancelBetReq.color:type_name -> colorgame.ColorGameReward
+	71,  // 16: colorgame.ColorGameCancelBetRsp.error_code:type_name -> common.ErrorCode
+	71,  // 17: colorgame.ColorGameGetStateRsp.error_code:type_name -> common.ErrorCode
+	1,   // 18: colorgame.ColorGameRecordBetReq.color:type_name -> colorgame.ColorGameReward
+	71,  // 19: colorgame.ColorGameRecordBetRsp.error_code:type_name -> common.ErrorCode
+	1,   // 20: colorgame.ColorGameRevokeBetReq.color:type_name -> colorgame.ColorGameReward
+	71,  // 21: colorgame.ColorGameRevokeBetRsp.error_code:type_name -> common.ErrorCode
+	71,  // 22: colorgame.ColorGameGetRecentEventsRsp.error_code:type_name -> common.ErrorCode
+	33,  // 23: colorgame.ColorGameGetRecentEventsRsp.events:type_name -> colorgame.ColorGameRoundStateBRC
+	1,   // 24: colorgame.ColorGameRoadmapEntry.dice:type_name -> colorgame.ColorGameReward
+	1,   // 25: colorgame.ColorGameColorStat.color:type_name -> colorgame.ColorGameReward
+	71,  // 26: colorgame.ColorGameGetRoundHistoryRsp.error_code:type_name -> common.ErrorCode
+	27,  // 27: colorgame.ColorGameGetRoundHistoryRsp.rounds:type_name -> colorgame.ColorGameRoadmapEntry
+	28,  // 28: colorgame.ColorGameGetRoundHistoryRsp.stats:type_name -> colorgame.ColorGameColorStat
+	1,   // 29: colorgame.ColorGamePlayerBet.color:type_name -> colorgame.ColorGameReward
+	71,  // 30: colorgame.ColorGameGetCurrentRoundRsp.error_code:type_name -> common.ErrorCode
+	0,   // 31: colorgame.ColorGameGetCurrentRoundRsp.state:type_name -> colorgame.ColorGameState
+	31,  // 32: colorgame.ColorGameGetCurrentRoundRsp.player_bets:type_name -> colorgame.ColorGamePlayerBet
+	0,   // 33: colorgame.ColorGameRoundStateBRC.state:type_name -> colorgame.ColorGameState
+	1,   // 34: colorgame.ColorGameSettlementBRC.winning_color:type_name -> colorgame.ColorGameReward
+	1,   // 35: colorgame.ColorGameSettlementBRC.bet_color:type_name -> colorgame.ColorGameReward
+	1,   // 36: colorgame.ColorGameSettlementBRC.dice:type_name -> colorgame.ColorGameReward
+	1,   // 37: colorgame.ColorGameRefundBRC.bet_color:type_name -> colorgame.ColorGameReward
+	37,  // 38: colorgame.ColorGameBetPoolBRC.pools:type_name -> colorgame.ColorGameBetPool
+	1,   // 39: colorgame.ColorGameBetPool.color:type_name -> colorgame.ColorGameReward
+	1,   // 40: colorgame.ColorGameRoundResultReq.result:type_name -> colorgame.ColorGameReward
+	1,   // 41: colorgame.ColorGameRoundResultReq.dice:type_name -> colorgame.ColorGameReward
+	71,  // 42: colorgame.ColorGameRoundResultRsp.error_code:type_name -> common.ErrorCode
+	71,  // 43: colorgame.ColorGameVoidRoundRsp.error_code:type_name -> common.ErrorCode
+	71,  // 44: colorgame.ColorGameCloseBettingRsp.error_code:type_name -> common.ErrorCode
+	71,  // 45: colorgame.ColorGameVerifyRoundRsp.error_code:type_name -> common.ErrorCode
+	1,   // 46: colorgame.ColorGameVerifyRoundRsp.result:type_name -> colorgame.ColorGameReward
+	1,   // 47: colorgame.ColorGameVerifyRoundRsp.computed_result:type_name -> colorgame.ColorGameReward
+	1,   // 48: colorgame.ColorGameVerifyRoundRsp.dice:type_name -> colorgame.ColorGameReward
+	1,   // 49: colorgame.ColorGameVerifyRoundRsp.computed_dice:type_name -> colorgame.ColorGameReward
+	1,   // 50: colorgame.ColorGameSubmitResultReq.result:type_name -> colorgame.ColorGameReward
+	1,   // 51: colorgame.ColorGameSubmitResultReq.dice:type_name -> colorgame.ColorGameReward
+	71,  // 52: colorgame.ColorGameSubmitResultRsp.error_code:type_name -> common.ErrorCode
+	71,  // 53: colorgame.ColorGamePauseTableRsp.error_code:type_name -> common.ErrorCode
+	71,  // 54: colorgame.ColorGameResumeTableRsp.error_code:type_name -> common.ErrorCode
+	71,  // 55: colorgame.ColorGameAdjustBettingRsp.error_code:type_name -> common.ErrorCode
+	71,  // 56: colorgame.ColorGameVoidCurrentRoundRsp.error_code:type_name -> common.ErrorCode
+	1,   // 57: colorgame.ColorGameRoundRecord.dice:type_name -> colorgame.ColorGameReward
+	71,  // 58: colorgame.ColorGameGetRoundRsp.error_code:type_name -> common.ErrorCode
+	56,  // 59: colorgame.ColorGameGetRoundRsp.round:type_name -> colorgame.ColorGameRoundRecord
+	71,  // 60: colorgame.ColorGameListRoundsRsp.error_code:type_name -> common.ErrorCode
+	56,  // 61: colorgame.ColorGameListRoundsRsp.rounds:type_name -> colorgame.ColorGameRoundRecord
+	1,   // 62: colorgame.ColorGameCompensation.color:type_name -> colorgame.ColorGameReward
+	71,  // 63: colorgame.ColorGameListCompensationsRsp.error_code:type_name -> common.ErrorCode
+	61,  // 64: colorgame.ColorGameListCompensationsRsp.compensations:type_name -> colorgame.ColorGameCompensation
+	71,  // 65: colorgame.ColorGameRetryCompensationRsp.error_code:type_name -> common.ErrorCode
+	61,  // 66: colorgame.ColorGameRetryCompensationRsp.compensation:type_name -> colorgame.ColorGameCompensation
+	1,   // 67: colorgame.ColorGameRoundSettlement.dice:type_name -> colorgame.ColorGameReward
+	71,  // 68: colorgame.ColorGameGetSettlementRsp.error_code:type_name -> common.ErrorCode
+	66,  // 69: colorgame.ColorGameGetSettlementRsp.settlement:type_name -> colorgame.ColorGameRoundSettlement
+	71,  // 70: colorgame.ColorGameListSettlementsRsp.error_code:type_name -> common.ErrorCode
+	66,  // 71: colorgame.ColorGameListSettlementsRsp.settlements:type_name -> colorgame.ColorGameRoundSettlement
+	2,   // 72: colorgame.ColorGameGSService.PlaceBet:input_type -> colorgame.ColorGamePlaceBetReq
+	18,  // 73: colorgame.ColorGameGSService.GetState:input_type -> colorgame.ColorGameGetStateReq
+	38,  // 74: colorgame.ColorGameGSService.RoundResult:input_type -> colorgame.ColorGameRoundResultReq
+	40,  // 75: colorgame.ColorGameGSService.VoidRound:input_type -> colorgame.ColorGameVoidRoundReq
+	42,  // 76: colorgame.ColorGameGSService.CloseBetting:input_type -> colorgame.ColorGameCloseBettingReq
+	16,  // 77: colorgame.ColorGameGSService.CancelBet:input_type -> colorgame.ColorGameCancelBetReq
+	5,   // 78: colorgame.ColorGameGSService.PlaceBetSlip:input_type -> colorgame.ColorGamePlaceBetSlipReq
+	7,   // 79: colorgame.ColorGameGSService.Rebet:input_type -> colorgame.ColorGameRebetReq
+	9,   // 80: colorgame.ColorGameGSService.StartAutoBet:input_type -> colorgame.ColorGameStartAutoBetReq
+	11,  // 81: colorgame.ColorGameGSService.StopAutoBet:input_type -> colorgame.ColorGameStopAutoBetReq
+	14,  // 82: colorgame.ColorGameGSService.GetBetHistory:input_type -> colorgame.ColorGameGetBetHistoryReq
+	20,  // 83: colorgame.ColorGameGMSService.RecordBet:input_type -> colorgame.ColorGameRecordBetReq
+	22,  // 84: colorgame.ColorGameGMSService.RevokeBet:input_type -> colorgame.ColorGameRevokeBetReq
+	24,  // 85: colorgame.ColorGameGMSService.GetCurrentRound:input_type -> colorgame.ColorGameGetCurrentRoundReq
+	44,  // 86: colorgame.ColorGameGMSService.VerifyRound:input_type -> colorgame.ColorGameVerifyRoundReq
+	25,  // 87: colorgame.ColorGameGMSService.GetRecentEvents:input_type -> colorgame.ColorGameGetRecentEventsReq
+	29,  // 88: colorgame.ColorGameGMSService.GetRoundHistory:input_type -> colorgame.ColorGameGetRoundHistoryReq
+	46,  // 89: colorgame.ColorGameGMSAdminService.SubmitResult:input_type -> colorgame.ColorGameSubmitResultReq
+	48,  // 90: colorgame.ColorGameGMSAdminService.PauseTable:input_type -> colorgame.ColorGamePauseTableReq
+	50,  // 91: colorgame.ColorGameGMSAdminService.ResumeTable:input_type -> colorgame.ColorGameResumeTableReq
+	52,  // 92: colorgame.ColorGameGMSAdminService.AdjustBetting:input_type -> colorgame.ColorGameAdjustBettingReq
+	54,  // 93: colorgame.ColorGameGMSAdminService.VoidCurrentRound:input_type -> colorgame.ColorGameVoidCurrentRoundReq
+	57,  // 94: colorgame.ColorGameGMSAdminService.GetRound:input_type -> colorgame.ColorGameGetRoundReq
+	59,  // 95: colorgame.ColorGameGMSAdminService.ListRounds:input_type -> colorgame.ColorGameListRoundsReq
+	62,  // 96: colorgame.ColorGameGSAdminService.ListCompensations:input_type -> colorgame.ColorGameListCompensationsReq
+	64,  // 97: colorgame.ColorGameGSAdminService.RetryCompensation:input_type -> colorgame.ColorGameRetryCompensationReq
+	67,  // 98: colorgame.ColorGameGSAdminService.GetSettlement:input_type -> colorgame.ColorGameGetSettlementReq
+	69,  // 99: colorgame.ColorGameGSAdminService.ListSettlements:input_type -> colorgame.ColorGameListSettlementsReq
+	14,  // 100: colorgame.ColorGameGSAdminService.SearchBetHistory:input_type -> colorgame.ColorGameGetBetHistoryReq
+	3,   // 101: colorgame.ColorGameGSService.PlaceBet:output_type -> colorgame.ColorGamePlaceBetRsp
+	19,  // 102: colorgame.ColorGameGSService.GetState:output_type -> colorgame.ColorGameGetStateRsp
+	39,  // 103: colorgame.ColorGameGSService.RoundResult:output_type -> colorgame.ColorGameRoundResultRsp
+	41,  // 104: colorgame.ColorGameGSService.VoidRound:output_type -> colorgame.ColorGameVoidRoundRsp
+	43,  // 105: colorgame.ColorGameGSService.CloseBetting:output_type -> colorgame.ColorGameCloseBettingRsp
+	17,  // 106: colorgame.ColorGameGSService.CancelBet:output_type -> colorgame.ColorGameCancelBetRsp
+	6,   // 107: colorgame.ColorGameGSService.PlaceBetSlip:output_type -> colorgame.ColorGamePlaceBetSlipRsp
+	8,   // 108: colorgame.ColorGameGSService.Rebet:output_type -> colorgame.ColorGameRebetRsp
+	10,  // 109: colorgame.ColorGameGSService.StartAutoBet:output_type -> colorgame.ColorGameStartAutoBetRsp
+	12,  // 110: colorgame.ColorGameGSService.StopAutoBet:output_type -> colorgame.ColorGameStopAutoBetRsp
+	15,  // 111: colorgame.ColorGameGSService.GetBetHistory:output_type -> colorgame.ColorGameGetBetHistoryRsp
+	21,  // 112: colorgame.ColorGameGMSService.RecordBet:output_type -> colorgame.ColorGameRecordBetRsp
+	23,  // 113: colorgame.ColorGameGMSService.RevokeBet:output_type -> colorgame.ColorGameRevokeBetRsp
+	32,  // 114: colorgame.ColorGameGMSService.GetCurrentRound:output_type -> colorgame.ColorGameGetCurrentRoundRsp
+	45,  // 115: colorgame.ColorGameGMSService.VerifyRound:output_type -> colorgame.ColorGameVerifyRoundRsp
+	26,  // 116: colorgame.ColorGameGMSService.GetRecentEvents:output_type -> colorgame.ColorGameGetRecentEventsRsp
+	30,  // 117: colorgame.ColorGameGMSService.GetRoundHistory:output_type -> colorgame.ColorGameGetRoundHistoryRsp
+	47,  // 118: colorgame.ColorGameGMSAdminService.SubmitResult:output_type -> colorgame.ColorGameSubmitResultRsp
+	49,  // 119: colorgame.ColorGameGMSAdminService.PauseTable:output_type -> colorgame.ColorGamePauseTableRsp
+	51,  // 120: colorgame.ColorGameGMSAdminService.ResumeTable:output_type -> colorgame.ColorGameResumeTableRsp
+	53,  // 121: colorgame.ColorGameGMSAdminService.AdjustBetting:output_type -> colorgame.ColorGameAdjustBettingRsp
+	55,  // 122: colorgame.ColorGameGMSAdminService.VoidCurrentRound:output_type -> colorgame.ColorGameVoidCurrentRoundRsp
+	58,  // 123: colorgame.ColorGameGMSAdminService.GetRound:output_type -> colorgame.ColorGameGetRoundRsp
+	60,  // 124: colorgame.ColorGameGMSAdminService.ListRounds:output_type -> colorgame.ColorGameListRoundsRsp
+	63,  // 125: colorgame.ColorGameGSAdminService.ListCompensations:output_type -> colorgame.ColorGameListCompensationsRsp
+	65,  // 126: colorgame.ColorGameGSAdminService.RetryCompensation:output_type -> colorgame.ColorGameRetryCompensationRsp
+	68,  // 127: colorgame.ColorGameGSAdminService.GetSettlement:output_type -> colorgame.ColorGameGetSettlementRsp
+	70,  // 128: colorgame.ColorGameGSAdminService.ListSettlements:output_type -> colorgame.ColorGameListSettlementsRsp
+	15,  // 129: colorgame.ColorGameGSAdminService.SearchBetHistory:output_type -> colorgame.ColorGameGetBetHistoryRsp
+	101, // [101:130] is the sub-list for method output_type
+	72,  // [72:101] is the sub-list for method input_type
+	72,  // [72:72] is the sub-list for extension type_name
+	72,  // [72:72] is the sub-list for extension extendee
+	0,   // [0:72] is the sub-list for field type_name
+}
+
+func init() { file_shared_proto_colorgame_colorgame_proto_init() }
+func file_shared_proto_colorgame_colorgame_proto_init() {
+	if File_shared_proto_colorgame_colorgame_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGamePlaceBetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGamePlaceBetRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameBetLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGamePlaceBetSlipReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGamePlaceBetSlipRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameRebetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameRebetRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameStartAutoBetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameStartAutoBetRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameStopAutoBetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameStopAutoBetRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameBetRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameGetBetHistoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameGetBetHistoryRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameCancelBetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameCancelBetRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameGetStateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameGetStateRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameRecordBetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameRecordBetRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameRevokeBetReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameRevokeBetRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameGetCurrentRoundReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameGetRecentEventsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameGetRecentEventsRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameRoadmapEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameColorStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameGetRoundHistoryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameGetRoundHistoryRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGamePlayerBet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameGetCurrentRoundRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameRoundStateBRC); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameSettlementBRC); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameRefundBRC); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameBetPoolBRC); i {
+			case 0:
+				return &v.state
 			case 1:
 				return &v.sizeCache
 			case 2:
@@ -1228,8 +6637,8 @@ func file_shared_proto_colorgame_colorgame_proto_init() {
 				return nil
 			}
 		}
-		file_shared_proto_colorgame_colorgame_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColorGameGetStateReq); i {
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameBetPool); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1240,8 +6649,8 @@ func file_shared_proto_colorgame_colorgame_proto_init() {
 				return nil
 			}
 		}
-		file_shared_proto_colorgame_colorgame_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColorGameGetStateRsp); i {
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameRoundResultReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1252,8 +6661,8 @@ func file_shared_proto_colorgame_colorgame_proto_init() {
 				return nil
 			}
 		}
-		file_shared_proto_colorgame_colorgame_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColorGameRecordBetReq); i {
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameRoundResultRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1264,8 +6673,8 @@ func file_shared_proto_colorgame_colorgame_proto_init() {
 				return nil
 			}
 		}
-		file_shared_proto_colorgame_colorgame_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColorGameRecordBetRsp); i {
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameVoidRoundReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1276,8 +6685,8 @@ func file_shared_proto_colorgame_colorgame_proto_init() {
 				return nil
 			}
 		}
-		file_shared_proto_colorgame_colorgame_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColorGameGetCurrentRoundReq); i {
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameVoidRoundRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1288,8 +6697,8 @@ func file_shared_proto_colorgame_colorgame_proto_init() {
 				return nil
 			}
 		}
-		file_shared_proto_colorgame_colorgame_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColorGamePlayerBet); i {
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameCloseBettingReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1300,8 +6709,8 @@ func file_shared_proto_colorgame_colorgame_proto_init() {
 				return nil
 			}
 		}
-		file_shared_proto_colorgame_colorgame_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColorGameGetCurrentRoundRsp); i {
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameCloseBettingRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1312,8 +6721,8 @@ func file_shared_proto_colorgame_colorgame_proto_init() {
 				return nil
 			}
 		}
-		file_shared_proto_colorgame_colorgame_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColorGameRoundStateBRC); i {
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameVerifyRoundReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1324,8 +6733,8 @@ func file_shared_proto_colorgame_colorgame_proto_init() {
 				return nil
 			}
 		}
-		file_shared_proto_colorgame_colorgame_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColorGameSettlementBRC); i {
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameVerifyRoundRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1336,8 +6745,8 @@ func file_shared_proto_colorgame_colorgame_proto_init() {
 				return nil
 			}
 		}
-		file_shared_proto_colorgame_colorgame_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColorGameRoundResultReq); i {
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameSubmitResultReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1348,8 +6757,284 @@ func file_shared_proto_colorgame_colorgame_proto_init() {
 				return nil
 			}
 		}
-		file_shared_proto_colorgame_colorgame_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ColorGameRoundResultRsp); i {
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameSubmitResultRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGamePauseTableReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGamePauseTableRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameResumeTableReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameResumeTableRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameAdjustBettingReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameAdjustBettingRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameVoidCurrentRoundReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameVoidCurrentRoundRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameRoundRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameGetRoundReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameGetRoundRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameListRoundsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameListRoundsRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameCompensation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameListCompensationsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameListCompensationsRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameRetryCompensationReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameRetryCompensationRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameRoundSettlement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameGetSettlementReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameGetSettlementRsp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameListSettlementsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shared_proto_colorgame_colorgame_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColorGameListSettlementsRsp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1367,9 +7052,9 @@ func file_shared_proto_colorgame_colorgame_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_shared_proto_colorgame_colorgame_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   13,
+			NumMessages:   69,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   4,
 		},
 		GoTypes:           file_shared_proto_colorgame_colorgame_proto_goTypes,
 		DependencyIndexes: file_shared_proto_colorgame_colorgame_proto_depIdxs,
@@ -28,6 +28,22 @@ type ColorGameGSServiceClient interface {
 	GetState(ctx context.Context, in *ColorGameGetStateReq, opts ...grpc.CallOption) (*ColorGameGetStateRsp, error)
 	// RoundResult handles round result notification from GMS
 	RoundResult(ctx context.Context, in *ColorGameRoundResultReq, opts ...grpc.CallOption) (*ColorGameRoundResultRsp, error)
+	// VoidRound handles round void notification from GMS (refund all bets)
+	VoidRound(ctx context.Context, in *ColorGameVoidRoundReq, opts ...grpc.CallOption) (*ColorGameVoidRoundRsp, error)
+	// CloseBetting handles the end of the betting phase of a round from GMS, bets of the round can no longer be cancelled
+	CloseBetting(ctx context.Context, in *ColorGameCloseBettingReq, opts ...grpc.CallOption) (*ColorGameCloseBettingRsp, error)
+	// CancelBet cancels or reduces a bet of the player while the round is betting (refunds the stake)
+	CancelBet(ctx context.Context, in *ColorGameCancelBetReq, opts ...grpc.CallOption) (*ColorGameCancelBetRsp, error)
+	// PlaceBetSlip places bets on several colors at once, every line is placed or none
+	PlaceBetSlip(ctx context.Context, in *ColorGamePlaceBetSlipReq, opts ...grpc.CallOption) (*ColorGamePlaceBetSlipRsp, error)
+	// Rebet places the last settled bet slip of the player again (multiplier 2 doubles it)
+	Rebet(ctx context.Context, in *ColorGameRebetReq, opts ...grpc.CallOption) (*ColorGameRebetRsp, error)
+	// StartAutoBet repeats the last settled bet slip in the next rounds until a round count or threshold is reached
+	StartAutoBet(ctx context.Context, in *ColorGameStartAutoBetReq, opts ...grpc.CallOption) (*ColorGameStartAutoBetRsp, error)
+	// StopAutoBet stops the auto bet of the player
+	StopAutoBet(ctx context.Context, in *ColorGameStopAutoBetReq, opts ...grpc.CallOption) (*ColorGameStopAutoBetRsp, error)
+	// GetBetHistory returns the bet orders of a player newest first, with the result of each round
+	GetBetHistory(ctx context.Context, in *ColorGameGetBetHistoryReq, opts ...grpc.CallOption) (*ColorGameGetBetHistoryRsp, error)
 }
 
 type colorGameGSServiceClient struct {
@@ -65,6 +81,78 @@ func (c *colorGameGSServiceClient) RoundResult(ctx context.Context, in *ColorGam
 	return out, nil
 }
 
+func (c *colorGameGSServiceClient) VoidRound(ctx context.Context, in *ColorGameVoidRoundReq, opts ...grpc.CallOption) (*ColorGameVoidRoundRsp, error) {
+	out := new(ColorGameVoidRoundRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGSService/VoidRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGSServiceClient) CloseBetting(ctx context.Context, in *ColorGameCloseBettingReq, opts ...grpc.CallOption) (*ColorGameCloseBettingRsp, error) {
+	out := new(ColorGameCloseBettingRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGSService/CloseBetting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGSServiceClient) CancelBet(ctx context.Context, in *ColorGameCancelBetReq, opts ...grpc.CallOption) (*ColorGameCancelBetRsp, error) {
+	out := new(ColorGameCancelBetRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGSService/CancelBet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGSServiceClient) PlaceBetSlip(ctx context.Context, in *ColorGamePlaceBetSlipReq, opts ...grpc.CallOption) (*ColorGamePlaceBetSlipRsp, error) {
+	out := new(ColorGamePlaceBetSlipRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGSService/PlaceBetSlip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGSServiceClient) Rebet(ctx context.Context, in *ColorGameRebetReq, opts ...grpc.CallOption) (*ColorGameRebetRsp, error) {
+	out := new(ColorGameRebetRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGSService/Rebet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGSServiceClient) StartAutoBet(ctx context.Context, in *ColorGameStartAutoBetReq, opts ...grpc.CallOption) (*ColorGameStartAutoBetRsp, error) {
+	out := new(ColorGameStartAutoBetRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGSService/StartAutoBet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGSServiceClient) StopAutoBet(ctx context.Context, in *ColorGameStopAutoBetReq, opts ...grpc.CallOption) (*ColorGameStopAutoBetRsp, error) {
+	out := new(ColorGameStopAutoBetRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGSService/StopAutoBet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGSServiceClient) GetBetHistory(ctx context.Context, in *ColorGameGetBetHistoryReq, opts ...grpc.CallOption) (*ColorGameGetBetHistoryRsp, error) {
+	out := new(ColorGameGetBetHistoryRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGSService/GetBetHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ColorGameGSServiceServer is the server API for ColorGameGSService service.
 // All implementations must embed UnimplementedColorGameGSServiceServer
 // for forward compatibility
@@ -75,6 +163,22 @@ type ColorGameGSServiceServer interface {
 	GetState(context.Context, *ColorGameGetStateReq) (*ColorGameGetStateRsp, error)
 	// RoundResult handles round result notification from GMS
 	RoundResult(context.Context, *ColorGameRoundResultReq) (*ColorGameRoundResultRsp, error)
+	// VoidRound handles round void notification from GMS (refund all bets)
+	VoidRound(context.Context, *ColorGameVoidRoundReq) (*ColorGameVoidRoundRsp, error)
+	// CloseBetting handles the end of the betting phase of a round from GMS, bets of the round can no longer be cancelled
+	CloseBetting(context.Context, *ColorGameCloseBettingReq) (*ColorGameCloseBettingRsp, error)
+	// CancelBet cancels or reduces a bet of the player while the round is betting (refunds the stake)
+	CancelBet(context.Context, *ColorGameCancelBetReq) (*ColorGameCancelBetRsp, error)
+	// PlaceBetSlip places bets on several colors at once, every line is placed or none
+	PlaceBetSlip(context.Context, *ColorGamePlaceBetSlipReq) (*ColorGamePlaceBetSlipRsp, error)
+	// Rebet places the last settled bet slip of the player again (multiplier 2 doubles it)
+	Rebet(context.Context, *ColorGameRebetReq) (*ColorGameRebetRsp, error)
+	// StartAutoBet repeats the last settled bet slip in the next rounds until a round count or threshold is reached
+	StartAutoBet(context.Context, *ColorGameStartAutoBetReq) (*ColorGameStartAutoBetRsp, error)
+	// StopAutoBet stops the auto bet of the player
+	StopAutoBet(context.Context, *ColorGameStopAutoBetReq) (*ColorGameStopAutoBetRsp, error)
+	// GetBetHistory returns the bet orders of a player newest first, with the result of each round
+	GetBetHistory(context.Context, *ColorGameGetBetHistoryReq) (*ColorGameGetBetHistoryRsp, error)
 	mustEmbedUnimplementedColorGameGSServiceServer()
 }
 
@@ -91,6 +195,30 @@ func (UnimplementedColorGameGSServiceServer) GetState(context.Context, *ColorGam
 func (UnimplementedColorGameGSServiceServer) RoundResult(context.Context, *ColorGameRoundResultReq) (*ColorGameRoundResultRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RoundResult not implemented")
 }
+func (UnimplementedColorGameGSServiceServer) VoidRound(context.Context, *ColorGameVoidRoundReq) (*ColorGameVoidRoundRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidRound not implemented")
+}
+func (UnimplementedColorGameGSServiceServer) CloseBetting(context.Context, *ColorGameCloseBettingReq) (*ColorGameCloseBettingRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseBetting not implemented")
+}
+func (UnimplementedColorGameGSServiceServer) CancelBet(context.Context, *ColorGameCancelBetReq) (*ColorGameCancelBetRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBet not implemented")
+}
+func (UnimplementedColorGameGSServiceServer) PlaceBetSlip(context.Context, *ColorGamePlaceBetSlipReq) (*ColorGamePlaceBetSlipRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceBetSlip not implemented")
+}
+func (UnimplementedColorGameGSServiceServer) Rebet(context.Context, *ColorGameRebetReq) (*ColorGameRebetRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rebet not implemented")
+}
+func (UnimplementedColorGameGSServiceServer) StartAutoBet(context.Context, *ColorGameStartAutoBetReq) (*ColorGameStartAutoBetRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartAutoBet not implemented")
+}
+func (UnimplementedColorGameGSServiceServer) StopAutoBet(context.Context, *ColorGameStopAutoBetReq) (*ColorGameStopAutoBetRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopAutoBet not implemented")
+}
+func (UnimplementedColorGameGSServiceServer) GetBetHistory(context.Context, *ColorGameGetBetHistoryReq) (*ColorGameGetBetHistoryRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBetHistory not implemented")
+}
 func (UnimplementedColorGameGSServiceServer) mustEmbedUnimplementedColorGameGSServiceServer() {}
 
 // UnsafeColorGameGSServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +286,150 @@ func _ColorGameGSService_RoundResult_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ColorGameGSService_VoidRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameVoidRoundReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGSServiceServer).VoidRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGSService/VoidRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGSServiceServer).VoidRound(ctx, req.(*ColorGameVoidRoundReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGSService_CloseBetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameCloseBettingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGSServiceServer).CloseBetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGSService/CloseBetting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGSServiceServer).CloseBetting(ctx, req.(*ColorGameCloseBettingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGSService_CancelBet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameCancelBetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGSServiceServer).CancelBet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGSService/CancelBet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGSServiceServer).CancelBet(ctx, req.(*ColorGameCancelBetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGSService_PlaceBetSlip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGamePlaceBetSlipReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGSServiceServer).PlaceBetSlip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGSService/PlaceBetSlip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGSServiceServer).PlaceBetSlip(ctx, req.(*ColorGamePlaceBetSlipReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGSService_Rebet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameRebetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGSServiceServer).Rebet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGSService/Rebet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGSServiceServer).Rebet(ctx, req.(*ColorGameRebetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGSService_StartAutoBet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameStartAutoBetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGSServiceServer).StartAutoBet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGSService/StartAutoBet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGSServiceServer).StartAutoBet(ctx, req.(*ColorGameStartAutoBetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGSService_StopAutoBet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameStopAutoBetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGSServiceServer).StopAutoBet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGSService/StopAutoBet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGSServiceServer).StopAutoBet(ctx, req.(*ColorGameStopAutoBetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGSService_GetBetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameGetBetHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGSServiceServer).GetBetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGSService/GetBetHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGSServiceServer).GetBetHistory(ctx, req.(*ColorGameGetBetHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ColorGameGSService_ServiceDesc is the grpc.ServiceDesc for ColorGameGSService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +449,38 @@ var ColorGameGSService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RoundResult",
 			Handler:    _ColorGameGSService_RoundResult_Handler,
 		},
+		{
+			MethodName: "VoidRound",
+			Handler:    _ColorGameGSService_VoidRound_Handler,
+		},
+		{
+			MethodName: "CloseBetting",
+			Handler:    _ColorGameGSService_CloseBetting_Handler,
+		},
+		{
+			MethodName: "CancelBet",
+			Handler:    _ColorGameGSService_CancelBet_Handler,
+		},
+		{
+			MethodName: "PlaceBetSlip",
+			Handler:    _ColorGameGSService_PlaceBetSlip_Handler,
+		},
+		{
+			MethodName: "Rebet",
+			Handler:    _ColorGameGSService_Rebet_Handler,
+		},
+		{
+			MethodName: "StartAutoBet",
+			Handler:    _ColorGameGSService_StartAutoBet_Handler,
+		},
+		{
+			MethodName: "StopAutoBet",
+			Handler:    _ColorGameGSService_StopAutoBet_Handler,
+		},
+		{
+			MethodName: "GetBetHistory",
+			Handler:    _ColorGameGSService_GetBetHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "shared/proto/colorgame/colorgame.proto",
@@ -188,8 +492,16 @@ var ColorGameGSService_ServiceDesc = grpc.ServiceDesc{
 type ColorGameGMSServiceClient interface {
 	// RecordBet records a bet in GMS
 	RecordBet(ctx context.Context, in *ColorGameRecordBetReq, opts ...grpc.CallOption) (*ColorGameRecordBetRsp, error)
+	// RevokeBet takes a cancelled stake back from the round stats, rejected once betting closed (unless it restores one)
+	RevokeBet(ctx context.Context, in *ColorGameRevokeBetReq, opts ...grpc.CallOption) (*ColorGameRevokeBetRsp, error)
 	// GetCurrentRound gets the current round from GMS
 	GetCurrentRound(ctx context.Context, in *ColorGameGetCurrentRoundReq, opts ...grpc.CallOption) (*ColorGameGetCurrentRoundRsp, error)
+	// VerifyRound recomputes the provably fair result of a historical round
+	VerifyRound(ctx context.Context, in *ColorGameVerifyRoundReq, opts ...grpc.CallOption) (*ColorGameVerifyRoundRsp, error)
+	// GetRecentEvents returns the buffered round state events after (round_id, after_seq), gateways resync after a gap
+	GetRecentEvents(ctx context.Context, in *ColorGameGetRecentEventsReq, opts ...grpc.CallOption) (*ColorGameGetRecentEventsRsp, error)
+	// GetRoundHistory returns the last results of a table with color frequencies, served from the GMS roadmap cache
+	GetRoundHistory(ctx context.Context, in *ColorGameGetRoundHistoryReq, opts ...grpc.CallOption) (*ColorGameGetRoundHistoryRsp, error)
 }
 
 type colorGameGMSServiceClient struct {
@@ -209,6 +521,15 @@ func (c *colorGameGMSServiceClient) RecordBet(ctx context.Context, in *ColorGame
 	return out, nil
 }
 
+func (c *colorGameGMSServiceClient) RevokeBet(ctx context.Context, in *ColorGameRevokeBetReq, opts ...grpc.CallOption) (*ColorGameRevokeBetRsp, error) {
+	out := new(ColorGameRevokeBetRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGMSService/RevokeBet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *colorGameGMSServiceClient) GetCurrentRound(ctx context.Context, in *ColorGameGetCurrentRoundReq, opts ...grpc.CallOption) (*ColorGameGetCurrentRoundRsp, error) {
 	out := new(ColorGameGetCurrentRoundRsp)
 	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGMSService/GetCurrentRound", in, out, opts...)
@@ -218,14 +539,49 @@ func (c *colorGameGMSServiceClient) GetCurrentRound(ctx context.Context, in *Col
 	return out, nil
 }
 
+func (c *colorGameGMSServiceClient) VerifyRound(ctx context.Context, in *ColorGameVerifyRoundReq, opts ...grpc.CallOption) (*ColorGameVerifyRoundRsp, error) {
+	out := new(ColorGameVerifyRoundRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGMSService/VerifyRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGMSServiceClient) GetRecentEvents(ctx context.Context, in *ColorGameGetRecentEventsReq, opts ...grpc.CallOption) (*ColorGameGetRecentEventsRsp, error) {
+	out := new(ColorGameGetRecentEventsRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGMSService/GetRecentEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGMSServiceClient) GetRoundHistory(ctx context.Context, in *ColorGameGetRoundHistoryReq, opts ...grpc.CallOption) (*ColorGameGetRoundHistoryRsp, error) {
+	out := new(ColorGameGetRoundHistoryRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGMSService/GetRoundHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ColorGameGMSServiceServer is the server API for ColorGameGMSService service.
 // All implementations must embed UnimplementedColorGameGMSServiceServer
 // for forward compatibility
 type ColorGameGMSServiceServer interface {
 	// RecordBet records a bet in GMS
 	RecordBet(context.Context, *ColorGameRecordBetReq) (*ColorGameRecordBetRsp, error)
+	// RevokeBet takes a cancelled stake back from the round stats, rejected once betting closed (unless it restores one)
+	RevokeBet(context.Context, *ColorGameRevokeBetReq) (*ColorGameRevokeBetRsp, error)
 	// GetCurrentRound gets the current round from GMS
 	GetCurrentRound(context.Context, *ColorGameGetCurrentRoundReq) (*ColorGameGetCurrentRoundRsp, error)
+	// VerifyRound recomputes the provably fair result of a historical round
+	VerifyRound(context.Context, *ColorGameVerifyRoundReq) (*ColorGameVerifyRoundRsp, error)
+	// GetRecentEvents returns the buffered round state events after (round_id, after_seq), gateways resync after a gap
+	GetRecentEvents(context.Context, *ColorGameGetRecentEventsReq) (*ColorGameGetRecentEventsRsp, error)
+	// GetRoundHistory returns the last results of a table with color frequencies, served from the GMS roadmap cache
+	GetRoundHistory(context.Context, *ColorGameGetRoundHistoryReq) (*ColorGameGetRoundHistoryRsp, error)
 	mustEmbedUnimplementedColorGameGMSServiceServer()
 }
 
@@ -236,9 +592,21 @@ type UnimplementedColorGameGMSServiceServer struct {
 func (UnimplementedColorGameGMSServiceServer) RecordBet(context.Context, *ColorGameRecordBetReq) (*ColorGameRecordBetRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecordBet not implemented")
 }
+func (UnimplementedColorGameGMSServiceServer) RevokeBet(context.Context, *ColorGameRevokeBetReq) (*ColorGameRevokeBetRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeBet not implemented")
+}
 func (UnimplementedColorGameGMSServiceServer) GetCurrentRound(context.Context, *ColorGameGetCurrentRoundReq) (*ColorGameGetCurrentRoundRsp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentRound not implemented")
 }
+func (UnimplementedColorGameGMSServiceServer) VerifyRound(context.Context, *ColorGameVerifyRoundReq) (*ColorGameVerifyRoundRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyRound not implemented")
+}
+func (UnimplementedColorGameGMSServiceServer) GetRecentEvents(context.Context, *ColorGameGetRecentEventsReq) (*ColorGameGetRecentEventsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentEvents not implemented")
+}
+func (UnimplementedColorGameGMSServiceServer) GetRoundHistory(context.Context, *ColorGameGetRoundHistoryReq) (*ColorGameGetRoundHistoryRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoundHistory not implemented")
+}
 func (UnimplementedColorGameGMSServiceServer) mustEmbedUnimplementedColorGameGMSServiceServer() {}
 
 // UnsafeColorGameGMSServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -270,6 +638,24 @@ func _ColorGameGMSService_RecordBet_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ColorGameGMSService_RevokeBet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameRevokeBetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGMSServiceServer).RevokeBet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGMSService/RevokeBet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGMSServiceServer).RevokeBet(ctx, req.(*ColorGameRevokeBetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ColorGameGMSService_GetCurrentRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ColorGameGetCurrentRoundReq)
 	if err := dec(in); err != nil {
@@ -288,6 +674,60 @@ func _ColorGameGMSService_GetCurrentRound_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ColorGameGMSService_VerifyRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameVerifyRoundReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGMSServiceServer).VerifyRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGMSService/VerifyRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGMSServiceServer).VerifyRound(ctx, req.(*ColorGameVerifyRoundReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGMSService_GetRecentEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameGetRecentEventsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGMSServiceServer).GetRecentEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGMSService/GetRecentEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGMSServiceServer).GetRecentEvents(ctx, req.(*ColorGameGetRecentEventsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGMSService_GetRoundHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameGetRoundHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGMSServiceServer).GetRoundHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGMSService/GetRoundHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGMSServiceServer).GetRoundHistory(ctx, req.(*ColorGameGetRoundHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ColorGameGMSService_ServiceDesc is the grpc.ServiceDesc for ColorGameGMSService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -299,10 +739,584 @@ var ColorGameGMSService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RecordBet",
 			Handler:    _ColorGameGMSService_RecordBet_Handler,
 		},
+		{
+			MethodName: "RevokeBet",
+			Handler:    _ColorGameGMSService_RevokeBet_Handler,
+		},
 		{
 			MethodName: "GetCurrentRound",
 			Handler:    _ColorGameGMSService_GetCurrentRound_Handler,
 		},
+		{
+			MethodName: "VerifyRound",
+			Handler:    _ColorGameGMSService_VerifyRound_Handler,
+		},
+		{
+			MethodName: "GetRecentEvents",
+			Handler:    _ColorGameGMSService_GetRecentEvents_Handler,
+		},
+		{
+			MethodName: "GetRoundHistory",
+			Handler:    _ColorGameGMSService_GetRoundHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "shared/proto/colorgame/colorgame.proto",
+}
+
+// ColorGameGMSAdminServiceClient is the client API for ColorGameGMSAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ColorGameGMSAdminServiceClient interface {
+	// SubmitResult submits the result of a drawing round (manual / live dealer tables)
+	SubmitResult(ctx context.Context, in *ColorGameSubmitResultReq, opts ...grpc.CallOption) (*ColorGameSubmitResultRsp, error)
+	// PauseTable stops the table before its next round starts, the current round is played to the end
+	PauseTable(ctx context.Context, in *ColorGamePauseTableReq, opts ...grpc.CallOption) (*ColorGamePauseTableRsp, error)
+	// ResumeTable starts rounds again on a paused table
+	ResumeTable(ctx context.Context, in *ColorGameResumeTableReq, opts ...grpc.CallOption) (*ColorGameResumeTableRsp, error)
+	// AdjustBetting extends (positive) or shortens (negative) the betting phase of the current round
+	AdjustBetting(ctx context.Context, in *ColorGameAdjustBettingReq, opts ...grpc.CallOption) (*ColorGameAdjustBettingRsp, error)
+	// VoidCurrentRound abandons the current round before its result is announced, every bet is refunded
+	VoidCurrentRound(ctx context.Context, in *ColorGameVoidCurrentRoundReq, opts ...grpc.CallOption) (*ColorGameVoidCurrentRoundRsp, error)
+	// GetRound returns a persisted round by ID
+	GetRound(ctx context.Context, in *ColorGameGetRoundReq, opts ...grpc.CallOption) (*ColorGameGetRoundRsp, error)
+	// ListRounds lists the rounds started in a time range, newest first
+	ListRounds(ctx context.Context, in *ColorGameListRoundsReq, opts ...grpc.CallOption) (*ColorGameListRoundsRsp, error)
+}
+
+type colorGameGMSAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewColorGameGMSAdminServiceClient(cc grpc.ClientConnInterface) ColorGameGMSAdminServiceClient {
+	return &colorGameGMSAdminServiceClient{cc}
+}
+
+func (c *colorGameGMSAdminServiceClient) SubmitResult(ctx context.Context, in *ColorGameSubmitResultReq, opts ...grpc.CallOption) (*ColorGameSubmitResultRsp, error) {
+	out := new(ColorGameSubmitResultRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGMSAdminService/SubmitResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGMSAdminServiceClient) PauseTable(ctx context.Context, in *ColorGamePauseTableReq, opts ...grpc.CallOption) (*ColorGamePauseTableRsp, error) {
+	out := new(ColorGamePauseTableRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGMSAdminService/PauseTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGMSAdminServiceClient) ResumeTable(ctx context.Context, in *ColorGameResumeTableReq, opts ...grpc.CallOption) (*ColorGameResumeTableRsp, error) {
+	out := new(ColorGameResumeTableRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGMSAdminService/ResumeTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGMSAdminServiceClient) AdjustBetting(ctx context.Context, in *ColorGameAdjustBettingReq, opts ...grpc.CallOption) (*ColorGameAdjustBettingRsp, error) {
+	out := new(ColorGameAdjustBettingRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGMSAdminService/AdjustBetting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGMSAdminServiceClient) VoidCurrentRound(ctx context.Context, in *ColorGameVoidCurrentRoundReq, opts ...grpc.CallOption) (*ColorGameVoidCurrentRoundRsp, error) {
+	out := new(ColorGameVoidCurrentRoundRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGMSAdminService/VoidCurrentRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGMSAdminServiceClient) GetRound(ctx context.Context, in *ColorGameGetRoundReq, opts ...grpc.CallOption) (*ColorGameGetRoundRsp, error) {
+	out := new(ColorGameGetRoundRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGMSAdminService/GetRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGMSAdminServiceClient) ListRounds(ctx context.Context, in *ColorGameListRoundsReq, opts ...grpc.CallOption) (*ColorGameListRoundsRsp, error) {
+	out := new(ColorGameListRoundsRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGMSAdminService/ListRounds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ColorGameGMSAdminServiceServer is the server API for ColorGameGMSAdminService service.
+// All implementations must embed UnimplementedColorGameGMSAdminServiceServer
+// for forward compatibility
+type ColorGameGMSAdminServiceServer interface {
+	// SubmitResult submits the result of a drawing round (manual / live dealer tables)
+	SubmitResult(context.Context, *ColorGameSubmitResultReq) (*ColorGameSubmitResultRsp, error)
+	// PauseTable stops the table before its next round starts, the current round is played to the end
+	PauseTable(context.Context, *ColorGamePauseTableReq) (*ColorGamePauseTableRsp, error)
+	// ResumeTable starts rounds again on a paused table
+	ResumeTable(context.Context, *ColorGameResumeTableReq) (*ColorGameResumeTableRsp, error)
+	// AdjustBetting extends (positive) or shortens (negative) the betting phase of the current round
+	AdjustBetting(context.Context, *ColorGameAdjustBettingReq) (*ColorGameAdjustBettingRsp, error)
+	// VoidCurrentRound abandons the current round before its result is announced, every bet is refunded
+	VoidCurrentRound(context.Context, *ColorGameVoidCurrentRoundReq) (*ColorGameVoidCurrentRoundRsp, error)
+	// GetRound returns a persisted round by ID
+	GetRound(context.Context, *ColorGameGetRoundReq) (*ColorGameGetRoundRsp, error)
+	// ListRounds lists the rounds started in a time range, newest first
+	ListRounds(context.Context, *ColorGameListRoundsReq) (*ColorGameListRoundsRsp, error)
+	mustEmbedUnimplementedColorGameGMSAdminServiceServer()
+}
+
+// UnimplementedColorGameGMSAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedColorGameGMSAdminServiceServer struct {
+}
+
+func (UnimplementedColorGameGMSAdminServiceServer) SubmitResult(context.Context, *ColorGameSubmitResultReq) (*ColorGameSubmitResultRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitResult not implemented")
+}
+func (UnimplementedColorGameGMSAdminServiceServer) PauseTable(context.Context, *ColorGamePauseTableReq) (*ColorGamePauseTableRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseTable not implemented")
+}
+func (UnimplementedColorGameGMSAdminServiceServer) ResumeTable(context.Context, *ColorGameResumeTableReq) (*ColorGameResumeTableRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTable not implemented")
+}
+func (UnimplementedColorGameGMSAdminServiceServer) AdjustBetting(context.Context, *ColorGameAdjustBettingReq) (*ColorGameAdjustBettingRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustBetting not implemented")
+}
+func (UnimplementedColorGameGMSAdminServiceServer) VoidCurrentRound(context.Context, *ColorGameVoidCurrentRoundReq) (*ColorGameVoidCurrentRoundRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidCurrentRound not implemented")
+}
+func (UnimplementedColorGameGMSAdminServiceServer) GetRound(context.Context, *ColorGameGetRoundReq) (*ColorGameGetRoundRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRound not implemented")
+}
+func (UnimplementedColorGameGMSAdminServiceServer) ListRounds(context.Context, *ColorGameListRoundsReq) (*ColorGameListRoundsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRounds not implemented")
+}
+func (UnimplementedColorGameGMSAdminServiceServer) mustEmbedUnimplementedColorGameGMSAdminServiceServer() {
+}
+
+// UnsafeColorGameGMSAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ColorGameGMSAdminServiceServer will
+// result in compilation errors.
+type UnsafeColorGameGMSAdminServiceServer interface {
+	mustEmbedUnimplementedColorGameGMSAdminServiceServer()
+}
+
+func RegisterColorGameGMSAdminServiceServer(s grpc.ServiceRegistrar, srv ColorGameGMSAdminServiceServer) {
+	s.RegisterService(&ColorGameGMSAdminService_ServiceDesc, srv)
+}
+
+func _ColorGameGMSAdminService_SubmitResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameSubmitResultReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGMSAdminServiceServer).SubmitResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGMSAdminService/SubmitResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGMSAdminServiceServer).SubmitResult(ctx, req.(*ColorGameSubmitResultReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGMSAdminService_PauseTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGamePauseTableReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGMSAdminServiceServer).PauseTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGMSAdminService/PauseTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGMSAdminServiceServer).PauseTable(ctx, req.(*ColorGamePauseTableReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGMSAdminService_ResumeTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameResumeTableReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGMSAdminServiceServer).ResumeTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGMSAdminService/ResumeTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGMSAdminServiceServer).ResumeTable(ctx, req.(*ColorGameResumeTableReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGMSAdminService_AdjustBetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameAdjustBettingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGMSAdminServiceServer).AdjustBetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGMSAdminService/AdjustBetting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGMSAdminServiceServer).AdjustBetting(ctx, req.(*ColorGameAdjustBettingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGMSAdminService_VoidCurrentRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameVoidCurrentRoundReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGMSAdminServiceServer).VoidCurrentRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGMSAdminService/VoidCurrentRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGMSAdminServiceServer).VoidCurrentRound(ctx, req.(*ColorGameVoidCurrentRoundReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGMSAdminService_GetRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameGetRoundReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGMSAdminServiceServer).GetRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGMSAdminService/GetRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGMSAdminServiceServer).GetRound(ctx, req.(*ColorGameGetRoundReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGMSAdminService_ListRounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameListRoundsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGMSAdminServiceServer).ListRounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGMSAdminService/ListRounds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGMSAdminServiceServer).ListRounds(ctx, req.(*ColorGameListRoundsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ColorGameGMSAdminService_ServiceDesc is the grpc.ServiceDesc for ColorGameGMSAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ColorGameGMSAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "colorgame.ColorGameGMSAdminService",
+	HandlerType: (*ColorGameGMSAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitResult",
+			Handler:    _ColorGameGMSAdminService_SubmitResult_Handler,
+		},
+		{
+			MethodName: "PauseTable",
+			Handler:    _ColorGameGMSAdminService_PauseTable_Handler,
+		},
+		{
+			MethodName: "ResumeTable",
+			Handler:    _ColorGameGMSAdminService_ResumeTable_Handler,
+		},
+		{
+			MethodName: "AdjustBetting",
+			Handler:    _ColorGameGMSAdminService_AdjustBetting_Handler,
+		},
+		{
+			MethodName: "VoidCurrentRound",
+			Handler:    _ColorGameGMSAdminService_VoidCurrentRound_Handler,
+		},
+		{
+			MethodName: "GetRound",
+			Handler:    _ColorGameGMSAdminService_GetRound_Handler,
+		},
+		{
+			MethodName: "ListRounds",
+			Handler:    _ColorGameGMSAdminService_ListRounds_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "shared/proto/colorgame/colorgame.proto",
+}
+
+// ColorGameGSAdminServiceClient is the client API for ColorGameGSAdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ColorGameGSAdminServiceClient interface {
+	// ListCompensations lists the bet intents of the PlaceBet saga in a status, COMPENSATING ones are stuck wallet rollbacks
+	ListCompensations(ctx context.Context, in *ColorGameListCompensationsReq, opts ...grpc.CallOption) (*ColorGameListCompensationsRsp, error)
+	// RetryCompensation rolls back the stake of a bet intent immediately
+	RetryCompensation(ctx context.Context, in *ColorGameRetryCompensationReq, opts ...grpc.CallOption) (*ColorGameRetryCompensationRsp, error)
+	// GetSettlement returns the settlement progress of a round
+	GetSettlement(ctx context.Context, in *ColorGameGetSettlementReq, opts ...grpc.CallOption) (*ColorGameGetSettlementRsp, error)
+	// ListSettlements lists the round settlements in a status, SETTLING ones are in progress or waiting to be resumed
+	ListSettlements(ctx context.Context, in *ColorGameListSettlementsReq, opts ...grpc.CallOption) (*ColorGameListSettlementsRsp, error)
+	// SearchBetHistory searches the bet orders of every player by round, game and date range (user_id optional)
+	SearchBetHistory(ctx context.Context, in *ColorGameGetBetHistoryReq, opts ...grpc.CallOption) (*ColorGameGetBetHistoryRsp, error)
+}
+
+type colorGameGSAdminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewColorGameGSAdminServiceClient(cc grpc.ClientConnInterface) ColorGameGSAdminServiceClient {
+	return &colorGameGSAdminServiceClient{cc}
+}
+
+func (c *colorGameGSAdminServiceClient) ListCompensations(ctx context.Context, in *ColorGameListCompensationsReq, opts ...grpc.CallOption) (*ColorGameListCompensationsRsp, error) {
+	out := new(ColorGameListCompensationsRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGSAdminService/ListCompensations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGSAdminServiceClient) RetryCompensation(ctx context.Context, in *ColorGameRetryCompensationReq, opts ...grpc.CallOption) (*ColorGameRetryCompensationRsp, error) {
+	out := new(ColorGameRetryCompensationRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGSAdminService/RetryCompensation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGSAdminServiceClient) GetSettlement(ctx context.Context, in *ColorGameGetSettlementReq, opts ...grpc.CallOption) (*ColorGameGetSettlementRsp, error) {
+	out := new(ColorGameGetSettlementRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGSAdminService/GetSettlement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGSAdminServiceClient) ListSettlements(ctx context.Context, in *ColorGameListSettlementsReq, opts ...grpc.CallOption) (*ColorGameListSettlementsRsp, error) {
+	out := new(ColorGameListSettlementsRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGSAdminService/ListSettlements", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *colorGameGSAdminServiceClient) SearchBetHistory(ctx context.Context, in *ColorGameGetBetHistoryReq, opts ...grpc.CallOption) (*ColorGameGetBetHistoryRsp, error) {
+	out := new(ColorGameGetBetHistoryRsp)
+	err := c.cc.Invoke(ctx, "/colorgame.ColorGameGSAdminService/SearchBetHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ColorGameGSAdminServiceServer is the server API for ColorGameGSAdminService service.
+// All implementations must embed UnimplementedColorGameGSAdminServiceServer
+// for forward compatibility
+type ColorGameGSAdminServiceServer interface {
+	// ListCompensations lists the bet intents of the PlaceBet saga in a status, COMPENSATING ones are stuck wallet rollbacks
+	ListCompensations(context.Context, *ColorGameListCompensationsReq) (*ColorGameListCompensationsRsp, error)
+	// RetryCompensation rolls back the stake of a bet intent immediately
+	RetryCompensation(context.Context, *ColorGameRetryCompensationReq) (*ColorGameRetryCompensationRsp, error)
+	// GetSettlement returns the settlement progress of a round
+	GetSettlement(context.Context, *ColorGameGetSettlementReq) (*ColorGameGetSettlementRsp, error)
+	// ListSettlements lists the round settlements in a status, SETTLING ones are in progress or waiting to be resumed
+	ListSettlements(context.Context, *ColorGameListSettlementsReq) (*ColorGameListSettlementsRsp, error)
+	// SearchBetHistory searches the bet orders of every player by round, game and date range (user_id optional)
+	SearchBetHistory(context.Context, *ColorGameGetBetHistoryReq) (*ColorGameGetBetHistoryRsp, error)
+	mustEmbedUnimplementedColorGameGSAdminServiceServer()
+}
+
+// UnimplementedColorGameGSAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedColorGameGSAdminServiceServer struct {
+}
+
+func (UnimplementedColorGameGSAdminServiceServer) ListCompensations(context.Context, *ColorGameListCompensationsReq) (*ColorGameListCompensationsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompensations not implemented")
+}
+func (UnimplementedColorGameGSAdminServiceServer) RetryCompensation(context.Context, *ColorGameRetryCompensationReq) (*ColorGameRetryCompensationRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryCompensation not implemented")
+}
+func (UnimplementedColorGameGSAdminServiceServer) GetSettlement(context.Context, *ColorGameGetSettlementReq) (*ColorGameGetSettlementRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettlement not implemented")
+}
+func (UnimplementedColorGameGSAdminServiceServer) ListSettlements(context.Context, *ColorGameListSettlementsReq) (*ColorGameListSettlementsRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSettlements not implemented")
+}
+func (UnimplementedColorGameGSAdminServiceServer) SearchBetHistory(context.Context, *ColorGameGetBetHistoryReq) (*ColorGameGetBetHistoryRsp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBetHistory not implemented")
+}
+func (UnimplementedColorGameGSAdminServiceServer) mustEmbedUnimplementedColorGameGSAdminServiceServer() {
+}
+
+// UnsafeColorGameGSAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ColorGameGSAdminServiceServer will
+// result in compilation errors.
+type UnsafeColorGameGSAdminServiceServer interface {
+	mustEmbedUnimplementedColorGameGSAdminServiceServer()
+}
+
+func RegisterColorGameGSAdminServiceServer(s grpc.ServiceRegistrar, srv ColorGameGSAdminServiceServer) {
+	s.RegisterService(&ColorGameGSAdminService_ServiceDesc, srv)
+}
+
+func _ColorGameGSAdminService_ListCompensations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameListCompensationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGSAdminServiceServer).ListCompensations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGSAdminService/ListCompensations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGSAdminServiceServer).ListCompensations(ctx, req.(*ColorGameListCompensationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGSAdminService_RetryCompensation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameRetryCompensationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGSAdminServiceServer).RetryCompensation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGSAdminService/RetryCompensation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGSAdminServiceServer).RetryCompensation(ctx, req.(*ColorGameRetryCompensationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGSAdminService_GetSettlement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameGetSettlementReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGSAdminServiceServer).GetSettlement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGSAdminService/GetSettlement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGSAdminServiceServer).GetSettlement(ctx, req.(*ColorGameGetSettlementReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGSAdminService_ListSettlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameListSettlementsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGSAdminServiceServer).ListSettlements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGSAdminService/ListSettlements",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGSAdminServiceServer).ListSettlements(ctx, req.(*ColorGameListSettlementsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ColorGameGSAdminService_SearchBetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ColorGameGetBetHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ColorGameGSAdminServiceServer).SearchBetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/colorgame.ColorGameGSAdminService/SearchBetHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ColorGameGSAdminServiceServer).SearchBetHistory(ctx, req.(*ColorGameGetBetHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ColorGameGSAdminService_ServiceDesc is the grpc.ServiceDesc for ColorGameGSAdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ColorGameGSAdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "colorgame.ColorGameGSAdminService",
+	HandlerType: (*ColorGameGSAdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCompensations",
+			Handler:    _ColorGameGSAdminService_ListCompensations_Handler,
+		},
+		{
+			MethodName: "RetryCompensation",
+			Handler:    _ColorGameGSAdminService_RetryCompensation_Handler,
+		},
+		{
+			MethodName: "GetSettlement",
+			Handler:    _ColorGameGSAdminService_GetSettlement_Handler,
+		},
+		{
+			MethodName: "ListSettlements",
+			Handler:    _ColorGameGSAdminService_ListSettlements_Handler,
+		},
+		{
+			MethodName: "SearchBetHistory",
+			Handler:    _ColorGameGSAdminService_SearchBetHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "shared/proto/colorgame/colorgame.proto",
@@ -39,12 +39,16 @@ const (
 	ErrorCode_INSUFFICIENT_BALANCE ErrorCode = 200
 	ErrorCode_TRANSACTION_FAILED   ErrorCode = 201
 	// Game specific
-	ErrorCode_GAME_CLOSED        ErrorCode = 300
-	ErrorCode_ROUND_NOT_ACTIVE   ErrorCode = 301
-	ErrorCode_INVALID_BET_AMOUNT ErrorCode = 302
-	ErrorCode_INVALID_BET_OPTION ErrorCode = 303
-	ErrorCode_ALREADY_BET        ErrorCode = 304
-	ErrorCode_ROOM_FULL          ErrorCode = 305
+	ErrorCode_GAME_CLOSED             ErrorCode = 300
+	ErrorCode_ROUND_NOT_ACTIVE        ErrorCode = 301
+	ErrorCode_INVALID_BET_AMOUNT      ErrorCode = 302
+	ErrorCode_INVALID_BET_OPTION      ErrorCode = 303
+	ErrorCode_ALREADY_BET             ErrorCode = 304
+	ErrorCode_ROOM_FULL               ErrorCode = 305
+	ErrorCode_BET_LIMIT_EXCEEDED      ErrorCode = 306 // Per round or per color stake limit of the player
+	ErrorCode_EXPOSURE_LIMIT_EXCEEDED ErrorCode = 307 // Potential payout of the color above the table liability cap
+	ErrorCode_REQUEST_IN_PROGRESS     ErrorCode = 308 // A request with the same idempotency key is still being processed
+	ErrorCode_TABLE_NOT_RUNNING       ErrorCode = 309 // No GMS instance is running the rounds of the table (e.g. no leader elected)
 	// System
 	ErrorCode_MAINTENANCE_MODE    ErrorCode = 400
 	ErrorCode_RATE_LIMIT_EXCEEDED ErrorCode = 401
@@ -72,31 +76,39 @@ var (
 		303: "INVALID_BET_OPTION",
 		304: "ALREADY_BET",
 		305: "ROOM_FULL",
+		306: "BET_LIMIT_EXCEEDED",
+		307: "EXPOSURE_LIMIT_EXCEEDED",
+		308: "REQUEST_IN_PROGRESS",
+		309: "TABLE_NOT_RUNNING",
 		400: "MAINTENANCE_MODE",
 		401: "RATE_LIMIT_EXCEEDED",
 	}
 	ErrorCode_value = map[string]int32{
-		"SUCCESS":              0,
-		"UNKNOWN_ERROR":        1,
-		"INVALID_PARAMS":       2,
-		"UNAUTHORIZED":         3,
-		"NOT_FOUND":            4,
-		"INTERNAL_ERROR":       5,
-		"INVALID_CREDENTIALS":  100,
-		"TOKEN_EXPIRED":        101,
-		"USER_ALREADY_EXISTS":  102,
-		"USER_BANNED":          103,
-		"USER_SUSPENDED":       104,
-		"INSUFFICIENT_BALANCE": 200,
-		"TRANSACTION_FAILED":   201,
-		"GAME_CLOSED":          300,
-		"ROUND_NOT_ACTIVE":     301,
-		"INVALID_BET_AMOUNT":   302,
-		"INVALID_BET_OPTION":   303,
-		"ALREADY_BET":          304,
-		"ROOM_FULL":            305,
-		"MAINTENANCE_MODE":     400,
-		"RATE_LIMIT_EXCEEDED":  401,
+		"SUCCESS":                 0,
+		"UNKNOWN_ERROR":           1,
+		"INVALID_PARAMS":          2,
+		"UNAUTHORIZED":            3,
+		"NOT_FOUND":               4,
+		"INTERNAL_ERROR":          5,
+		"INVALID_CREDENTIALS":     100,
+		"TOKEN_EXPIRED":           101,
+		"USER_ALREADY_EXISTS":     102,
+		"USER_BANNED":             103,
+		"USER_SUSPENDED":          104,
+		"INSUFFICIENT_BALANCE":    200,
+		"TRANSACTION_FAILED":      201,
+		"GAME_CLOSED":             300,
+		"ROUND_NOT_ACTIVE":        301,
+		"INVALID_BET_AMOUNT":      302,
+		"INVALID_BET_OPTION":      303,
+		"ALREADY_BET":             304,
+		"ROOM_FULL":               305,
+		"BET_LIMIT_EXCEEDED":      306,
+		"EXPOSURE_LIMIT_EXCEEDED": 307,
+		"REQUEST_IN_PROGRESS":     308,
+		"TABLE_NOT_RUNNING":       309,
+		"MAINTENANCE_MODE":        400,
+		"RATE_LIMIT_EXCEEDED":     401,
 	}
 )
 
@@ -132,7 +144,7 @@ var File_shared_proto_common_common_proto protoreflect.FileDescriptor
 var file_shared_proto_common_common_proto_rawDesc = []byte{
 	0x0a, 0x20, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
 	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2a, 0xc0, 0x03, 0x0a, 0x09, 0x45,
+	0x74, 0x6f, 0x12, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2a, 0xa9, 0x04, 0x0a, 0x09, 0x45,
 	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43,
 	0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
 	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41,
@@ -157,14 +169,21 @@ var file_shared_proto_common_common_proto_rawDesc = []byte{
 	0x02, 0x12, 0x17, 0x0a, 0x12, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x42, 0x45, 0x54,
 	0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xaf, 0x02, 0x12, 0x10, 0x0a, 0x0b, 0x41, 0x4c,
 	0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x42, 0x45, 0x54, 0x10, 0xb0, 0x02, 0x12, 0x0e, 0x0a, 0x09,
-	0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0xb1, 0x02, 0x12, 0x15, 0x0a, 0x10,
-	0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
-	0x10, 0x90, 0x03, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49,
-	0x54, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x91, 0x03, 0x42, 0x37, 0x5a,
-	0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x72, 0x61, 0x6e,
-	0x6b, 0x69, 0x65, 0x6c, 0x69, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0xb1, 0x02, 0x12, 0x17, 0x0a, 0x12,
+	0x42, 0x45, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44,
+	0x45, 0x44, 0x10, 0xb2, 0x02, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x58, 0x50, 0x4f, 0x53, 0x55, 0x52,
+	0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44,
+	0x10, 0xb3, 0x02, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x49,
+	0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0xb4, 0x02, 0x12, 0x16, 0x0a,
+	0x11, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49,
+	0x4e, 0x47, 0x10, 0xb5, 0x02, 0x12, 0x15, 0x0a, 0x10, 0x4d, 0x41, 0x49, 0x4e, 0x54, 0x45, 0x4e,
+	0x41, 0x4e, 0x43, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x10, 0x90, 0x03, 0x12, 0x18, 0x0a, 0x13,
+	0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45,
+	0x44, 0x45, 0x44, 0x10, 0x91, 0x03, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x65, 0x6c, 0x69, 0x2f, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x2f, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -0,0 +1,152 @@
+package colorgame_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+
+	gmsGrpc "github.com/frankieli/game_product/internal/modules/color_game/gms/adapter/grpc"
+	gmsMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	gmsMemory "github.com/frankieli/game_product/internal/modules/color_game/gms/repository/memory"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	pbCommon "github.com/frankieli/game_product/shared/proto/common"
+)
+
+// staticLocator reports a fixed lease holder, this instance is never the leader
+type staticLocator struct {
+	leader string
+}
+
+func (l *staticLocator) IsLeader() bool { return false }
+
+func (l *staticLocator) Leader(ctx context.Context) (string, error) { return l.leader, nil }
+
+func TestAdminControlsForwardedToLeader(t *testing.T) {
+	// 1. Two replicas share the snapshot store, only the leader runs rounds
+	snapshotRepo := gmsMemory.NewRoundSnapshotRepository()
+
+	leader := newRecoveryStateMachine(snapshotRepo)
+	leader.BettingDuration = 2 * time.Second
+	leaderBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	leaderUC := gmsUC.NewGMSUseCase(leader, leaderBroadcaster, nil, &MockGameRoundRepository{})
+
+	follower := newRecoveryStateMachine(snapshotRepo)
+	followerUC := gmsUC.NewGMSUseCase(follower, &TestBroadcaster{Messages: make(chan proto.Message, 100)}, nil, &MockGameRoundRepository{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go leader.Start(ctx)
+	defer leader.Stop()
+
+	// 2. The leader serves the admin API over gRPC
+	leaderAddr, leaderClient := serveAdmin(t, leaderUC)
+
+	locator := &staticLocator{}
+	followerHandler := gmsGrpc.NewAdminHandler(followerUC)
+	followerHandler.SetLeaderForwarding(locator, func(addr string) (pbColorGame.ColorGameGMSAdminServiceClient, error) {
+		return leaderClient, nil
+	})
+
+	// 3. Without a leader the follower cannot run the control and says so
+	betting := waitForState(t, leaderBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_BETTING, time.Second)
+	rsp, err := followerHandler.PauseTable(ctx, &pbColorGame.ColorGamePauseTableReq{Operator: "tester"})
+	if err != nil || rsp.ErrorCode != pbCommon.ErrorCode_TABLE_NOT_RUNNING {
+		t.Fatalf("Expected TABLE_NOT_RUNNING without a leader, got %v %v", rsp, err)
+	}
+
+	// 4. Controls sent to the follower run on the leader's state machine
+	locator.leader = leaderAddr
+	rsp, err = followerHandler.PauseTable(ctx, &pbColorGame.ColorGamePauseTableReq{Operator: "tester"})
+	if err != nil || rsp.ErrorCode != pbCommon.ErrorCode_SUCCESS {
+		t.Fatalf("Expected forwarded PauseTable to succeed, got %v %v", rsp, err)
+	}
+	if !leader.IsPaused() {
+		t.Error("Expected the leader's table to be paused")
+	}
+
+	adjusted, err := followerHandler.AdjustBetting(ctx, &pbColorGame.ColorGameAdjustBettingReq{
+		RoundId:      betting.RoundId,
+		DeltaSeconds: 1,
+		Operator:     "tester",
+	})
+	if err != nil || adjusted.ErrorCode != pbCommon.ErrorCode_SUCCESS {
+		t.Fatalf("Expected forwarded AdjustBetting to succeed, got %v %v", adjusted, err)
+	}
+	if adjusted.BettingEndTimestamp != leader.GetCurrentRound().BettingEnd.Unix() {
+		t.Errorf("Expected betting end %d on the leader, got %d", adjusted.BettingEndTimestamp, leader.GetCurrentRound().BettingEnd.Unix())
+	}
+}
+
+func TestSubmitResultForwardedToLeader(t *testing.T) {
+	// 1. A live dealer table runs on the leader, the dealer's console reaches a follower
+	snapshotRepo := gmsMemory.NewRoundSnapshotRepository()
+
+	leader := newManualStateMachine(time.Second)
+	leader.SetSnapshotRepository(snapshotRepo)
+	leaderBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	leaderUC := gmsUC.NewGMSUseCase(leader, leaderBroadcaster, nil, &MockGameRoundRepository{})
+
+	follower := newManualStateMachine(time.Second)
+	follower.SetSnapshotRepository(snapshotRepo)
+	followerUC := gmsUC.NewGMSUseCase(follower, &TestBroadcaster{Messages: make(chan proto.Message, 100)}, nil, &MockGameRoundRepository{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	go leader.Start(ctx)
+	defer leader.Stop()
+
+	leaderAddr, leaderClient := serveAdmin(t, leaderUC)
+	followerHandler := gmsGrpc.NewAdminHandler(followerUC)
+	followerHandler.SetLeaderForwarding(&staticLocator{leader: leaderAddr}, func(addr string) (pbColorGame.ColorGameGMSAdminServiceClient, error) {
+		return leaderClient, nil
+	})
+
+	// 2. The follower has no pending draw of its own
+	drawing := waitForState(t, leaderBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_DRAWING, time.Second)
+	if err := follower.SubmitResult(drawing.RoundId, []pbColorGame.ColorGameReward{pbColorGame.ColorGameReward_REWARD_GREEN}); err != gmsMachine.ErrNotRunning {
+		t.Errorf("Expected ErrNotRunning on the follower, got %v", err)
+	}
+
+	// 3. A submission to the follower settles the leader's round
+	rsp, err := followerHandler.SubmitResult(ctx, &pbColorGame.ColorGameSubmitResultReq{
+		RoundId:  drawing.RoundId,
+		Result:   pbColorGame.ColorGameReward_REWARD_GREEN,
+		Operator: "dealer",
+	})
+	if err != nil || rsp.ErrorCode != pbCommon.ErrorCode_SUCCESS {
+		t.Fatalf("Expected forwarded SubmitResult to succeed, got %v %v", rsp, err)
+	}
+
+	result := waitForState(t, leaderBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_RESULT, time.Second)
+	if result.RoundId != drawing.RoundId {
+		t.Errorf("Expected result of round %s, got %s", drawing.RoundId, result.RoundId)
+	}
+}
+
+// serveAdmin serves the admin API of a GMS over gRPC on a local port, it returns the address and a client
+func serveAdmin(t *testing.T, uc *gmsUC.GMSUseCase) (string, pbColorGame.ColorGameGMSAdminServiceClient) {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	server := grpc.NewServer()
+	pbColorGame.RegisterColorGameGMSAdminServiceServer(server, gmsGrpc.NewAdminHandler(uc))
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return lis.Addr().String(), pbColorGame.NewColorGameGMSAdminServiceClient(conn)
+}
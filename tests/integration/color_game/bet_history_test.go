@@ -0,0 +1,139 @@
+package colorgame_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	pbCommon "github.com/frankieli/game_product/shared/proto/common"
+
+	gsLocal "github.com/frankieli/game_product/internal/modules/color_game/gs/adapter/local"
+	gsDomain "github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	gsRepo "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/memory"
+	gsUC "github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
+	gatewayUC "github.com/frankieli/game_product/internal/modules/gateway/usecase"
+	"github.com/frankieli/game_product/internal/modules/wallet"
+	"github.com/frankieli/game_product/pkg/clock"
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
+)
+
+func TestBetHistory(t *testing.T) {
+	ctx := context.Background()
+	gms := &roundsGMS{roundID: "r-1", state: pbColorGame.ColorGameState_GAME_STATE_BETTING}
+	walletSvc := wallet.NewMockService()
+	betOrderRepo := &MockBetOrderRepository{}
+	playerUC := gsUC.NewGSUseCase(gsRepo.NewBetRepository(), betOrderRepo, gms, walletSvc, nil)
+	start := time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC)
+	fakeClock := clock.NewFake(start)
+	playerUC.SetClock(fakeClock)
+	walletSvc.SetBalance(9101, 1000)
+	walletSvc.SetBalance(9102, 1000)
+
+	placeBet := func(userID int64, color gsDomain.Color, amount int64) {
+		t.Helper()
+		fakeClock.Advance(time.Second)
+		if _, err := playerUC.PlaceBet(ctx, userID, "", color, amount, ""); err != nil {
+			t.Fatalf("PlaceBet failed: %v", err)
+		}
+	}
+
+	// r-1 is settled (red drawn), r-2 voided and r-3 still betting
+	placeBet(9101, red, 100)
+	placeBet(9101, green, 50)
+	placeBet(9102, red, 10)
+	gms.set("r-1", pbColorGame.ColorGameState_GAME_STATE_DRAWING)
+	if err := playerUC.SettleRound(ctx, "", "r-1", []gsDomain.Color{red}); err != nil {
+		t.Fatalf("SettleRound failed: %v", err)
+	}
+	gms.set("r-2", pbColorGame.ColorGameState_GAME_STATE_BETTING)
+	placeBet(9101, red, 30)
+	gms.set("r-2", pbColorGame.ColorGameState_GAME_STATE_DRAWING)
+	if err := playerUC.VoidRound(ctx, "", "r-2", "dealer error"); err != nil {
+		t.Fatalf("VoidRound failed: %v", err)
+	}
+	gms.set("r-3", pbColorGame.ColorGameState_GAME_STATE_BETTING)
+	placeBet(9101, blue, 20)
+
+	// 1. A player pages through their orders newest first, each with the result of its round
+	first, err := playerUC.GetBetHistory(ctx, gsDomain.BetHistoryQuery{UserID: 9101, Limit: 2})
+	if err != nil || len(first.Records) != 2 || first.NextCursor == "" {
+		t.Fatalf("Expected a first page of 2 with a cursor, got %+v %v", first, err)
+	}
+	pending, voided := first.Records[0], first.Records[1]
+	if pending.Order.RoundID != "r-3" || pending.Order.Status != gsDomain.BetOrderStatusPending || len(pending.Dice) != 0 {
+		t.Fatalf("Expected the pending r-3 bet without result first, got %+v %v", pending.Order, pending.Dice)
+	}
+	if voided.Order.RoundID != "r-2" || voided.Order.Status != gsDomain.BetOrderStatusRefunded || voided.VoidReason != "dealer error" {
+		t.Fatalf("Expected the refunded r-2 bet with its void reason, got %+v %q", voided.Order, voided.VoidReason)
+	}
+
+	second, err := playerUC.GetBetHistory(ctx, gsDomain.BetHistoryQuery{UserID: 9101, Limit: 2, Cursor: first.NextCursor})
+	if err != nil || len(second.Records) != 2 || second.NextCursor != "" {
+		t.Fatalf("Expected a last page of 2, got %+v %v", second, err)
+	}
+	lost, won := second.Records[0], second.Records[1]
+	wantPayout := colorgame.DefaultPaytable().WinAmount(red, 100, []gsDomain.Color{red})
+	if won.Order.BetArea != red.String() || int64(won.Order.Payout) != wantPayout || len(won.Dice) != 1 || won.Dice[0] != red {
+		t.Fatalf("Expected red 100 paying %d with red drawn, got %+v %v", wantPayout, won.Order, won.Dice)
+	}
+	if lost.Order.BetArea != green.String() || lost.Order.Payout != 0 || lost.Order.Status != gsDomain.BetOrderStatusSettled {
+		t.Fatalf("Expected the settled green bet without payout, got %+v", lost.Order)
+	}
+
+	// 2. The orders of a round, of one player or of everyone
+	round, err := playerUC.GetBetHistory(ctx, gsDomain.BetHistoryQuery{UserID: 9101, RoundID: "r-1"})
+	if err != nil || len(round.Records) != 2 || round.Records[0].Order.BetArea != red.String() {
+		t.Fatalf("Expected the 2 r-1 bets of the player oldest first, got %+v %v", round, err)
+	}
+	if everyone, _ := playerUC.GetBetHistory(ctx, gsDomain.BetHistoryQuery{RoundID: "r-1"}); len(everyone.Records) != 3 {
+		t.Fatalf("Expected the 3 r-1 bets of every player, got %d", len(everyone.Records))
+	}
+
+	// 3. Operators search every player by game and date range
+	search, err := playerUC.GetBetHistory(ctx, gsDomain.BetHistoryQuery{GameCode: "color_game", From: start.Add(3 * time.Second), To: start.Add(5 * time.Second)})
+	if err != nil || len(search.Records) != 2 || search.Records[0].Order.UserID != 9101 || search.Records[1].Order.UserID != 9102 {
+		t.Fatalf("Expected the r-2 bet and the bet of 9102 in the range, got %+v %v", search, err)
+	}
+	if other, _ := playerUC.GetBetHistory(ctx, gsDomain.BetHistoryQuery{GameCode: "baccarat"}); len(other.Records) != 0 {
+		t.Fatalf("Expected no baccarat bets, got %d", len(other.Records))
+	}
+	if _, err := playerUC.GetBetHistory(ctx, gsDomain.BetHistoryQuery{UserID: 9101, Cursor: "not-a-cursor"}); !errors.Is(err, gsDomain.ErrInvalidBetHistoryQuery) {
+		t.Fatalf("Expected ErrInvalidBetHistoryQuery for a malformed cursor, got %v", err)
+	}
+	if _, err := playerUC.GetBetHistory(ctx, gsDomain.BetHistoryQuery{From: start.Add(time.Hour), To: start}); !errors.Is(err, gsDomain.ErrInvalidBetHistoryQuery) {
+		t.Fatalf("Expected ErrInvalidBetHistoryQuery for a reversed range, got %v", err)
+	}
+
+	// 4. Players read their history through the gateway, other players' bets are not included
+	gateway := gatewayUC.NewGatewayUseCase(gsLocal.NewHandler(playerUC), nil)
+	send := func(userID int64, message string) map[string]interface{} {
+		t.Helper()
+		respJSON, err := gateway.HandleMessage(ctx, userID, []byte(message))
+		if err != nil {
+			t.Fatalf("HandleMessage failed: %v", err)
+		}
+		var resp struct {
+			Command string                 `json:"command"`
+			Data    map[string]interface{} `json:"data"`
+		}
+		if err := json.Unmarshal(respJSON, &resp); err != nil || resp.Command != "ColorGameBetHistoryRSP" {
+			t.Fatalf("Expected ColorGameBetHistoryRSP, got %s %v", respJSON, err)
+		}
+		return resp.Data
+	}
+	data := send(9101, `{"game_code":"color_game","command":"ColorGameBetHistoryREQ","data":{"round_id":"r-1"}}`)
+	records := data["records"].([]interface{})
+	if data["error_code"] != float64(pbCommon.ErrorCode_SUCCESS) || len(records) != 2 {
+		t.Fatalf("Expected the 2 r-1 bets of the player, got %v", data)
+	}
+	if record := records[0].(map[string]interface{}); record["color"] != "red" || record["payout"] != float64(wantPayout) || record["dice"].([]interface{})[0] != "red" {
+		t.Fatalf("Expected red with its payout and the drawn dice, got %v", record)
+	}
+	data = send(9102, `{"game_code":"color_game","command":"ColorGameBetHistoryREQ","data":{"limit":5}}`)
+	if records := data["records"].([]interface{}); len(records) != 1 || data["next_cursor"] != "" {
+		t.Fatalf("Expected the single bet of 9102, got %v", data)
+	}
+}
@@ -0,0 +1,140 @@
+package colorgame_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	pbCommon "github.com/frankieli/game_product/shared/proto/common"
+	"google.golang.org/protobuf/proto"
+
+	gmsLocal "github.com/frankieli/game_product/internal/modules/color_game/gms/adapter/local"
+	gmsMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+	gsLocal "github.com/frankieli/game_product/internal/modules/color_game/gs/adapter/local"
+	gsDomain "github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	gsRepo "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/memory"
+	gsUC "github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
+	"github.com/frankieli/game_product/internal/modules/wallet"
+)
+
+func TestBetLimits(t *testing.T) {
+	// 1. Setup a table that stays in betting
+	stateMachine := gmsMachine.NewStateMachine()
+	stateMachine.WaitDuration = 50 * time.Millisecond
+	stateMachine.BettingDuration = 500 * time.Millisecond
+
+	broadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	roundUC := gmsUC.NewGMSUseCase(stateMachine, broadcaster, nil, &MockGameRoundRepository{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	walletSvc := wallet.NewMockService()
+	playerUC := gsUC.NewGSUseCase(gsRepo.NewBetRepository(), &MockBetOrderRepository{}, gmsLocal.NewHandler(roundUC), walletSvc, broadcaster)
+	playerUC.SetBetLimits(gsDomain.BetLimits{
+		MinBet:      10,
+		MaxBet:      500,
+		MaxRoundBet: 800,
+		MaxColorBet: 500,
+		MaxExposure: 2000, // The default table pays 2x: at most 1000 staked per color
+	})
+	handler := gsLocal.NewHandler(playerUC)
+
+	placeBet := func(userID int64, color pbColorGame.ColorGameReward, amount int64) pbCommon.ErrorCode {
+		rsp, err := handler.PlaceBet(ctx, &pbColorGame.ColorGamePlaceBetReq{UserId: userID, Color: color, Amount: amount})
+		if err != nil {
+			t.Fatalf("PlaceBet returned an error: %v", err)
+		}
+		return rsp.ErrorCode
+	}
+
+	go stateMachine.Start(ctx)
+	waitForState(t, broadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_BETTING, time.Second)
+
+	// 2. Every limit is surfaced with its error code
+	steps := []struct {
+		name   string
+		userID int64
+		color  pbColorGame.ColorGameReward
+		amount int64
+		code   pbCommon.ErrorCode
+	}{
+		{"zero stake", 8001, pbColorGame.ColorGameReward_REWARD_RED, 0, pbCommon.ErrorCode_INVALID_BET_AMOUNT},
+		{"negative stake", 8001, pbColorGame.ColorGameReward_REWARD_RED, -100, pbCommon.ErrorCode_INVALID_BET_AMOUNT},
+		{"below minimum", 8001, pbColorGame.ColorGameReward_REWARD_RED, 5, pbCommon.ErrorCode_INVALID_BET_AMOUNT},
+		{"above maximum", 8001, pbColorGame.ColorGameReward_REWARD_RED, 501, pbCommon.ErrorCode_INVALID_BET_AMOUNT},
+		{"color outside the table", 8001, pbColorGame.ColorGameReward_REWARD_PINK, 100, pbCommon.ErrorCode_INVALID_BET_OPTION},
+		{"first bet", 8001, pbColorGame.ColorGameReward_REWARD_RED, 400, pbCommon.ErrorCode_SUCCESS},
+		{"color maximum", 8001, pbColorGame.ColorGameReward_REWARD_RED, 101, pbCommon.ErrorCode_BET_LIMIT_EXCEEDED},
+		{"up to the color maximum", 8001, pbColorGame.ColorGameReward_REWARD_RED, 100, pbCommon.ErrorCode_SUCCESS},
+		{"other color", 8001, pbColorGame.ColorGameReward_REWARD_GREEN, 300, pbCommon.ErrorCode_SUCCESS},
+		{"round maximum", 8001, pbColorGame.ColorGameReward_REWARD_BLUE, 100, pbCommon.ErrorCode_BET_LIMIT_EXCEEDED},
+		{"another player", 8002, pbColorGame.ColorGameReward_REWARD_RED, 500, pbCommon.ErrorCode_SUCCESS},
+		{"table exposure", 8003, pbColorGame.ColorGameReward_REWARD_RED, 10, pbCommon.ErrorCode_EXPOSURE_LIMIT_EXCEEDED},
+		{"exposure of another color", 8003, pbColorGame.ColorGameReward_REWARD_BLUE, 10, pbCommon.ErrorCode_SUCCESS},
+	}
+	for _, step := range steps {
+		if code := placeBet(step.userID, step.color, step.amount); code != step.code {
+			t.Errorf("%s: expected %s, got %s", step.name, step.code, code)
+		}
+	}
+
+	// 3. Rejected bets are not charged
+	if balance, _ := walletSvc.GetBalance(ctx, 8001); balance != 1000000-800 {
+		t.Errorf("Expected only the accepted 800 to be deducted, got balance %d", balance)
+	}
+
+	// 4. Bets are rejected once betting closed
+	waitForState(t, broadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_DRAWING, time.Second)
+	if code := placeBet(8004, pbColorGame.ColorGameReward_REWARD_RED, 100); code != pbCommon.ErrorCode_ROUND_NOT_ACTIVE {
+		t.Errorf("Expected ROUND_NOT_ACTIVE while drawing, got %s", code)
+	}
+
+	cancel()
+	stateMachine.WaitForDone()
+}
+
+func TestBetLimitReservationsAreAtomic(t *testing.T) {
+	repo := gsRepo.NewBetLimitRepository()
+	limits := gsDomain.BetLimits{MaxExposure: 1000}
+
+	// 200 concurrent bets of 10 paying 20: exactly 50 fit under the exposure cap
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		accepted int
+	)
+	for i := 0; i < 200; i++ {
+		wg.Add(1)
+		go func(userID int64) {
+			defer wg.Done()
+			err := repo.Reserve(context.Background(), gsDomain.BetReservation{
+				RoundID: "r-1",
+				UserID:  userID,
+				Color:   pbColorGame.ColorGameReward_REWARD_RED,
+				Amount:  10,
+				Payout:  20,
+			}, limits)
+			if err == nil {
+				mu.Lock()
+				accepted++
+				mu.Unlock()
+			}
+		}(int64(i))
+	}
+	wg.Wait()
+
+	if accepted != 50 {
+		t.Fatalf("Expected 50 reservations, got %d", accepted)
+	}
+
+	// A released reservation frees its share of the cap
+	released := gsDomain.BetReservation{RoundID: "r-1", UserID: 0, Color: pbColorGame.ColorGameReward_REWARD_RED, Amount: 10, Payout: 20}
+	_ = repo.Release(context.Background(), released)
+	if err := repo.Reserve(context.Background(), released, limits); err != nil {
+		t.Errorf("Expected the released share to be reserved again, got %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package colorgame_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	gsDomain "github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	gsDB "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/db"
+)
+
+func TestSavePendingDoesNotOverwriteFinishedOrders(t *testing.T) {
+	ctx := context.Background()
+	db, err := gorm.Open(sqlite.Open("file:save_pending?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	if err := db.AutoMigrate(&gsDomain.BetOrder{}); err != nil {
+		t.Fatalf("Failed to migrate: %v", err)
+	}
+	repo := gsDB.NewBetOrderRepository(db)
+
+	now := time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC)
+	order := func(orderID string, amount float64, txIDs string) *gsDomain.BetOrder {
+		return &gsDomain.BetOrder{
+			OrderID:   orderID,
+			UserID:    7301,
+			RoundID:   "r-1",
+			GameCode:  "color_game",
+			BetArea:   "red",
+			Amount:    amount,
+			Status:    gsDomain.BetOrderStatusPending,
+			TxIDs:     txIDs,
+			CreatedAt: now,
+		}
+	}
+
+	// 1. A pending order is raised by a later PlaceBet
+	if err := repo.SavePending(ctx, []*gsDomain.BetOrder{order("o-1", 100, "tx-1"), order("o-2", 50, "tx-2")}); err != nil {
+		t.Fatalf("SavePending failed: %v", err)
+	}
+	if err := repo.SavePending(ctx, []*gsDomain.BetOrder{order("o-1", 150, "tx-1,tx-3")}); err != nil {
+		t.Fatalf("SavePending of a raise failed: %v", err)
+	}
+
+	// 2. A cancelled order is not raised back, nor is the rest of the slip written
+	if err := db.Model(&gsDomain.BetOrder{}).Where("order_id = ?", "o-2").Update("status", gsDomain.BetOrderStatusCancelled).Error; err != nil {
+		t.Fatalf("Failed to cancel order: %v", err)
+	}
+	err = repo.SavePending(ctx, []*gsDomain.BetOrder{order("o-3", 20, "tx-4"), order("o-2", 80, "tx-2,tx-5")})
+	if !errors.Is(err, gsDomain.ErrBetChanged) {
+		t.Fatalf("Expected ErrBetChanged raising a cancelled order, got %v", err)
+	}
+
+	orders, err := repo.ListByRound(ctx, "r-1")
+	if err != nil {
+		t.Fatalf("ListByRound failed: %v", err)
+	}
+	if len(orders) != 2 {
+		t.Fatalf("Expected the slip to be rolled back, got %d orders", len(orders))
+	}
+	for _, stored := range orders {
+		switch stored.OrderID {
+		case "o-1":
+			if stored.Amount != 150 || stored.TxIDs != "tx-1,tx-3" {
+				t.Errorf("Expected o-1 raised to 150, got %v (%s)", stored.Amount, stored.TxIDs)
+			}
+		case "o-2":
+			if stored.Amount != 50 || stored.Status != gsDomain.BetOrderStatusCancelled {
+				t.Errorf("Expected o-2 to stay cancelled at 50, got %v status %d", stored.Amount, stored.Status)
+			}
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package colorgame_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"google.golang.org/protobuf/proto"
+
+	gmsMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+	"github.com/frankieli/game_product/pkg/clock"
+)
+
+// waitForBetPool waits for the next ColorGameBetPoolBRC
+func waitForBetPool(t *testing.T, messages chan proto.Message, timeout time.Duration) *pbColorGame.ColorGameBetPoolBRC {
+	deadline := time.After(timeout)
+	for {
+		select {
+		case msg := <-messages:
+			if brc, ok := msg.(*pbColorGame.ColorGameBetPoolBRC); ok {
+				return brc
+			}
+		case <-deadline:
+			t.Fatal("Timeout waiting for ColorGameBetPoolBRC")
+			return nil
+		}
+	}
+}
+
+// poolOf returns the pool of a color, nil if nobody bet on it
+func poolOf(brc *pbColorGame.ColorGameBetPoolBRC, color pbColorGame.ColorGameReward) *pbColorGame.ColorGameBetPool {
+	for _, pool := range brc.Pools {
+		if pool.Color == color {
+			return pool
+		}
+	}
+	return nil
+}
+
+func TestBetPoolIsBroadcastDuringBetting(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC))
+	stateMachine := gmsMachine.NewStateMachine()
+	stateMachine.SetClock(fakeClock)
+
+	broadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	roundUC := gmsUC.NewGMSUseCase(stateMachine, broadcaster, nil, &MockGameRoundRepository{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go stateMachine.Start(ctx)
+
+	waitForState(t, broadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_ROUND_STARTED, time.Second)
+	fakeClock.BlockUntil(1)
+	fakeClock.Advance(stateMachine.WaitDuration)
+
+	// 1. Betting opens with an empty pool
+	betting := waitForState(t, broadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_BETTING, time.Second)
+	pool := waitForBetPool(t, broadcaster.Messages, time.Second)
+	if pool.RoundId != betting.RoundId || pool.TotalAmount != 0 || len(pool.Pools) != 0 {
+		t.Errorf("Expected an empty pool for round %s, got %+v", betting.RoundId, pool)
+	}
+	fakeClock.BlockUntil(2) // betting phase and pool ticker
+
+	// 2. The next tick carries the per color totals
+	bets := []struct {
+		userID int64
+		color  pbColorGame.ColorGameReward
+		amount int64
+	}{
+		{8001, pbColorGame.ColorGameReward_REWARD_RED, 100},
+		{8002, pbColorGame.ColorGameReward_REWARD_RED, 50},
+		{8003, pbColorGame.ColorGameReward_REWARD_BLUE, 30},
+	}
+	for _, bet := range bets {
+		if err := roundUC.RecordBet(ctx, "", betting.RoundId, bet.userID, bet.color, bet.amount); err != nil {
+			t.Fatalf("RecordBet failed: %v", err)
+		}
+	}
+	fakeClock.Advance(gmsUC.BetPoolInterval)
+
+	pool = waitForBetPool(t, broadcaster.Messages, time.Second)
+	red, blue := poolOf(pool, pbColorGame.ColorGameReward_REWARD_RED), poolOf(pool, pbColorGame.ColorGameReward_REWARD_BLUE)
+	if red == nil || red.Amount != 150 || red.Bettors != 2 || blue == nil || blue.Amount != 30 || blue.Bettors != 1 {
+		t.Errorf("Unexpected pools %v", pool.Pools)
+	}
+	if pool.TotalAmount != 180 || pool.TotalBettors != 3 {
+		t.Errorf("Expected totals 180 / 3 bettors, got %d / %d", pool.TotalAmount, pool.TotalBettors)
+	}
+
+	// 3. Ticks without new bets are not broadcast
+	fakeClock.BlockUntil(2)
+	fakeClock.Advance(gmsUC.BetPoolInterval)
+	select {
+	case msg := <-broadcaster.Messages:
+		if _, ok := msg.(*pbColorGame.ColorGameBetPoolBRC); ok {
+			t.Error("Expected no pool broadcast without new bets")
+		}
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// 4. A late bet is announced exactly once, betting closes right after it
+	fakeClock.BlockUntil(2)
+	if err := roundUC.RecordBet(ctx, "", betting.RoundId, 8001, pbColorGame.ColorGameReward_REWARD_GREEN, 20); err != nil {
+		t.Fatalf("RecordBet failed: %v", err)
+	}
+	fakeClock.Advance(stateMachine.BettingDuration)
+
+	var pools []*pbColorGame.ColorGameBetPoolBRC
+	drawingSeen := false
+	deadline := time.After(time.Second)
+	for !drawingSeen || len(pools) == 0 {
+		select {
+		case msg := <-broadcaster.Messages:
+			switch brc := msg.(type) {
+			case *pbColorGame.ColorGameBetPoolBRC:
+				pools = append(pools, brc)
+			case *pbColorGame.ColorGameRoundStateBRC:
+				drawingSeen = drawingSeen || brc.State == pbColorGame.ColorGameState_GAME_STATE_DRAWING
+			}
+		case <-deadline:
+			t.Fatalf("Timeout waiting for the closing pool (drawing seen: %v)", drawingSeen)
+		}
+	}
+	// No further pool broadcast once betting closed
+	select {
+	case msg := <-broadcaster.Messages:
+		if brc, ok := msg.(*pbColorGame.ColorGameBetPoolBRC); ok {
+			pools = append(pools, brc)
+		}
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if len(pools) != 1 {
+		t.Fatalf("Expected exactly one closing pool broadcast, got %d", len(pools))
+	}
+	green := poolOf(pools[0], pbColorGame.ColorGameReward_REWARD_GREEN)
+	if green == nil || green.Amount != 20 || pools[0].TotalAmount != 200 || pools[0].TotalBettors != 3 {
+		t.Errorf("Unexpected closing pool %+v", pools[0])
+	}
+
+	cancel()
+	stateMachine.WaitForDone()
+}
@@ -0,0 +1,170 @@
+package colorgame_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	pbCommon "github.com/frankieli/game_product/shared/proto/common"
+	"google.golang.org/protobuf/proto"
+
+	gmsLocal "github.com/frankieli/game_product/internal/modules/color_game/gms/adapter/local"
+	gmsMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	gmsMemory "github.com/frankieli/game_product/internal/modules/color_game/gms/repository/memory"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+	gsLocal "github.com/frankieli/game_product/internal/modules/color_game/gs/adapter/local"
+	gsDomain "github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	gsRepo "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/memory"
+	gsUC "github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
+	gatewayUC "github.com/frankieli/game_product/internal/modules/gateway/usecase"
+	"github.com/frankieli/game_product/internal/modules/wallet"
+	"github.com/frankieli/game_product/pkg/clock"
+)
+
+func TestPlaceBetSlip(t *testing.T) {
+	// 1. GMS with round stats and GS on a fake clock
+	fakeClock := clock.NewFake(time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC))
+	stateMachine := gmsMachine.NewStateMachine()
+	stateMachine.SetClock(fakeClock)
+
+	roundBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	statsRepo := gmsMemory.NewRoundStatsRepository()
+	roundUC := gmsUC.NewGMSUseCase(stateMachine, roundBroadcaster, nil, &MockGameRoundRepository{})
+	roundUC.SetRoundStatsRepository(statsRepo)
+
+	betRepo := gsRepo.NewBetRepository()
+	betOrderRepo := &MockBetOrderRepository{}
+	walletSvc := wallet.NewMockService()
+	playerUC := gsUC.NewGSUseCase(betRepo, betOrderRepo, gmsLocal.NewHandler(roundUC), walletSvc, nil)
+	playerUC.SetClock(fakeClock)
+	playerUC.SetBetLimits(gsDomain.BetLimits{MinBet: 50, MaxColorBet: 300})
+	roundUC.SetGSService(gsLocal.NewHandler(playerUC))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go stateMachine.Start(ctx)
+
+	waitForState(t, roundBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_ROUND_STARTED, time.Second)
+	fakeClock.BlockUntil(1)
+	fakeClock.Advance(stateMachine.WaitDuration)
+	betting := waitForState(t, roundBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_BETTING, time.Second)
+	fakeClock.BlockUntil(1)
+
+	balance := func(userID int64) int64 {
+		b, _ := walletSvc.GetBalance(ctx, userID)
+		return b
+	}
+	walletSvc.SetBalance(8001, 1000)
+	walletSvc.SetBalance(8002, 1000)
+	redBet, err := playerUC.PlaceBet(ctx, 8001, "", red, 100, "")
+	if err != nil {
+		t.Fatalf("PlaceBet failed: %v", err)
+	}
+
+	// 2. A slip adds to the bet on red and opens one on green, both deducted in one wallet transaction
+	slip := []gsDomain.BetLine{{Color: red, Amount: 100}, {Color: green, Amount: 200}}
+	bets, err := playerUC.PlaceBetSlip(ctx, 8001, "", slip, "s-1")
+	if err != nil {
+		t.Fatalf("PlaceBetSlip failed: %v", err)
+	}
+	if len(bets) != 2 || bets[0].BetID != redBet.BetID || bets[0].Amount != 200 || bets[1].Color != green || bets[1].Amount != 200 {
+		t.Fatalf("Expected red raised to 200 on bet %s and 200 on green, got %+v", redBet.BetID, bets)
+	}
+	slipTxID := bets[1].TxIDs[0]
+	if bets[0].TxIDs[len(bets[0].TxIDs)-1] != slipTxID {
+		t.Fatalf("Expected both lines to share one wallet transaction, got %v and %v", bets[0].TxIDs, bets[1].TxIDs)
+	}
+	if got := balance(8001); got != 600 {
+		t.Fatalf("Expected balance 600 after the slip, got %d", got)
+	}
+	if queued, _ := betRepo.GetBetsForSettlement(ctx, betting.RoundId); len(queued) != 2 {
+		t.Fatalf("Expected 2 bets to settle, got %d", len(queued))
+	}
+	if order := betOrderRepo.Order(bets[1].BetID); order.Status != gsDomain.BetOrderStatusPending || order.Amount != 200 {
+		t.Fatalf("Expected a pending order of 200 on green, got %+v", order)
+	}
+	stats, _ := statsRepo.Get(ctx, betting.RoundId)
+	if stats.TotalAmount != 400 || stats.Pools[red].Amount != 200 || stats.Pools[green].Amount != 200 {
+		t.Fatalf("Expected 400 in the round stats, got %d, red %+v green %+v", stats.TotalAmount, stats.Pools[red], stats.Pools[green])
+	}
+
+	// 3. A resent slip returns the same bets and deducts nothing
+	replay, err := playerUC.PlaceBetSlip(ctx, 8001, "", slip, "s-1")
+	if err != nil || len(replay) != 2 || replay[0].BetID != bets[0].BetID || replay[1].BetID != bets[1].BetID || balance(8001) != 600 {
+		t.Fatalf("Expected the replay to return the placed bets, got %+v %v balance %d", replay, err, balance(8001))
+	}
+
+	// 4. The slip is validated as a whole, one bad line rejects every line
+	if _, err := playerUC.PlaceBetSlip(ctx, 8002, "", nil, ""); !errors.Is(err, gsDomain.ErrInvalidBetOption) {
+		t.Fatalf("Expected ErrInvalidBetOption for an empty slip, got %v", err)
+	}
+	duplicate := []gsDomain.BetLine{{Color: blue, Amount: 100}, {Color: blue, Amount: 100}}
+	if _, err := playerUC.PlaceBetSlip(ctx, 8002, "", duplicate, ""); !errors.Is(err, gsDomain.ErrInvalidBetOption) {
+		t.Fatalf("Expected ErrInvalidBetOption for a color appearing twice, got %v", err)
+	}
+	belowMin := []gsDomain.BetLine{{Color: blue, Amount: 100}, {Color: red, Amount: 10}}
+	if _, err := playerUC.PlaceBetSlip(ctx, 8002, "", belowMin, ""); !errors.Is(err, gsDomain.ErrInvalidBetAmount) {
+		t.Fatalf("Expected ErrInvalidBetAmount for a line below the minimum bet, got %v", err)
+	}
+
+	// 5. A line over a round limit rejects the slip and frees what the other lines reserved
+	overLimit := []gsDomain.BetLine{{Color: blue, Amount: 300}, {Color: red, Amount: 200}}
+	if _, err := playerUC.PlaceBetSlip(ctx, 8001, "", overLimit, ""); !errors.Is(err, gsDomain.ErrBetLimitExceeded) {
+		t.Fatalf("Expected ErrBetLimitExceeded raising red to 400, got %v", err)
+	}
+	if userBets, _ := betRepo.GetUserBets(ctx, betting.RoundId, 8001); len(userBets) != 2 || balance(8001) != 600 {
+		t.Fatalf("Expected nothing placed by the rejected slip, got %d bets balance %d", len(userBets), balance(8001))
+	}
+	if _, err := playerUC.PlaceBet(ctx, 8001, "", blue, 300, ""); err != nil {
+		t.Fatalf("Expected the blue limit to be free again, got %v", err)
+	}
+
+	// 6. Players place slips through the gateway
+	gateway := gatewayUC.NewGatewayUseCase(gsLocal.NewHandler(playerUC), nil)
+	respJSON, err := gateway.HandleMessage(ctx, 8002, []byte(`{"game_code":"color_game","command":"ColorGamePlaceBetSlipREQ","data":{"bets":[{"color":"yellow","amount":100},{"color":"blue","amount":50}]}}`))
+	if err != nil {
+		t.Fatalf("HandleMessage failed: %v", err)
+	}
+	var resp struct {
+		Command string `json:"command"`
+		Data    struct {
+			ErrorCode int32 `json:"error_code"`
+			Bets      []struct {
+				Color  string `json:"color"`
+				Amount int64  `json:"amount"`
+				BetID  string `json:"bet_id"`
+			} `json:"bets"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(respJSON, &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if resp.Command != "ColorGamePlaceBetSlipRSP" || resp.Data.ErrorCode != int32(pbCommon.ErrorCode_SUCCESS) ||
+		len(resp.Data.Bets) != 2 || resp.Data.Bets[0].Color != "yellow" || resp.Data.Bets[0].BetID == "" || resp.Data.Bets[1].BetID == "" {
+		t.Fatalf("Expected a bet ID per line through the gateway, got %s", respJSON)
+	}
+	if got := balance(8002); got != 850 {
+		t.Fatalf("Expected balance 850 after the slip, got %d", got)
+	}
+
+	// 7. A void refunds each line of a slip exactly once, not the whole slip transaction per line
+	fakeClock.Advance(stateMachine.BettingDuration)
+	waitForState(t, roundBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_DRAWING, time.Second)
+	if err := roundUC.VoidCurrentRound(ctx, "", betting.RoundId, "dealer error", "tester"); err != nil {
+		t.Fatalf("VoidCurrentRound failed: %v", err)
+	}
+	deadline := time.Now().Add(time.Second)
+	for (balance(8001) != 1000 || balance(8002) != 1000) && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if balance(8001) != 1000 || balance(8002) != 1000 {
+		t.Fatalf("Expected the players to get exactly their stakes back, got %d and %d", balance(8001), balance(8002))
+	}
+
+	cancel()
+	stateMachine.WaitForDone()
+}
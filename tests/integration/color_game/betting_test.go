@@ -31,6 +31,10 @@ func (m *MockBroadcaster) Broadcast(ctx context.Context, gameCode string, event
 	m.events = append(m.events, event)
 }
 
+func (m *MockBroadcaster) BroadcastToTable(ctx context.Context, gameCode string, tableID string, event proto.Message) {
+	m.Broadcast(ctx, gameCode, event)
+}
+
 func (m *MockBroadcaster) SendToUser(ctx context.Context, userID int64, gameCode string, message proto.Message) {
 	if m.events == nil {
 		m.events = make([]proto.Message, 0)
@@ -46,10 +50,50 @@ func (m *MockBroadcaster) RoundResult(ctx context.Context, req *pbColorGame.Colo
 	return &pbColorGame.ColorGameRoundResultRsp{}, nil
 }
 
+func (m *MockBroadcaster) VoidRound(ctx context.Context, req *pbColorGame.ColorGameVoidRoundReq) (*pbColorGame.ColorGameVoidRoundRsp, error) {
+	if m.events == nil {
+		m.events = make([]proto.Message, 0)
+	}
+	m.events = append(m.events, req)
+	return &pbColorGame.ColorGameVoidRoundRsp{}, nil
+}
+
+func (m *MockBroadcaster) CloseBetting(ctx context.Context, req *pbColorGame.ColorGameCloseBettingReq) (*pbColorGame.ColorGameCloseBettingRsp, error) {
+	return &pbColorGame.ColorGameCloseBettingRsp{}, nil
+}
+
 func (m *MockBroadcaster) PlaceBet(ctx context.Context, req *pbColorGame.ColorGamePlaceBetReq) (*pbColorGame.ColorGamePlaceBetRsp, error) {
 	return &pbColorGame.ColorGamePlaceBetRsp{}, nil
 }
 
+func (m *MockBroadcaster) CancelBet(ctx context.Context, req *pbColorGame.ColorGameCancelBetReq) (*pbColorGame.ColorGameCancelBetRsp, error) {
+	return &pbColorGame.ColorGameCancelBetRsp{}, nil
+}
+
+func (m *MockBroadcaster) PlaceBetSlip(ctx context.Context, req *pbColorGame.ColorGamePlaceBetSlipReq) (*pbColorGame.ColorGamePlaceBetSlipRsp, error) {
+	return &pbColorGame.ColorGamePlaceBetSlipRsp{}, nil
+}
+
+func (m *MockBroadcaster) Rebet(ctx context.Context, req *pbColorGame.ColorGameRebetReq) (*pbColorGame.ColorGameRebetRsp, error) {
+	return &pbColorGame.ColorGameRebetRsp{}, nil
+}
+
+func (m *MockBroadcaster) StartAutoBet(ctx context.Context, req *pbColorGame.ColorGameStartAutoBetReq) (*pbColorGame.ColorGameStartAutoBetRsp, error) {
+	return &pbColorGame.ColorGameStartAutoBetRsp{}, nil
+}
+
+func (m *MockBroadcaster) StopAutoBet(ctx context.Context, req *pbColorGame.ColorGameStopAutoBetReq) (*pbColorGame.ColorGameStopAutoBetRsp, error) {
+	return &pbColorGame.ColorGameStopAutoBetRsp{}, nil
+}
+
+func (m *MockBroadcaster) GetBetHistory(ctx context.Context, req *pbColorGame.ColorGameGetBetHistoryReq) (*pbColorGame.ColorGameGetBetHistoryRsp, error) {
+	return &pbColorGame.ColorGameGetBetHistoryRsp{}, nil
+}
+
+func (m *MockBroadcaster) GetRoundHistory(ctx context.Context, req *pbColorGame.ColorGameGetRoundHistoryReq) (*pbColorGame.ColorGameGetRoundHistoryRsp, error) {
+	return &pbColorGame.ColorGameGetRoundHistoryRsp{}, nil
+}
+
 func (m *MockBroadcaster) GetState(ctx context.Context, req *pbColorGame.ColorGameGetStateReq) (*pbColorGame.ColorGameGetStateRsp, error) {
 	return &pbColorGame.ColorGameGetStateRsp{}, nil
 }
@@ -94,7 +138,7 @@ func TestBetting(t *testing.T) {
 	// 3. Wait for betting state
 	var currentRoundID string
 	for i := 0; i < 20; i++ {
-		round, err := gsUseCase.GetCurrentRound(ctx, 1001)
+		round, err := gsUseCase.GetCurrentRound(ctx, 1001, "")
 		if err == nil && round["state"] == pbColorGame.ColorGameState_GAME_STATE_BETTING.String() {
 			currentRoundID = round["round_id"].(string)
 			break
@@ -127,7 +171,7 @@ func TestBetting(t *testing.T) {
 
 	// Place initial bets
 	for _, tc := range testCases {
-		_, err := gsUseCase.PlaceBet(ctx, tc.userID, tc.color, tc.amount)
+		_, err := gsUseCase.PlaceBet(ctx, tc.userID, "", tc.color, tc.amount, "")
 		if err != nil {
 			t.Fatalf("PlaceBet failed for user %d: %v", tc.userID, err)
 		}
@@ -139,7 +183,7 @@ func TestBetting(t *testing.T) {
 	originalBet, _ := betRepo.GetUserBet(ctx, currentRoundID, 1001, pbColorGame.ColorGameReward_REWARD_RED)
 	originalBetID := originalBet.BetID
 
-	_, err := gsUseCase.PlaceBet(ctx, 1001, pbColorGame.ColorGameReward_REWARD_RED, 50)
+	_, err := gsUseCase.PlaceBet(ctx, 1001, "", pbColorGame.ColorGameReward_REWARD_RED, 50, "")
 	if err != nil {
 		t.Fatalf("Second PlaceBet failed for user 1001: %v", err)
 	}
@@ -177,7 +221,7 @@ func TestBetting(t *testing.T) {
 	}
 
 	// 7. Test GetCurrentRound returns player bets
-	round, err := gsUseCase.GetCurrentRound(ctx, 1001)
+	round, err := gsUseCase.GetCurrentRound(ctx, 1001, "")
 	if err != nil {
 		t.Fatalf("GetCurrentRound failed: %v", err)
 	}
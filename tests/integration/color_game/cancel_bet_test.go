@@ -0,0 +1,287 @@
+package colorgame_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	pbCommon "github.com/frankieli/game_product/shared/proto/common"
+	"google.golang.org/protobuf/proto"
+
+	gmsLocal "github.com/frankieli/game_product/internal/modules/color_game/gms/adapter/local"
+	gmsMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	gmsMemory "github.com/frankieli/game_product/internal/modules/color_game/gms/repository/memory"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+	gsLocal "github.com/frankieli/game_product/internal/modules/color_game/gs/adapter/local"
+	gsDomain "github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	gsRepo "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/memory"
+	gsUC "github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
+	gatewayUC "github.com/frankieli/game_product/internal/modules/gateway/usecase"
+	"github.com/frankieli/game_product/internal/modules/wallet"
+	"github.com/frankieli/game_product/pkg/clock"
+)
+
+func TestCancelBetDuringBetting(t *testing.T) {
+	// 1. GMS with round stats and GS on a fake clock
+	fakeClock := clock.NewFake(time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC))
+	stateMachine := gmsMachine.NewStateMachine()
+	stateMachine.SetClock(fakeClock)
+
+	roundBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	statsRepo := gmsMemory.NewRoundStatsRepository()
+	roundUC := gmsUC.NewGMSUseCase(stateMachine, roundBroadcaster, nil, &MockGameRoundRepository{})
+	roundUC.SetRoundStatsRepository(statsRepo)
+
+	betRepo := gsRepo.NewBetRepository()
+	betOrderRepo := &MockBetOrderRepository{}
+	walletSvc := wallet.NewMockService()
+	playerUC := gsUC.NewGSUseCase(betRepo, betOrderRepo, gmsLocal.NewHandler(roundUC), walletSvc, nil)
+	playerUC.SetClock(fakeClock)
+	playerUC.SetBetLimits(gsDomain.BetLimits{MinBet: 50})
+	roundUC.SetGSService(gsLocal.NewHandler(playerUC))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go stateMachine.Start(ctx)
+
+	waitForState(t, roundBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_ROUND_STARTED, time.Second)
+	fakeClock.BlockUntil(1)
+	fakeClock.Advance(stateMachine.WaitDuration)
+	betting := waitForState(t, roundBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_BETTING, time.Second)
+	fakeClock.BlockUntil(1)
+
+	balance := func(userID int64) int64 {
+		b, _ := walletSvc.GetBalance(ctx, userID)
+		return b
+	}
+	for _, userID := range []int64{7001, 7002, 7003} {
+		walletSvc.SetBalance(userID, 1000)
+	}
+	if _, err := playerUC.PlaceBet(ctx, 7001, "", red, 200, ""); err != nil {
+		t.Fatalf("PlaceBet failed: %v", err)
+	}
+	bet, err := playerUC.PlaceBet(ctx, 7001, "", red, 100, "")
+	if err != nil {
+		t.Fatalf("PlaceBet failed: %v", err)
+	}
+	if _, err := playerUC.PlaceBet(ctx, 7002, "", green, 100, ""); err != nil {
+		t.Fatalf("PlaceBet failed: %v", err)
+	}
+
+	// 2. Reducing a bet refunds the difference and takes it back from the round stats
+	reduced, err := playerUC.CancelBet(ctx, 7001, "", red, 100, "c-1")
+	if err != nil || reduced.BetID != bet.BetID || reduced.Refund != 100 || reduced.Remaining != 200 {
+		t.Fatalf("Expected 100 refunded and 200 left on bet %s, got %+v %v", bet.BetID, reduced, err)
+	}
+	if got := balance(7001); got != 800 {
+		t.Fatalf("Expected balance 800 after the refund, got %d", got)
+	}
+	stats, _ := statsRepo.Get(ctx, betting.RoundId)
+	if stats.TotalBets != 3 || stats.TotalAmount != 300 || stats.Pools[red].Amount != 200 {
+		t.Fatalf("Expected 3 bets of 300 with 200 on red, got %d bets of %d, red %+v", stats.TotalBets, stats.TotalAmount, stats.Pools[red])
+	}
+	if order := betOrderRepo.Order(bet.BetID); order.Status != gsDomain.BetOrderStatusPending || order.Amount != 200 {
+		t.Fatalf("Expected the pending order to be reduced to 200, got %+v", order)
+	}
+
+	// 3. A resent request is not refunded twice
+	replay, err := playerUC.CancelBet(ctx, 7001, "", red, 100, "c-1")
+	if err != nil || replay.Refund != 100 || replay.Remaining != 200 || balance(7001) != 800 {
+		t.Fatalf("Expected the replay to return the first cancellation, got %+v %v balance %d", replay, err, balance(7001))
+	}
+
+	// 4. The bet cannot go below zero nor below the minimum bet
+	if _, err := playerUC.CancelBet(ctx, 7001, "", red, 300, ""); !errors.Is(err, gsDomain.ErrInvalidBetAmount) {
+		t.Fatalf("Expected ErrInvalidBetAmount cancelling more than the bet, got %v", err)
+	}
+	if _, err := playerUC.CancelBet(ctx, 7001, "", red, 180, ""); !errors.Is(err, gsDomain.ErrInvalidBetAmount) {
+		t.Fatalf("Expected ErrInvalidBetAmount leaving less than the minimum bet, got %v", err)
+	}
+	if _, err := playerUC.CancelBet(ctx, 7001, "", blue, 0, ""); !errors.Is(err, gsDomain.ErrBetNotFound) {
+		t.Fatalf("Expected ErrBetNotFound without a bet on the color, got %v", err)
+	}
+
+	// 5. Cancelling the whole bet removes it from the round
+	greenBet, _ := betRepo.GetUserBet(ctx, betting.RoundId, 7002, green)
+	removed, err := playerUC.CancelBet(ctx, 7002, "", green, 0, "")
+	if err != nil || removed.Refund != 100 || removed.Remaining != 0 || balance(7002) != 1000 {
+		t.Fatalf("Expected the whole bet to be refunded, got %+v %v balance %d", removed, err, balance(7002))
+	}
+	if bets, _ := betRepo.GetUserBets(ctx, betting.RoundId, 7002); len(bets) != 0 {
+		t.Fatalf("Expected the bet to be removed, got %d bets", len(bets))
+	}
+	if queued, _ := betRepo.GetBetsForSettlement(ctx, betting.RoundId); len(queued) != 1 || queued[0].BetID != bet.BetID {
+		t.Fatalf("Expected only bet %s left to settle, got %d bets", bet.BetID, len(queued))
+	}
+	if order := betOrderRepo.Order(greenBet.BetID); order.Status != gsDomain.BetOrderStatusCancelled {
+		t.Fatalf("Expected a cancelled order, got %+v", order)
+	}
+	stats, _ = statsRepo.Get(ctx, betting.RoundId)
+	if stats.TotalBets != 2 || stats.TotalAmount != 200 || stats.Pools[green].Amount != 0 {
+		t.Fatalf("Expected the green bet to leave the stats, got %d bets of %d", stats.TotalBets, stats.TotalAmount)
+	}
+
+	// 6. Players cancel through the gateway
+	if _, err := playerUC.PlaceBet(ctx, 7003, "", blue, 100, ""); err != nil {
+		t.Fatalf("PlaceBet failed: %v", err)
+	}
+	gateway := gatewayUC.NewGatewayUseCase(gsLocal.NewHandler(playerUC), nil)
+	respJSON, err := gateway.HandleMessage(ctx, 7003, []byte(`{"game_code":"color_game","command":"ColorGameCancelBetREQ","data":{"color":"blue"}}`))
+	if err != nil {
+		t.Fatalf("HandleMessage failed: %v", err)
+	}
+	var resp struct {
+		Command string                 `json:"command"`
+		Data    map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(respJSON, &resp); err != nil {
+		t.Fatalf("Failed to unmarshal response: %v", err)
+	}
+	if resp.Command != "ColorGameCancelBetRSP" || resp.Data["error_code"] != float64(pbCommon.ErrorCode_SUCCESS) ||
+		resp.Data["refund_amount"] != float64(100) || resp.Data["remaining_amount"] != float64(0) {
+		t.Fatalf("Expected the bet to be cancelled through the gateway, got %s", respJSON)
+	}
+	if got := balance(7003); got != 1000 {
+		t.Fatalf("Expected balance 1000 after the cancellation, got %d", got)
+	}
+
+	// 7. Once betting closed the bet stands
+	fakeClock.Advance(stateMachine.BettingDuration)
+	if _, err := playerUC.CancelBet(ctx, 7001, "", red, 0, ""); !errors.Is(err, gsDomain.ErrRoundNotActive) {
+		t.Fatalf("Expected ErrRoundNotActive after betting closed, got %v", err)
+	}
+	if stood, _ := betRepo.GetUserBet(ctx, betting.RoundId, 7001, red); stood == nil || stood.Amount != 200 || balance(7001) != 800 {
+		t.Fatalf("Expected the bet of 200 to stand, got %+v balance %d", stood, balance(7001))
+	}
+
+	// 8. The bet repository closed betting when the betting phase ended, before any settlement
+	waitForState(t, roundBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_DRAWING, time.Second)
+	stood, _ := betRepo.GetUserBet(ctx, betting.RoundId, 7001, red)
+	if err := betRepo.CancelBet(ctx, stood, 200, 200, "late-refund"); !errors.Is(err, gsDomain.ErrRoundNotActive) {
+		t.Fatalf("Expected the bet repository to reject a cancel while drawing, got %v", err)
+	}
+
+	// 9. A void refunds what is left of a reduced bet, not the stakes it was placed with
+	if err := roundUC.VoidCurrentRound(ctx, "", betting.RoundId, "dealer error", "tester"); err != nil {
+		t.Fatalf("VoidCurrentRound failed: %v", err)
+	}
+	deadline := time.Now().Add(time.Second)
+	for betOrderRepo.Order(bet.BetID).Status != gsDomain.BetOrderStatusRefunded && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if got := balance(7001); got != 1000 {
+		t.Fatalf("Expected the player to get exactly the stake back, got balance %d", got)
+	}
+
+	cancel()
+	stateMachine.WaitForDone()
+}
+
+// closingGMS is a GMS whose betting ends right after it takes a stake back, it records every revoke request
+type closingGMS struct {
+	bettingGMS
+	closeBetting func()
+	revokes      []*pbColorGame.ColorGameRevokeBetReq
+}
+
+func (g *closingGMS) RevokeBet(ctx context.Context, req *pbColorGame.ColorGameRevokeBetReq) (*pbColorGame.ColorGameRevokeBetRsp, error) {
+	g.revokes = append(g.revokes, req)
+	if !req.Restore {
+		g.closeBetting()
+	}
+	return &pbColorGame.ColorGameRevokeBetRsp{ErrorCode: pbCommon.ErrorCode_SUCCESS}, nil
+}
+
+func TestCancelBetRacingBettingCloseRestoresRoundStats(t *testing.T) {
+	ctx := context.Background()
+	gms := &closingGMS{}
+	betRepo := gsRepo.NewBetRepository()
+	walletSvc := wallet.NewMockService()
+	playerUC := gsUC.NewGSUseCase(betRepo, &MockBetOrderRepository{}, gms, walletSvc, nil)
+	gms.closeBetting = func() {
+		if err := playerUC.CloseBetting(ctx, "", "r-1"); err != nil {
+			t.Errorf("CloseBetting failed: %v", err)
+		}
+	}
+
+	red := pbColorGame.ColorGameReward_REWARD_RED
+	walletSvc.SetBalance(7201, 1000)
+	bet, err := playerUC.PlaceBet(ctx, 7201, "", red, 100, "")
+	if err != nil {
+		t.Fatalf("PlaceBet failed: %v", err)
+	}
+
+	// 1. GMS accepted the cancel, betting closed before the bet was updated: the bet stands
+	if _, err := playerUC.CancelBet(ctx, 7201, "", red, 0, ""); !errors.Is(err, gsDomain.ErrRoundNotActive) {
+		t.Fatalf("Expected ErrRoundNotActive once betting closed, got %v", err)
+	}
+	if stood, _ := betRepo.GetUserBet(ctx, "r-1", 7201, red); stood == nil || stood.BetID != bet.BetID || stood.Amount != 100 {
+		t.Fatalf("Expected bet %s of 100 to stand, got %+v", bet.BetID, stood)
+	}
+	if b, _ := walletSvc.GetBalance(ctx, 7201); b != 900 {
+		t.Fatalf("Expected no refund, got balance %d", b)
+	}
+
+	// 2. The stake taken from the round stats is restored, bypassing the betting check
+	if len(gms.revokes) != 2 {
+		t.Fatalf("Expected a revoke and a restore, got %d requests", len(gms.revokes))
+	}
+	restore := gms.revokes[1]
+	if !restore.Restore || restore.Amount != 100 || !restore.Removed || restore.RoundId != "r-1" {
+		t.Errorf("Expected the whole stake of 100 restored, got %+v", restore)
+	}
+}
+
+func TestBetWritesDoNotResurrectCancelledBet(t *testing.T) {
+	ctx := context.Background()
+	betRepo := gsRepo.NewBetRepository()
+	now := time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC)
+	redBet := gsDomain.NewBet("b-red", "r-1", 7101, red, 100, "tx-1", now)
+	greenBet := gsDomain.NewBet("b-green", "r-1", 7101, green, 100, "tx-2", now)
+	for _, bet := range []*gsDomain.Bet{redBet, greenBet} {
+		if err := betRepo.SaveBet(ctx, bet); err != nil {
+			t.Fatalf("SaveBet failed: %v", err)
+		}
+	}
+
+	// 1. A raise read before a concurrent cancel must not write the cancelled bet back
+	stale := *redBet
+	if err := betRepo.CancelBet(ctx, redBet, 100, 100, "refund-1"); err != nil {
+		t.Fatalf("CancelBet failed: %v", err)
+	}
+	if err := betRepo.UpdateBetAmount(ctx, &stale, 50, "tx-3"); !errors.Is(err, gsDomain.ErrBetChanged) {
+		t.Fatalf("Expected ErrBetChanged for the cancelled bet, got %v", err)
+	}
+	if bet, _ := betRepo.GetUserBet(ctx, "r-1", 7101, red); bet != nil {
+		t.Fatalf("Expected the cancelled bet to stay cancelled, got %+v", bet)
+	}
+
+	// 2. A slip with a line on a reduced bet saves none of its bets
+	raised := *greenBet
+	raised.Amount = 150
+	blueBet := gsDomain.NewBet("b-blue", "r-1", 7101, blue, 100, "tx-4", now)
+	if err := betRepo.CancelBet(ctx, greenBet, 100, 40, "refund-2"); err != nil {
+		t.Fatalf("CancelBet failed: %v", err)
+	}
+	if err := betRepo.SaveBetSlip(ctx, []*gsDomain.Bet{blueBet}, []*gsDomain.Bet{&raised}, []int64{100}); !errors.Is(err, gsDomain.ErrBetChanged) {
+		t.Fatalf("Expected ErrBetChanged for the reduced bet, got %v", err)
+	}
+	bets, _ := betRepo.GetUserBets(ctx, "r-1", 7101)
+	if len(bets) != 1 || bets[0].Amount != 60 {
+		t.Fatalf("Expected only the reduced green bet of 60, got %+v", bets)
+	}
+
+	// 3. Once the settlement closed betting of the round, the bet stands
+	if err := betRepo.CloseBetting(ctx, "r-1"); err != nil {
+		t.Fatalf("CloseBetting failed: %v", err)
+	}
+	if err := betRepo.CancelBet(ctx, greenBet, 60, 60, "refund-3"); !errors.Is(err, gsDomain.ErrRoundNotActive) {
+		t.Fatalf("Expected ErrRoundNotActive after betting closed, got %v", err)
+	}
+	if bet, _ := betRepo.GetUserBet(ctx, "r-1", 7101, green); bet == nil || bet.Amount != 60 {
+		t.Fatalf("Expected the green bet of 60 to stand, got %+v", bet)
+	}
+}
@@ -0,0 +1,322 @@
+package colorgame_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	pbCommon "github.com/frankieli/game_product/shared/proto/common"
+
+	gsDomain "github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	gsRepo "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/memory"
+	gsUC "github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
+	"github.com/frankieli/game_product/internal/modules/wallet"
+	"github.com/frankieli/game_product/pkg/clock"
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
+)
+
+// bettingGMS is a GMS whose table is always betting on round r-1, RecordBet fails while failRecord is set
+type bettingGMS struct {
+	colorgame.GMSService
+	mu         sync.Mutex
+	failRecord bool
+}
+
+func (g *bettingGMS) GetCurrentRound(ctx context.Context, req *pbColorGame.ColorGameGetCurrentRoundReq) (*pbColorGame.ColorGameGetCurrentRoundRsp, error) {
+	return &pbColorGame.ColorGameGetCurrentRoundRsp{
+		ErrorCode: pbCommon.ErrorCode_SUCCESS,
+		TableId:   colorgame.DefaultTableID,
+		RoundId:   "r-1",
+		State:     pbColorGame.ColorGameState_GAME_STATE_BETTING,
+	}, nil
+}
+
+func (g *bettingGMS) RecordBet(ctx context.Context, req *pbColorGame.ColorGameRecordBetReq) (*pbColorGame.ColorGameRecordBetRsp, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.failRecord {
+		return nil, errors.New("gms unavailable")
+	}
+	return &pbColorGame.ColorGameRecordBetRsp{ErrorCode: pbCommon.ErrorCode_SUCCESS}, nil
+}
+
+// flakyWallet fails the next rollbackFailures rollbacks and settleWinFailures payouts
+type flakyWallet struct {
+	*wallet.MockService
+	mu                sync.Mutex
+	rollbackFailures  int
+	settleWinFailures int
+}
+
+func (w *flakyWallet) SettleWin(ctx context.Context, userID int64, amount int64, roundID string, txID string) (int64, error) {
+	w.mu.Lock()
+	if w.settleWinFailures > 0 {
+		w.settleWinFailures--
+		w.mu.Unlock()
+		return 0, errors.New("wallet unavailable")
+	}
+	w.mu.Unlock()
+	return w.MockService.SettleWin(ctx, userID, amount, roundID, txID)
+}
+
+func (w *flakyWallet) Rollback(ctx context.Context, originalTxID string, reason string) (int64, error) {
+	w.mu.Lock()
+	if w.rollbackFailures > 0 {
+		w.rollbackFailures--
+		w.mu.Unlock()
+		return 0, errors.New("wallet unavailable")
+	}
+	w.mu.Unlock()
+	return w.MockService.Rollback(ctx, originalTxID, reason)
+}
+
+func TestPlaceBetCompensation(t *testing.T) {
+	ctx := context.Background()
+	fakeClock := clock.NewFake(time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC))
+	gms := &bettingGMS{}
+	walletSvc := &flakyWallet{MockService: wallet.NewMockService()}
+	intentRepo := gsRepo.NewBetIntentRepository()
+
+	playerUC := gsUC.NewGSUseCase(gsRepo.NewBetRepository(), &MockBetOrderRepository{}, gms, walletSvc, nil)
+	playerUC.SetClock(fakeClock)
+	playerUC.SetBetIntentRepository(intentRepo)
+
+	compensating := func() []*gsDomain.BetIntent {
+		intents, err := playerUC.ListCompensations(ctx, gsDomain.BetIntentStatusCompensating, 0)
+		if err != nil {
+			t.Fatalf("ListCompensations failed: %v", err)
+		}
+		return intents
+	}
+	balance := func(userID int64) int64 {
+		b, _ := walletSvc.GetBalance(ctx, userID)
+		return b
+	}
+
+	// 1. A placed bet leaves no intent behind
+	walletSvc.SetBalance(6001, 1000)
+	if _, err := playerUC.PlaceBet(ctx, 6001, "", pbColorGame.ColorGameReward_REWARD_RED, 100, ""); err != nil {
+		t.Fatalf("PlaceBet failed: %v", err)
+	}
+	if due, _ := intentRepo.ListDue(ctx, fakeClock.Now().Add(time.Hour), 10); len(due) != 0 {
+		t.Errorf("Expected no intent after a placed bet, got %d", len(due))
+	}
+
+	// 2. GMS fails after the deduction and the first rollback fails: the stake is owed to the player
+	gms.failRecord = true
+	walletSvc.rollbackFailures = 2
+	if _, err := playerUC.PlaceBet(ctx, 6001, "", pbColorGame.ColorGameReward_REWARD_GREEN, 200, ""); err == nil {
+		t.Fatal("Expected PlaceBet to fail when GMS is down")
+	}
+	stuck := compensating()
+	if len(stuck) != 1 || stuck[0].Attempts != 1 || stuck[0].Amount != 200 || stuck[0].LastError == "" {
+		t.Fatalf("Expected one stuck compensation after the first attempt, got %+v", stuck)
+	}
+	if got := balance(6001); got != 700 {
+		t.Fatalf("Expected the stake to be deducted until compensated, got balance %d", got)
+	}
+
+	// 3. The worker retries with backoff until the wallet accepts the rollback
+	if n, _ := playerUC.RunCompensations(ctx); n != 0 || compensating()[0].Attempts != 1 {
+		t.Error("Expected no retry before the backoff elapsed")
+	}
+	fakeClock.Advance(time.Second)
+	if n, _ := playerUC.RunCompensations(ctx); n != 0 || compensating()[0].Attempts != 2 {
+		t.Error("Expected the second attempt to fail")
+	}
+	fakeClock.Advance(time.Second)
+	if n, _ := playerUC.RunCompensations(ctx); n != 0 {
+		t.Error("Expected the backoff to double after the second failure")
+	}
+	fakeClock.Advance(time.Second)
+	if n, _ := playerUC.RunCompensations(ctx); n != 1 {
+		t.Errorf("Expected the third attempt to compensate, got %d", n)
+	}
+	if got := balance(6001); got != 900 || len(compensating()) != 0 {
+		t.Errorf("Expected the stake to be returned, got balance %d and %d stuck", got, len(compensating()))
+	}
+
+	// 4. A GS that died after the deduction leaves a pending intent, it is rolled back after the timeout
+	gms.failRecord = false
+	walletSvc.SetBalance(6002, 1000)
+	_, _ = walletSvc.PlaceBet(ctx, 6002, 300, "r-1", "tx-crashed")
+	_ = intentRepo.Create(ctx, &gsDomain.BetIntent{
+		TxID:        "tx-crashed",
+		RoundID:     "r-1",
+		UserID:      6002,
+		Color:       pbColorGame.ColorGameReward_REWARD_BLUE,
+		Amount:      300,
+		NextRetryAt: fakeClock.Now().Add(time.Minute),
+		CreatedAt:   fakeClock.Now(),
+		UpdatedAt:   fakeClock.Now(),
+	})
+	if n, _ := playerUC.RunCompensations(ctx); n != 0 {
+		t.Error("Expected an in-flight intent to be left alone")
+	}
+	fakeClock.Advance(time.Minute)
+	if n, _ := playerUC.RunCompensations(ctx); n != 1 || balance(6002) != 1000 {
+		t.Errorf("Expected the crashed bet to be compensated, got %d and balance %d", n, balance(6002))
+	}
+
+	// 5. A crash while saving the bet needs an operator, unless the bet exists
+	walletSvc.SetBalance(6003, 1000)
+	_, _ = walletSvc.PlaceBet(ctx, 6003, 400, "r-1", "tx-saving")
+	_ = intentRepo.Create(ctx, &gsDomain.BetIntent{
+		TxID:        "tx-saving",
+		RoundID:     "r-1",
+		UserID:      6003,
+		Color:       pbColorGame.ColorGameReward_REWARD_RED,
+		Amount:      400,
+		Status:      gsDomain.BetIntentStatusPlaced,
+		NextRetryAt: fakeClock.Now(),
+		CreatedAt:   fakeClock.Now(),
+		UpdatedAt:   fakeClock.Now(),
+	})
+	if n, _ := playerUC.RunCompensations(ctx); n != 0 || balance(6003) != 600 {
+		t.Error("Expected a placed intent not to be rolled back automatically")
+	}
+	placed, _ := playerUC.ListCompensations(ctx, gsDomain.BetIntentStatusPlaced, 0)
+	if len(placed) != 1 || placed[0].LastError == "" {
+		t.Fatalf("Expected the placed intent to be flagged for operators, got %+v", placed)
+	}
+	intent, err := playerUC.RetryCompensation(ctx, "tx-saving", "tester")
+	if err != nil || intent.Status != gsDomain.BetIntentStatusCompensated || balance(6003) != 1000 {
+		t.Errorf("Expected the operator to compensate the bet, got %+v %v and balance %d", intent, err, balance(6003))
+	}
+	if _, err := playerUC.RetryCompensation(ctx, "tx-unknown", "tester"); !errors.Is(err, gsDomain.ErrBetIntentNotFound) {
+		t.Errorf("Expected an unknown intent to be reported, got %v", err)
+	}
+}
+
+func TestVoidRefundRetry(t *testing.T) {
+	ctx := context.Background()
+	fakeClock := clock.NewFake(time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC))
+	walletSvc := &flakyWallet{MockService: wallet.NewMockService()}
+	betOrderRepo := &MockBetOrderRepository{}
+
+	playerUC := gsUC.NewGSUseCase(gsRepo.NewBetRepository(), betOrderRepo, &bettingGMS{}, walletSvc, nil)
+	playerUC.SetClock(fakeClock)
+	playerUC.SetBetIntentRepository(gsRepo.NewBetIntentRepository())
+
+	balance := func(userID int64) int64 {
+		b, _ := walletSvc.GetBalance(ctx, userID)
+		return b
+	}
+
+	// 1. A bet refunded by rollback and a slip refunded by deposit, both refunds fail
+	walletSvc.SetBalance(6101, 1000)
+	walletSvc.SetBalance(6102, 1000)
+	bet, err := playerUC.PlaceBet(ctx, 6101, "", red, 100, "")
+	if err != nil {
+		t.Fatalf("PlaceBet failed: %v", err)
+	}
+	slip, err := playerUC.PlaceBetSlip(ctx, 6102, "", []gsDomain.BetLine{{Color: red, Amount: 100}, {Color: green, Amount: 200}}, "")
+	if err != nil {
+		t.Fatalf("PlaceBetSlip failed: %v", err)
+	}
+	walletSvc.rollbackFailures = 1
+	walletSvc.settleWinFailures = 2
+	if err := playerUC.VoidRound(ctx, "", "r-1", "dealer error"); err != nil {
+		t.Fatalf("VoidRound failed: %v", err)
+	}
+
+	// 2. The refunds are queued before the batch is acked, the bet orders are refunded
+	if balance(6101) != 900 || balance(6102) != 700 {
+		t.Fatalf("Expected the stakes to be owed, got balances %d and %d", balance(6101), balance(6102))
+	}
+	for _, betID := range []string{bet.BetID, slip[0].BetID, slip[1].BetID} {
+		if order := betOrderRepo.Order(betID); order == nil || order.Status != gsDomain.BetOrderStatusRefunded {
+			t.Errorf("Expected bet order %s refunded, got %+v", betID, order)
+		}
+	}
+	queued, _ := playerUC.ListCompensations(ctx, gsDomain.BetIntentStatusCompensating, 0)
+	if len(queued) != 1 || queued[0].TxID != bet.TxIDs[0] || queued[0].Amount != 100 {
+		t.Fatalf("Expected the rollback of the bet to be queued, got %+v", queued)
+	}
+
+	// 3. The workers return every stake once the wallet recovers
+	fakeClock.Advance(time.Second)
+	if n, _ := playerUC.RunCompensations(ctx); n != 1 || balance(6101) != 1000 {
+		t.Errorf("Expected the rollback to be retried, got %d and balance %d", n, balance(6101))
+	}
+	if n, _ := playerUC.RunPayouts(ctx); n != 2 || balance(6102) != 1000 {
+		t.Errorf("Expected both slip refunds to be deposited, got %d and balance %d", n, balance(6102))
+	}
+}
+
+// failingBetRepository fails every bet write while fail is set
+type failingBetRepository struct {
+	*gsRepo.BetRepository
+	fail bool
+}
+
+func (r *failingBetRepository) SaveBet(ctx context.Context, bet *gsDomain.Bet) error {
+	if r.fail {
+		return errors.New("redis unavailable")
+	}
+	return r.BetRepository.SaveBet(ctx, bet)
+}
+
+func (r *failingBetRepository) UpdateBetAmount(ctx context.Context, bet *gsDomain.Bet, additionalAmount int64, txID string) error {
+	if r.fail {
+		return errors.New("redis unavailable")
+	}
+	return r.BetRepository.UpdateBetAmount(ctx, bet, additionalAmount, txID)
+}
+
+func (r *failingBetRepository) SaveBetSlip(ctx context.Context, created []*gsDomain.Bet, updated []*gsDomain.Bet, stakes []int64) error {
+	if r.fail {
+		return errors.New("redis unavailable")
+	}
+	return r.BetRepository.SaveBetSlip(ctx, created, updated, stakes)
+}
+
+func TestAbortedPlacementRevertsBetOrders(t *testing.T) {
+	ctx := context.Background()
+	betRepo := &failingBetRepository{BetRepository: gsRepo.NewBetRepository()}
+	betOrderRepo := &MockBetOrderRepository{}
+	walletSvc := wallet.NewMockService()
+	playerUC := gsUC.NewGSUseCase(betRepo, betOrderRepo, &bettingGMS{}, walletSvc, nil)
+	walletSvc.SetBalance(6201, 1000)
+
+	bet, err := playerUC.PlaceBet(ctx, 6201, "", red, 100, "")
+	if err != nil {
+		t.Fatalf("PlaceBet failed: %v", err)
+	}
+
+	// 1. A new bet that cannot be saved leaves a cancelled order
+	betRepo.fail = true
+	if _, err := playerUC.PlaceBet(ctx, 6201, "", green, 200, ""); err == nil {
+		t.Fatal("Expected PlaceBet to fail")
+	}
+	orders, _ := betOrderRepo.ListByRound(ctx, "r-1")
+	if len(orders) != 2 || orders[1].Status != gsDomain.BetOrderStatusCancelled || orders[1].SettledAt == nil {
+		t.Fatalf("Expected the order of the green bet to be cancelled, got %+v", orders)
+	}
+
+	// 2. A raise that cannot be saved gives the order its previous stake back
+	if _, err := playerUC.PlaceBet(ctx, 6201, "", red, 50, ""); err == nil {
+		t.Fatal("Expected PlaceBet to fail")
+	}
+	if order := betOrderRepo.Order(bet.BetID); order.Status != gsDomain.BetOrderStatusPending || order.Amount != 100 || order.TxIDs != bet.TxIDs[0] {
+		t.Fatalf("Expected the red order back at 100, got %+v", order)
+	}
+
+	// 3. A slip that cannot be saved reverts every order it wrote
+	if _, err := playerUC.PlaceBetSlip(ctx, 6201, "", []gsDomain.BetLine{{Color: red, Amount: 100}, {Color: blue, Amount: 100}}, ""); err == nil {
+		t.Fatal("Expected PlaceBetSlip to fail")
+	}
+	orders, _ = betOrderRepo.ListByRound(ctx, "r-1")
+	if len(orders) != 3 || orders[2].Status != gsDomain.BetOrderStatusCancelled {
+		t.Fatalf("Expected the order of the blue line to be cancelled, got %+v", orders)
+	}
+	if order := betOrderRepo.Order(bet.BetID); order.Status != gsDomain.BetOrderStatusPending || order.Amount != 100 {
+		t.Fatalf("Expected the red order back at 100, got %+v", order)
+	}
+	if b, _ := walletSvc.GetBalance(ctx, 6201); b != 900 {
+		t.Fatalf("Expected only the red stake to be deducted, got balance %d", b)
+	}
+}
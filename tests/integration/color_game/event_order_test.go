@@ -0,0 +1,194 @@
+package colorgame_test
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"google.golang.org/protobuf/proto"
+
+	gmsLocal "github.com/frankieli/game_product/internal/modules/color_game/gms/adapter/local"
+	gmsDomain "github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	gmsMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+	"github.com/frankieli/game_product/pkg/clock"
+)
+
+// roundPhases is the order of the events of a round
+var roundPhases = []pbColorGame.ColorGameState{
+	pbColorGame.ColorGameState_GAME_STATE_ROUND_STARTED,
+	pbColorGame.ColorGameState_GAME_STATE_BETTING,
+	pbColorGame.ColorGameState_GAME_STATE_DRAWING,
+	pbColorGame.ColorGameState_GAME_STATE_RESULT,
+	pbColorGame.ColorGameState_GAME_STATE_ROUND_ENDED,
+}
+
+// driveFakeClock advances the fake clock past every phase the state machine waits for until ctx is done
+func driveFakeClock(ctx context.Context, fakeClock *clock.Fake) {
+	for ctx.Err() == nil {
+		if fakeClock.Timers() > 0 {
+			fakeClock.Advance(time.Minute)
+			continue
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestGameEventsAreSequencedAndOrderedPerHandler(t *testing.T) {
+	const rounds = 3
+
+	fakeClock := clock.NewFake(time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC))
+	stateMachine := gmsMachine.NewStateMachine()
+	stateMachine.SetClock(fakeClock)
+	stateMachine.SetEventBufferSize(8)
+
+	// A slow handler must still see the events in emission order
+	var mu sync.Mutex
+	var received []gmsMachine.GameEvent
+	roundsEnded := make(chan struct{}, 10)
+	stateMachine.RegisterEventHandler(func(event gmsMachine.GameEvent) {
+		time.Sleep(time.Duration(rand.Intn(3)) * time.Millisecond)
+		mu.Lock()
+		received = append(received, event)
+		mu.Unlock()
+		if event.Type == pbColorGame.ColorGameState_GAME_STATE_ROUND_ENDED {
+			roundsEnded <- struct{}{}
+		}
+	})
+
+	broadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	roundUC := gmsUC.NewGMSUseCase(stateMachine, broadcaster, nil, &MockGameRoundRepository{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go stateMachine.Start(ctx)
+	go driveFakeClock(ctx, fakeClock)
+
+	for i := 0; i < rounds; i++ {
+		select {
+		case <-roundsEnded:
+		case <-ctx.Done():
+			t.Fatalf("Timeout waiting for round %d to end", i+1)
+		}
+	}
+	cancel()
+	stateMachine.WaitForDone()
+
+	// 1. Every round is delivered as seq 1..5 in phase order
+	mu.Lock()
+	events := append([]gmsMachine.GameEvent(nil), received...)
+	mu.Unlock()
+
+	var roundIDs []string
+	var lastEnded string
+	for i, event := range events {
+		if event.Seq == 1 {
+			roundIDs = append(roundIDs, event.RoundID)
+		}
+		if event.Type == pbColorGame.ColorGameState_GAME_STATE_ROUND_ENDED {
+			lastEnded = event.RoundID
+		}
+		if event.Seq < 1 || int(event.Seq) > len(roundPhases) {
+			t.Fatalf("Event %d: unexpected seq %d", i, event.Seq)
+		}
+		if expected := roundPhases[event.Seq-1]; event.Type != expected {
+			t.Errorf("Event %d of round %s: expected %s at seq %d, got %s", i, event.RoundID, expected, event.Seq, event.Type)
+		}
+		if i > 0 && event.Seq != 1 && (event.RoundID != events[i-1].RoundID || event.Seq != events[i-1].Seq+1) {
+			t.Errorf("Event %d: %s seq %d does not follow %s seq %d", i, event.RoundID, event.Seq, events[i-1].RoundID, events[i-1].Seq)
+		}
+	}
+	if len(roundIDs) < rounds {
+		t.Fatalf("Expected at least %d rounds, got %v", rounds, roundIDs)
+	}
+
+	// 2. Broadcasts carry the sequence number
+	brc := waitForState(t, broadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_DRAWING, time.Second)
+	if brc.Seq != 3 {
+		t.Errorf("Expected DRAWING broadcast with seq 3, got %d", brc.Seq)
+	}
+
+	// 3. Resync from the ring buffer after a gap in the last finished round
+	// (the clock keeps running until the state machine stops, it may have started another round since)
+	handler := gmsLocal.NewHandler(roundUC)
+	rsp, err := handler.GetRecentEvents(context.Background(), &pbColorGame.ColorGameGetRecentEventsReq{
+		RoundId:  lastEnded,
+		AfterSeq: 2,
+	})
+	if err != nil {
+		t.Fatalf("GetRecentEvents failed: %v", err)
+	}
+	if !rsp.Complete || len(rsp.Events) < 3 {
+		t.Fatalf("Expected a complete resync of at least 3 events, got complete=%v events=%d", rsp.Complete, len(rsp.Events))
+	}
+	for i, state := range roundPhases[2:] {
+		if rsp.Events[i].RoundId != lastEnded || rsp.Events[i].State != state || rsp.Events[i].Seq != int64(i+3) {
+			t.Errorf("Resync event %d: expected %s seq %d, got %s seq %d", i, state, i+3, rsp.Events[i].State, rsp.Events[i].Seq)
+		}
+	}
+
+	// 4. The first round was overwritten (buffer of 8 events), the gap can no longer be filled
+	rsp, err = handler.GetRecentEvents(context.Background(), &pbColorGame.ColorGameGetRecentEventsReq{
+		RoundId:  roundIDs[0],
+		AfterSeq: 2,
+	})
+	if err != nil {
+		t.Fatalf("GetRecentEvents failed: %v", err)
+	}
+	if rsp.Complete || len(rsp.Events) != 8 {
+		t.Errorf("Expected an incomplete resync with the whole buffer, got complete=%v events=%d", rsp.Complete, len(rsp.Events))
+	}
+}
+
+// slowGameRoundRepository holds every round creation until released, like a database that stopped answering
+type slowGameRoundRepository struct {
+	MockGameRoundRepository
+	release chan struct{}
+}
+
+func (r *slowGameRoundRepository) Create(ctx context.Context, round *gmsDomain.GameRound) error {
+	<-r.release
+	return r.MockGameRoundRepository.Create(ctx, round)
+}
+
+func TestSlowConsumersDoNotHoldBackRounds(t *testing.T) {
+	const rounds = 30 // 150 events, more than a handler queue holds
+
+	fakeClock := clock.NewFake(time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC))
+	stateMachine := gmsMachine.NewStateMachine()
+	stateMachine.SetClock(fakeClock)
+
+	// 1. A handler stuck on its first event and a database that does not answer
+	stuck := make(chan struct{})
+	stateMachine.RegisterEventHandler(func(event gmsMachine.GameEvent) {
+		<-stuck
+	})
+	gameRoundRepo := &slowGameRoundRepository{release: make(chan struct{})}
+	broadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 1000)}
+	roundUC := gmsUC.NewGMSUseCase(stateMachine, broadcaster, nil, gameRoundRepo)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go stateMachine.Start(ctx)
+	go driveFakeClock(ctx, fakeClock)
+
+	// 2. The rounds go on and are broadcast, the stuck handler misses the events its queue cannot hold
+	for i := 0; i < rounds; i++ {
+		waitForState(t, broadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_ROUND_ENDED, time.Second)
+	}
+
+	// 3. The rounds are persisted in order once the database answers again
+	close(gameRoundRepo.release)
+	close(stuck)
+	cancel()
+	stateMachine.WaitForDone()
+	if err := roundUC.GracefulShutdown(time.Second); err != nil {
+		t.Fatalf("GracefulShutdown failed: %v", err)
+	}
+	if recent, _ := gameRoundRepo.ListRecent(context.Background(), stateMachine.TableID, rounds+10); len(recent) < rounds {
+		t.Errorf("Expected at least %d persisted rounds, got %d", rounds, len(recent))
+	}
+}
@@ -0,0 +1,176 @@
+package colorgame_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"google.golang.org/protobuf/proto"
+
+	gmsDomain "github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	gmsMemory "github.com/frankieli/game_product/internal/modules/color_game/gms/repository/memory"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+)
+
+func TestGMSLeaderFailover(t *testing.T) {
+	// 1. Two replicas share the snapshot store
+	snapshotRepo := gmsMemory.NewRoundSnapshotRepository()
+
+	leader := newRecoveryStateMachine(snapshotRepo)
+	leader.BettingDuration = 400 * time.Millisecond
+	leader.SetFencingToken(1)
+	leaderBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	gmsUC.NewGMSUseCase(leader, leaderBroadcaster, nil, &MockGameRoundRepository{})
+
+	follower := newRecoveryStateMachine(snapshotRepo)
+	followerBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	gmsUC.NewGMSUseCase(follower, followerBroadcaster, nil, &MockGameRoundRepository{})
+
+	leaderCtx, loseLeadership := context.WithCancel(context.Background())
+	defer loseLeadership()
+	go leader.Start(leaderCtx)
+
+	// 2. The follower serves the leader's round from shared state
+	betting := waitForState(t, leaderBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_BETTING, time.Second)
+	view := follower.GetCurrentRound()
+	if view.RoundID != betting.RoundId || view.State != pbColorGame.ColorGameState_GAME_STATE_BETTING {
+		t.Fatalf("Expected follower to see round %s BETTING, got %s %s", betting.RoundId, view.RoundID, view.State)
+	}
+	if !follower.CanAcceptBet() {
+		t.Error("Expected follower to accept bets during the leader's betting phase")
+	}
+
+	// 3. The leader loses its lease in the middle of betting, the follower takes over with a newer token
+	loseLeadership()
+	leader.WaitForDone()
+
+	follower.SetFencingToken(2)
+	followerCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	go follower.Start(followerCtx)
+
+	// 4. The in-flight round is finished by the new leader instead of being lost
+	result := waitForState(t, followerBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_RESULT, time.Second)
+	if result.RoundId != betting.RoundId {
+		t.Errorf("Expected new leader to finish round %s, got %s", betting.RoundId, result.RoundId)
+	}
+	follower.Stop()
+
+	// 5. The deposed leader can no longer overwrite the shared state
+	err := snapshotRepo.Save(context.Background(), &gmsDomain.RoundSnapshot{RoundID: "stale", FencingToken: 1})
+	if err != gmsDomain.ErrFencedOut {
+		t.Errorf("Expected ErrFencedOut for stale fencing token, got %v", err)
+	}
+}
+
+func TestGMSFailoverKeepsOperatorControls(t *testing.T) {
+	// 1. The leader's table is paused by an operator during betting
+	snapshotRepo := gmsMemory.NewRoundSnapshotRepository()
+
+	leader := newRecoveryStateMachine(snapshotRepo)
+	leader.BettingDuration = 2 * time.Second
+	leader.SetFencingToken(1)
+	leaderBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	gmsUC.NewGMSUseCase(leader, leaderBroadcaster, nil, &MockGameRoundRepository{})
+
+	leaderCtx, loseLeadership := context.WithCancel(context.Background())
+	defer loseLeadership()
+	go leader.Start(leaderCtx)
+
+	betting := waitForState(t, leaderBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_BETTING, time.Second)
+	if err := leader.Pause(); err != nil {
+		t.Fatalf("Pause failed: %v", err)
+	}
+	snapshot, _ := snapshotRepo.Load(context.Background())
+	if snapshot == nil || !snapshot.Paused {
+		t.Fatalf("Expected the pause in the snapshot, got %+v", snapshot)
+	}
+
+	// 2. A void is requested, the leader fails over before carrying it out
+	loseLeadership()
+	leader.WaitForDone()
+	snapshot, _ = snapshotRepo.Load(context.Background())
+	snapshot.VoidReason = "table malfunction"
+	if err := snapshotRepo.Save(context.Background(), snapshot); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	// 3. The new leader voids the round and keeps the table paused
+	follower := newRecoveryStateMachine(snapshotRepo)
+	follower.SetFencingToken(2)
+	followerBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	gsBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	gmsUC.NewGMSUseCase(follower, followerBroadcaster, gsBroadcaster, &MockGameRoundRepository{})
+
+	followerCtx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	go follower.Start(followerCtx)
+	defer follower.Stop()
+
+	refund := waitForVoidRound(t, gsBroadcaster.Messages, time.Second)
+	if refund.RoundId != betting.RoundId || refund.Reason != "table malfunction" {
+		t.Errorf("Expected round %s voided (table malfunction), got %s (%s)", betting.RoundId, refund.RoundId, refund.Reason)
+	}
+	waitForState(t, followerBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_PAUSED, time.Second)
+	if !follower.IsPaused() {
+		t.Error("Expected the new leader to keep the table paused")
+	}
+}
+
+func TestFollowerRevokeBetRejectedOnceBettingClosed(t *testing.T) {
+	// 1. Two replicas share the snapshot store and the round stats
+	snapshotRepo := gmsMemory.NewRoundSnapshotRepository()
+	statsRepo := gmsMemory.NewRoundStatsRepository()
+
+	leader := newRecoveryStateMachine(snapshotRepo)
+	leader.DrawingDuration = time.Second
+	leaderBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	leaderUC := gmsUC.NewGMSUseCase(leader, leaderBroadcaster, nil, &MockGameRoundRepository{})
+	leaderUC.SetRoundStatsRepository(statsRepo)
+
+	follower := newRecoveryStateMachine(snapshotRepo)
+	followerUC := gmsUC.NewGMSUseCase(follower, &TestBroadcaster{Messages: make(chan proto.Message, 100)}, nil, &MockGameRoundRepository{})
+	followerUC.SetRoundStatsRepository(statsRepo)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	go leader.Start(ctx)
+	defer leader.Stop()
+
+	// 2. A stake is cancelled through the follower while betting is open
+	red := pbColorGame.ColorGameReward_REWARD_RED
+	betting := waitForState(t, leaderBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_BETTING, time.Second)
+	if err := leaderUC.RecordBet(ctx, "", betting.RoundId, 9001, red, 100); err != nil {
+		t.Fatalf("RecordBet failed: %v", err)
+	}
+	if err := followerUC.RevokeBet(ctx, "", betting.RoundId, 9001, red, 40, false); err != nil {
+		t.Fatalf("RevokeBet during betting failed: %v", err)
+	}
+
+	// 3. Betting closed, the follower still reads a snapshot from before (stale phase)
+	waitForState(t, leaderBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_DRAWING, time.Second)
+	stale, _ := snapshotRepo.Load(ctx)
+	stale.State = pbColorGame.ColorGameState_GAME_STATE_BETTING
+	stale.BettingEnd = time.Now().Add(time.Second)
+	_ = snapshotRepo.Save(ctx, stale)
+
+	// 4. The stats reject the cancellation themselves
+	if err := followerUC.RevokeBet(ctx, "", betting.RoundId, 9001, red, 60, true); !errors.Is(err, gmsDomain.ErrBettingClosed) {
+		t.Errorf("Expected ErrBettingClosed after betting closed, got %v", err)
+	}
+	stats, _ := statsRepo.Get(ctx, betting.RoundId)
+	if stats.TotalAmount != 60 || stats.TotalBets != 1 {
+		t.Errorf("Expected 1 bet of 60 left in the stats, got %d bets of %d", stats.TotalBets, stats.TotalAmount)
+	}
+
+	// 5. A stake revoked during betting whose bet could not be cancelled is still restored
+	if err := followerUC.RestoreBet(ctx, "", betting.RoundId, 9001, red, 40, false); err != nil {
+		t.Fatalf("RestoreBet after betting closed failed: %v", err)
+	}
+	stats, _ = statsRepo.Get(ctx, betting.RoundId)
+	if stats.TotalAmount != 100 || stats.Pools[red].Amount != 100 {
+		t.Errorf("Expected the stake of 100 back in the stats, got %d (red %+v)", stats.TotalAmount, stats.Pools[red])
+	}
+}
@@ -0,0 +1,89 @@
+package colorgame_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"google.golang.org/protobuf/proto"
+
+	gmsDomain "github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	gmsMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+)
+
+func TestProvablyFairRound(t *testing.T) {
+	// 1. Setup GMS in provably fair mode
+	stateMachine := gmsMachine.NewStateMachine()
+	stateMachine.WaitDuration = 50 * time.Millisecond
+	stateMachine.BettingDuration = 100 * time.Millisecond
+	stateMachine.DrawingDuration = 50 * time.Millisecond
+	stateMachine.ResultDuration = 100 * time.Millisecond
+	stateMachine.RestDuration = 50 * time.Millisecond
+	stateMachine.SetResultProvider(gmsMachine.NewFairProvider("public-seed"))
+
+	gatewayBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	gameRoundRepo := &MockGameRoundRepository{}
+	roundUC := gmsUC.NewGMSUseCase(stateMachine, gatewayBroadcaster, nil, gameRoundRepo)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	go stateMachine.Start(ctx)
+
+	// 2. Collect the commitment (round start) and the reveal (result)
+	var started, result *pbColorGame.ColorGameRoundStateBRC
+	timeout := time.After(1500 * time.Millisecond)
+
+collectLoop:
+	for result == nil {
+		select {
+		case msg := <-gatewayBroadcaster.Messages:
+			brc, ok := msg.(*pbColorGame.ColorGameRoundStateBRC)
+			if !ok {
+				continue
+			}
+			switch brc.State {
+			case pbColorGame.ColorGameState_GAME_STATE_ROUND_STARTED:
+				if started == nil {
+					started = brc
+				}
+			case pbColorGame.ColorGameState_GAME_STATE_RESULT:
+				if started != nil && brc.RoundId == started.RoundId {
+					result = brc
+				}
+			}
+		case <-timeout:
+			break collectLoop
+		}
+	}
+	stateMachine.Stop()
+
+	if started == nil || result == nil {
+		t.Fatal("Did not receive ROUND_STARTED and RESULT for the same round")
+	}
+
+	// 3. The seed must be hidden at round start and match the commitment when revealed
+	if started.ServerSeedHash == "" || started.ServerSeed != "" {
+		t.Fatalf("Expected only the seed hash at round start, got hash=%q seed=%q", started.ServerSeedHash, started.ServerSeed)
+	}
+	if gmsDomain.HashServerSeed(result.ServerSeed) != started.ServerSeedHash {
+		t.Errorf("Revealed seed does not match the published hash")
+	}
+
+	// Give the event handler a moment to persist the revealed seed
+	time.Sleep(50 * time.Millisecond)
+
+	// 4. Verification recomputes the same result
+	proof, err := roundUC.VerifyRound(context.Background(), started.RoundId)
+	if err != nil {
+		t.Fatalf("VerifyRound failed: %v", err)
+	}
+	if !proof.Verified {
+		t.Errorf("Expected round to verify, got result=%s computed=%s hash_matched=%v", proof.Result, proof.ComputedResult, proof.HashMatched)
+	}
+	if proof.ClientSeed != "public-seed" {
+		t.Errorf("Expected client seed public-seed, got %s", proof.ClientSeed)
+	}
+}
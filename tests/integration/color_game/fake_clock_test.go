@@ -0,0 +1,124 @@
+package colorgame_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"google.golang.org/protobuf/proto"
+
+	gmsLocal "github.com/frankieli/game_product/internal/modules/color_game/gms/adapter/local"
+	gmsMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+	gsLocal "github.com/frankieli/game_product/internal/modules/color_game/gs/adapter/local"
+	gsRepo "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/memory"
+	gsUC "github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
+	"github.com/frankieli/game_product/internal/modules/wallet"
+	"github.com/frankieli/game_product/pkg/clock"
+)
+
+func TestFullRoundWithFakeClock(t *testing.T) {
+	realStart := time.Now()
+
+	// 1. GMS with the default (production) phase durations on a fake clock
+	fakeClock := clock.NewFake(time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC))
+	stateMachine := gmsMachine.NewStateMachine()
+	stateMachine.SetClock(fakeClock)
+	stateMachine.SetResultProvider(gmsMachine.NewSeededProvider(7))
+
+	roundBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	roundUC := gmsUC.NewGMSUseCase(stateMachine, roundBroadcaster, nil, &MockGameRoundRepository{})
+
+	// 2. GS settles through the local adapter
+	betOrderRepo := &MockBetOrderRepository{}
+	walletSvc := wallet.NewMockService()
+	playerBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	playerUC := gsUC.NewGSUseCase(gsRepo.NewBetRepository(), betOrderRepo, gmsLocal.NewHandler(roundUC), walletSvc, playerBroadcaster)
+	playerUC.SetClock(fakeClock)
+	roundUC.SetGSService(gsLocal.NewHandler(playerUC))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go stateMachine.Start(ctx)
+
+	// 3. Round started: betting is closed until the waiting phase elapsed
+	waitForState(t, roundBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_ROUND_STARTED, time.Second)
+	fakeClock.BlockUntil(1)
+	if stateMachine.CanAcceptBet() {
+		t.Error("Expected bets to be rejected before betting opens")
+	}
+	fakeClock.Advance(stateMachine.WaitDuration)
+
+	// 4. Betting: one player on every color
+	betting := waitForState(t, roundBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_BETTING, time.Second)
+	fakeClock.BlockUntil(1)
+	bettingAt := fakeClock.Now()
+	if betting.BettingEndTimestamp != bettingAt.Add(stateMachine.BettingDuration).Unix() {
+		t.Errorf("Expected betting to end %s after the fake now, got %d", stateMachine.BettingDuration, betting.BettingEndTimestamp)
+	}
+
+	colors := map[int64]pbColorGame.ColorGameReward{
+		5001: pbColorGame.ColorGameReward_REWARD_RED,
+		5002: pbColorGame.ColorGameReward_REWARD_GREEN,
+		5003: pbColorGame.ColorGameReward_REWARD_BLUE,
+		5004: pbColorGame.ColorGameReward_REWARD_YELLOW,
+	}
+	for userID, color := range colors {
+		walletSvc.SetBalance(userID, 1000)
+		if _, err := playerUC.PlaceBet(ctx, userID, "", color, 100, ""); err != nil {
+			t.Fatalf("PlaceBet failed for user %d: %v", userID, err)
+		}
+	}
+
+	// Betting closes exactly at its end on the fake clock
+	fakeClock.Advance(stateMachine.BettingDuration - time.Millisecond)
+	if !stateMachine.CanAcceptBet() {
+		t.Error("Expected bets to be accepted until the betting end")
+	}
+	fakeClock.Advance(time.Millisecond)
+
+	// 5. Drawing, then the result triggers settlement
+	waitForState(t, roundBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_DRAWING, time.Second)
+	fakeClock.BlockUntil(1)
+	fakeClock.Advance(stateMachine.DrawingDuration)
+
+	waitForState(t, roundBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_RESULT, time.Second)
+
+	var winningColor pbColorGame.ColorGameReward
+	settled := 0
+	for settled < len(colors) {
+		select {
+		case msg := <-playerBroadcaster.Messages:
+			if brc, ok := msg.(*pbColorGame.ColorGameSettlementBRC); ok && brc.BetId != "" {
+				winningColor = brc.WinningColor
+				settled++
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Timeout waiting for settlement, %d of %d players settled", settled, len(colors))
+		}
+	}
+
+	// 6. Balances and bet timestamps follow the fake clock
+	for userID, color := range colors {
+		expected := int64(900)
+		if color == winningColor {
+			expected = 1100
+		}
+		if balance, _ := walletSvc.GetBalance(ctx, userID); balance != expected {
+			t.Errorf("User %d (%s, winning %s): expected balance %d, got %d", userID, color, winningColor, expected, balance)
+		}
+	}
+	for _, order := range betOrderRepo.Orders() {
+		if !order.CreatedAt.Equal(bettingAt) {
+			t.Errorf("Expected bet %s placed at fake time %s, got %s", order.OrderID, bettingAt, order.CreatedAt)
+		}
+	}
+
+	cancel()
+	stateMachine.WaitForDone()
+
+	if elapsed := time.Since(realStart); elapsed > 2*time.Second {
+		t.Errorf("Expected the fake clock round to complete in milliseconds, took %s", elapsed)
+	}
+}
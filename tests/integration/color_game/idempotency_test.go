@@ -0,0 +1,118 @@
+package colorgame_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	pbCommon "github.com/frankieli/game_product/shared/proto/common"
+
+	gsLocal "github.com/frankieli/game_product/internal/modules/color_game/gs/adapter/local"
+	gsDomain "github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	gsRepo "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/memory"
+	gsUC "github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
+	gatewayUC "github.com/frankieli/game_product/internal/modules/gateway/usecase"
+	"github.com/frankieli/game_product/internal/modules/wallet"
+)
+
+func TestPlaceBetIdempotency(t *testing.T) {
+	ctx := context.Background()
+	gms := &bettingGMS{}
+	walletSvc := wallet.NewMockService()
+	requestRepo := gsRepo.NewBetRequestRepository()
+
+	playerUC := gsUC.NewGSUseCase(gsRepo.NewBetRepository(), &MockBetOrderRepository{}, gms, walletSvc, nil)
+	playerUC.SetBetRequestRepository(requestRepo)
+
+	balance := func(userID int64) int64 {
+		b, _ := walletSvc.GetBalance(ctx, userID)
+		return b
+	}
+
+	// 1. A resent request returns the original bet and deducts once
+	walletSvc.SetBalance(6201, 1000)
+	first, err := playerUC.PlaceBet(ctx, 6201, "", red, 100, "req-1")
+	if err != nil {
+		t.Fatalf("PlaceBet failed: %v", err)
+	}
+	replay, err := playerUC.PlaceBet(ctx, 6201, "", red, 100, "req-1")
+	if err != nil || replay.BetID != first.BetID || replay.Amount != 100 {
+		t.Fatalf("Expected the replay to return bet %s, got %+v %v", first.BetID, replay, err)
+	}
+	if got := balance(6201); got != 900 {
+		t.Fatalf("Expected one deduction, got balance %d", got)
+	}
+
+	// 2. Another request on the same color adds to the bet, every wallet transaction is derived from the bet ID
+	added, err := playerUC.PlaceBet(ctx, 6201, "", red, 50, "req-2")
+	if err != nil || added.BetID != first.BetID || added.Amount != 150 {
+		t.Fatalf("Expected the stake to be added to bet %s, got %+v %v", first.BetID, added, err)
+	}
+	wantTxIDs := []string{gsDomain.BetTxID(first.BetID, "req-1"), gsDomain.BetTxID(first.BetID, "req-2")}
+	if len(added.TxIDs) != 2 || added.TxIDs[0] != wantTxIDs[0] || added.TxIDs[1] != wantTxIDs[1] {
+		t.Fatalf("Expected transactions %v, got %v", wantTxIDs, added.TxIDs)
+	}
+
+	// 3. Requests without a key are never deduplicated and still get distinct transactions
+	a, errA := playerUC.PlaceBet(ctx, 6202, "", green, 10, "")
+	b, errB := playerUC.PlaceBet(ctx, 6202, "", green, 10, "")
+	if errA != nil || errB != nil || b.Amount != 20 || len(b.TxIDs) != 2 || b.TxIDs[0] == b.TxIDs[1] || a.BetID != b.BetID {
+		t.Fatalf("Expected two deductions on one bet, got %+v %v %v", b, errA, errB)
+	}
+
+	// 4. A replay while the first attempt is still running is rejected
+	if _, err := requestRepo.Acquire(ctx, 6203, "req-busy", time.Minute); err != nil {
+		t.Fatalf("Acquire failed: %v", err)
+	}
+	if _, err := playerUC.PlaceBet(ctx, 6203, "", red, 100, "req-busy"); !errors.Is(err, gsDomain.ErrRequestInProgress) {
+		t.Fatalf("Expected ErrRequestInProgress, got %v", err)
+	}
+
+	// 5. A failed request releases its key, the retry is placed
+	walletSvc.SetBalance(6204, 1000)
+	gms.failRecord = true
+	if _, err := playerUC.PlaceBet(ctx, 6204, "", blue, 100, "req-retry"); err == nil {
+		t.Fatal("Expected PlaceBet to fail when GMS is down")
+	}
+	gms.failRecord = false
+	if _, err := playerUC.PlaceBet(ctx, 6204, "", blue, 100, "req-retry"); err != nil {
+		t.Fatalf("Expected the retry to be placed, got %v", err)
+	}
+	if got := balance(6204); got != 900 {
+		t.Fatalf("Expected the failed attempt to be rolled back, got balance %d", got)
+	}
+
+	// 6. Keys that cannot be part of a wallet transaction are rejected
+	if _, err := playerUC.PlaceBet(ctx, 6205, "", red, 100, "bad,key"); !errors.Is(err, gsDomain.ErrInvalidRequestKey) {
+		t.Fatalf("Expected ErrInvalidRequestKey, got %v", err)
+	}
+
+	// 7. The WS envelope carries the key through the gateway
+	gateway := gatewayUC.NewGatewayUseCase(gsLocal.NewHandler(playerUC), nil)
+	send := func() (code float64, betID string) {
+		respJSON, err := gateway.HandleMessage(ctx, 6206, []byte(`{"game_code":"color_game","command":"ColorGamePlaceBetREQ","idempotency_key":"ws-1","data":{"color":"red","amount":100}}`))
+		if err != nil {
+			t.Fatalf("HandleMessage failed: %v", err)
+		}
+		var resp struct {
+			Data map[string]interface{} `json:"data"`
+		}
+		if err := json.Unmarshal(respJSON, &resp); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		if resp.Data["idempotency_key"] != "ws-1" {
+			t.Errorf("Expected the key to be echoed, got %v", resp.Data["idempotency_key"])
+		}
+		return resp.Data["error_code"].(float64), resp.Data["bet_id"].(string)
+	}
+	code1, bet1 := send()
+	code2, bet2 := send()
+	if code1 != float64(pbCommon.ErrorCode_SUCCESS) || code2 != float64(pbCommon.ErrorCode_SUCCESS) || bet1 == "" || bet1 != bet2 {
+		t.Fatalf("Expected the resent request to return bet %s, got %v %s / %v %s", bet1, code1, bet1, code2, bet2)
+	}
+	if got := balance(6206); got != 1000000-100 {
+		t.Fatalf("Expected one deduction through the gateway, got balance %d", got)
+	}
+}
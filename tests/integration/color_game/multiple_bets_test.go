@@ -49,7 +49,7 @@ func TestMultipleBetsPerUser(t *testing.T) {
 	// 2. Wait for betting state
 	var currentRoundID string
 	for i := 0; i < 20; i++ {
-		round, err := playerUC.GetCurrentRound(ctx, 1001)
+		round, err := playerUC.GetCurrentRound(ctx, 1001, "")
 		if err == nil && round["state"] == pbColorGame.ColorGameState_GAME_STATE_BETTING.String() {
 			currentRoundID = round["round_id"].(string)
 			break
@@ -66,19 +66,19 @@ func TestMultipleBetsPerUser(t *testing.T) {
 	walletSvc.SetBalance(userID, 1000)
 
 	// Bet 1: Red 100
-	_, err := playerUC.PlaceBet(ctx, userID, pbColorGame.ColorGameReward_REWARD_RED, 100)
+	_, err := playerUC.PlaceBet(ctx, userID, "", pbColorGame.ColorGameReward_REWARD_RED, 100, "")
 	if err != nil {
 		t.Fatalf("First bet failed: %v", err)
 	}
 
 	// Bet 2: Red 50 (Add to existing)
-	_, err = playerUC.PlaceBet(ctx, userID, pbColorGame.ColorGameReward_REWARD_RED, 50)
+	_, err = playerUC.PlaceBet(ctx, userID, "", pbColorGame.ColorGameReward_REWARD_RED, 50, "")
 	if err != nil {
 		t.Fatalf("Second bet failed: %v", err)
 	}
 
 	// Bet 3: Green 200 (New bet on different color)
-	_, err = playerUC.PlaceBet(ctx, userID, pbColorGame.ColorGameReward_REWARD_GREEN, 200)
+	_, err = playerUC.PlaceBet(ctx, userID, "", pbColorGame.ColorGameReward_REWARD_GREEN, 200, "")
 	if err != nil {
 		t.Fatalf("Third bet failed: %v", err)
 	}
@@ -94,7 +94,7 @@ func TestMultipleBetsPerUser(t *testing.T) {
 	}
 
 	// 5. Verify GetCurrentRound returns all player bets
-	round, err := playerUC.GetCurrentRound(ctx, userID)
+	round, err := playerUC.GetCurrentRound(ctx, userID, "")
 	if err != nil {
 		t.Fatalf("GetCurrentRound failed: %v", err)
 	}
@@ -121,7 +121,7 @@ func TestMultipleBetsPerUser(t *testing.T) {
 
 	// 7. Settle with Red as winning color
 	winningColor := pbColorGame.ColorGameReward_REWARD_RED
-	err = playerUC.SettleRound(ctx, currentRoundID, winningColor)
+	err = playerUC.SettleRound(ctx, "", currentRoundID, []pbColorGame.ColorGameReward{winningColor})
 	if err != nil {
 		t.Fatalf("SettleRound failed: %v", err)
 	}
@@ -0,0 +1,110 @@
+package colorgame_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"google.golang.org/protobuf/proto"
+
+	gmsDomain "github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	gmsMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+)
+
+func TestOperatorControls(t *testing.T) {
+	// 1. Setup GMS with a betting phase long enough to be adjusted
+	stateMachine := gmsMachine.NewStateMachine()
+	stateMachine.WaitDuration = 50 * time.Millisecond
+	stateMachine.BettingDuration = 300 * time.Millisecond
+	stateMachine.DrawingDuration = 50 * time.Millisecond
+	stateMachine.ResultDuration = 50 * time.Millisecond
+	stateMachine.RestDuration = 50 * time.Millisecond
+
+	gatewayBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	gsBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	gameRoundRepo := &MockGameRoundRepository{}
+	roundUC := gmsUC.NewGMSUseCase(stateMachine, gatewayBroadcaster, gsBroadcaster, gameRoundRepo)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go stateMachine.Start(ctx)
+
+	// 2. Extending betting announces the new betting end, shortening closes betting immediately
+	betting := waitForState(t, gatewayBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_BETTING, time.Second)
+	if _, err := roundUC.AdjustBetting(ctx, "", "unknown-round", time.Second, "tester"); !errors.Is(err, gmsMachine.ErrRoundMismatch) {
+		t.Errorf("Expected ErrRoundMismatch for another round, got %v", err)
+	}
+	if _, err := roundUC.AdjustBetting(ctx, "missing", betting.RoundId, time.Second, "tester"); !errors.Is(err, gmsDomain.ErrTableNotFound) {
+		t.Errorf("Expected ErrTableNotFound for unknown table, got %v", err)
+	}
+
+	extendedEnd, err := roundUC.AdjustBetting(ctx, "", betting.RoundId, 2*time.Second, "tester")
+	if err != nil {
+		t.Fatalf("AdjustBetting failed: %v", err)
+	}
+	extended := waitForState(t, gatewayBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_BETTING, time.Second)
+	if extended.RoundId != betting.RoundId || extended.BettingEndTimestamp != extendedEnd.Unix() {
+		t.Errorf("Expected BETTING re-announced with end %d, got round %s end %d", extendedEnd.Unix(), extended.RoundId, extended.BettingEndTimestamp)
+	}
+
+	if _, err := roundUC.AdjustBetting(ctx, "", betting.RoundId, -time.Minute, "tester"); err != nil {
+		t.Fatalf("AdjustBetting failed: %v", err)
+	}
+	waitForState(t, gatewayBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_DRAWING, 200*time.Millisecond)
+
+	// 3. Voiding a round in betting broadcasts VOIDED, marks the round and refunds through GS
+	betting = waitForState(t, gatewayBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_BETTING, time.Second)
+	if err := roundUC.VoidCurrentRound(ctx, "", betting.RoundId, "table malfunction", "tester"); err != nil {
+		t.Fatalf("VoidCurrentRound failed: %v", err)
+	}
+	voided := waitForState(t, gatewayBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_VOIDED, 200*time.Millisecond)
+	if voided.RoundId != betting.RoundId {
+		t.Errorf("Expected round %s voided, got %s", betting.RoundId, voided.RoundId)
+	}
+
+	refund := waitForVoidRound(t, gsBroadcaster.Messages, time.Second)
+	if refund.RoundId != betting.RoundId || refund.Reason != "table malfunction" {
+		t.Errorf("Expected GS refund of %s (table malfunction), got %s (%s)", betting.RoundId, refund.RoundId, refund.Reason)
+	}
+	if round, _ := gameRoundRepo.GetByRoundID(ctx, betting.RoundId); round == nil || round.Status != gmsDomain.RoundStatusVoided {
+		t.Errorf("Expected round %s marked voided, got %+v", betting.RoundId, round)
+	}
+
+	// 4. Pausing lets the current round finish and holds the table until resumed
+	if err := roundUC.PauseTable(ctx, "", "tester"); err != nil {
+		t.Fatalf("PauseTable failed: %v", err)
+	}
+	waitForState(t, gatewayBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_PAUSED, 2*time.Second)
+
+	select {
+	case msg := <-gatewayBroadcaster.Messages:
+		t.Fatalf("Expected no broadcast while paused, got %v", msg)
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	if err := roundUC.ResumeTable(ctx, "", "tester"); err != nil {
+		t.Fatalf("ResumeTable failed: %v", err)
+	}
+	waitForState(t, gatewayBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_ROUND_STARTED, 200*time.Millisecond)
+
+	stateMachine.Stop()
+}
+
+// waitForVoidRound returns the next void request sent to GS
+func waitForVoidRound(t *testing.T, messages chan proto.Message, timeout time.Duration) *pbColorGame.ColorGameVoidRoundReq {
+	deadline := time.After(timeout)
+	for {
+		select {
+		case msg := <-messages:
+			if req, ok := msg.(*pbColorGame.ColorGameVoidRoundReq); ok {
+				return req
+			}
+		case <-deadline:
+			t.Fatal("Timeout waiting for VoidRoundReq")
+			return nil
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package colorgame_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"google.golang.org/protobuf/proto"
+
+	gsDomain "github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	gsRepo "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/memory"
+	gsUC "github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
+	"github.com/frankieli/game_product/internal/modules/wallet"
+	"github.com/frankieli/game_product/pkg/clock"
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
+)
+
+func TestSettlementPayoutRetry(t *testing.T) {
+	ctx := context.Background()
+	fakeClock := clock.NewFake(time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC))
+	walletSvc := &flakyWallet{MockService: wallet.NewMockService()}
+	broadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	betOrderRepo := &MockBetOrderRepository{}
+	payoutRepo := gsRepo.NewPayoutRepository(betOrderRepo)
+
+	playerUC := gsUC.NewGSUseCase(gsRepo.NewBetRepository(), betOrderRepo, &bettingGMS{}, walletSvc, broadcaster)
+	playerUC.SetClock(fakeClock)
+	playerUC.SetPayoutRepository(payoutRepo)
+
+	// settlementsOf drains the broadcaster and returns the personal settlements per player
+	settlementsOf := func() map[int64]*pbColorGame.ColorGameSettlementBRC {
+		settlements := make(map[int64]*pbColorGame.ColorGameSettlementBRC)
+		for {
+			select {
+			case msg := <-broadcaster.Messages:
+				if brc, ok := msg.(*pbColorGame.ColorGameSettlementBRC); ok && brc.BetId != "" {
+					switch brc.BetColor {
+					case red:
+						settlements[6101] = brc
+					case green:
+						settlements[6102] = brc
+					}
+				}
+			default:
+				return settlements
+			}
+		}
+	}
+	balance := func(userID int64) int64 {
+		b, _ := walletSvc.GetBalance(ctx, userID)
+		return b
+	}
+
+	// 1. A winner and a loser, the wallet is down for the first two deposits
+	walletSvc.SetBalance(6101, 1000)
+	walletSvc.SetBalance(6102, 1000)
+	if _, err := playerUC.PlaceBet(ctx, 6101, "", red, 100, ""); err != nil {
+		t.Fatalf("PlaceBet failed: %v", err)
+	}
+	if _, err := playerUC.PlaceBet(ctx, 6102, "", green, 100, ""); err != nil {
+		t.Fatalf("PlaceBet failed: %v", err)
+	}
+	winAmount := colorgame.DefaultPaytable().WinAmount(red, 100, []pbColorGame.ColorGameReward{red})
+
+	walletSvc.settleWinFailures = 2
+	if err := playerUC.SettleRound(ctx, colorgame.DefaultTableID, "r-1", []pbColorGame.ColorGameReward{red}); err != nil {
+		t.Fatalf("SettleRound failed: %v", err)
+	}
+
+	// 2. The settlement is persisted, the loser is notified but the winner is not paid yet
+	if len(betOrderRepo.Orders()) != 2 {
+		t.Errorf("Expected 2 bet orders, got %d", len(betOrderRepo.Orders()))
+	}
+	settlements := settlementsOf()
+	if _, ok := settlements[6101]; ok {
+		t.Error("Expected the winner not to be notified before the payout landed")
+	}
+	if brc, ok := settlements[6102]; !ok || brc.IsWinner {
+		t.Errorf("Expected the loser to be notified, got %+v", brc)
+	}
+	if got := balance(6101); got != 900 {
+		t.Fatalf("Expected the winner to be unpaid, got balance %d", got)
+	}
+	due, _ := payoutRepo.ListDue(ctx, fakeClock.Now().Add(time.Hour), 10)
+	if len(due) != 1 || due[0].Amount != winAmount || due[0].Attempts != 1 || due[0].TxID != gsDomain.PayoutTxID(due[0].BetID) {
+		t.Fatalf("Expected one pending payout after the first attempt, got %+v", due)
+	}
+
+	// 3. The worker retries with backoff until the deposit lands, then notifies the winner
+	if n, _ := playerUC.RunPayouts(ctx); n != 0 {
+		t.Error("Expected no retry before the backoff elapsed")
+	}
+	fakeClock.Advance(time.Second)
+	if n, _ := playerUC.RunPayouts(ctx); n != 0 {
+		t.Error("Expected the second attempt to fail")
+	}
+	fakeClock.Advance(2 * time.Second)
+	if n, _ := playerUC.RunPayouts(ctx); n != 1 {
+		t.Errorf("Expected the third attempt to pay, got %d", n)
+	}
+	if got := balance(6101); got != 900+winAmount {
+		t.Errorf("Expected balance %d after the payout, got %d", 900+winAmount, got)
+	}
+	settlements = settlementsOf()
+	if brc, ok := settlements[6101]; !ok || !brc.IsWinner || brc.WinAmount != winAmount || len(brc.Dice) != 1 || brc.WinningColor != red {
+		t.Errorf("Expected the winner to be notified once paid, got %+v", brc)
+	}
+
+	// 4. A paid payout is never paid again
+	fakeClock.Advance(time.Hour)
+	if n, _ := playerUC.RunPayouts(ctx); n != 0 || balance(6101) != 900+winAmount {
+		t.Error("Expected a paid payout not to be retried")
+	}
+	if _, err := walletSvc.SettleWin(ctx, 6101, winAmount, "r-1", due[0].TxID); err != nil || balance(6101) != 900+winAmount {
+		t.Error("Expected a repeated deposit of the same payout to be ignored by the wallet")
+	}
+}
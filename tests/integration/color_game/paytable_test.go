@@ -0,0 +1,181 @@
+package colorgame_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"google.golang.org/protobuf/proto"
+
+	gmsLocal "github.com/frankieli/game_product/internal/modules/color_game/gms/adapter/local"
+	gmsDomain "github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	gmsMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+	gsRepo "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/memory"
+	gsUC "github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
+	"github.com/frankieli/game_product/internal/modules/wallet"
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
+)
+
+func TestPaytableValidation(t *testing.T) {
+	invalid := map[string]string{
+		"single color":      "RED:1:2",
+		"unknown color":     "RED:1:2,PURPLE:1:2",
+		"duplicate color":   "RED:1:2,red:1:2",
+		"zero weight":       "RED:0:2,GREEN:1:2",
+		"payout below 1x":   "RED:1:0.5,GREEN:1:2",
+		"three decimals":    "RED:1:2.125,GREEN:1:2",
+		"missing payout":    "RED:1,GREEN:1:2",
+		"unspecified color": "UNSPECIFIED:1:2,GREEN:1:2",
+	}
+	for name, spec := range invalid {
+		if _, err := colorgame.ParsePaytable(spec); err == nil {
+			t.Errorf("%s: expected %q to be rejected", name, spec)
+		}
+	}
+
+	paytable, err := colorgame.ParsePaytable(" red:3:2.9, REWARD_GREEN:3:2.9,blue:3:2.9,white:1:9.5 ")
+	if err != nil {
+		t.Fatalf("ParsePaytable failed: %v", err)
+	}
+	if paytable.String() != "RED:3:2.9,GREEN:3:2.9,BLUE:3:2.9,WHITE:1:9.5" {
+		t.Errorf("Unexpected normalized paytable %s", paytable.String())
+	}
+	if paytable.Contains(pbColorGame.ColorGameReward_REWARD_YELLOW) || !paytable.Contains(pbColorGame.ColorGameReward_REWARD_WHITE) {
+		t.Error("Expected WHITE to replace YELLOW")
+	}
+	if win := paytable.WinAmount(pbColorGame.ColorGameReward_REWARD_RED, 15, []pbColorGame.ColorGameReward{pbColorGame.ColorGameReward_REWARD_RED}); win != 43 {
+		t.Errorf("Expected 15 × 2.9 rounded down to 43, got %d", win)
+	}
+}
+
+func TestPaytableRTP(t *testing.T) {
+	near := func(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+
+	report := colorgame.DefaultPaytable().RTP()
+	if !near(report.RTP, 0.5) || !near(report.HouseEdge, 0.5) {
+		t.Errorf("Expected the default table to return 50%%, got %v", report.RTP)
+	}
+	// Win 2 with p = 1/4: sqrt(1/4 × 4 - 1/4)
+	if !near(report.Colors[0].Volatility, math.Sqrt(0.75)) {
+		t.Errorf("Unexpected volatility %v", report.Colors[0].Volatility)
+	}
+
+	paytable, _ := colorgame.ParsePaytable("RED:3:2.9,GREEN:3:2.9,BLUE:3:2.9,YELLOW:1:9.5")
+	report = paytable.RTP()
+	if !near(report.Colors[0].RTP, 0.87) || !near(report.Colors[3].RTP, 0.95) {
+		t.Errorf("Unexpected per color RTP %+v", report.Colors)
+	}
+	if !near(report.RTP, (0.87*3+0.95)/4) || !near(report.MinRTP, 0.87) || !near(report.MaxRTP, 0.95) {
+		t.Errorf("Unexpected RTP report %+v", report)
+	}
+}
+
+func TestWeightedDraws(t *testing.T) {
+	paytable, _ := colorgame.ParsePaytable("RED:6:1.5,GREEN:3:3,PINK:1:9")
+
+	// 1. The RNG draws follow the weights
+	const draws = 20000
+	provider := gmsMachine.NewSeededProvider(42)
+	provider.Paytable = paytable
+	counts := make(map[pbColorGame.ColorGameReward]int)
+	for i := 0; i < draws; i++ {
+		dice, err := provider.Draw(context.Background(), &gmsDomain.Round{})
+		if err != nil || len(dice) != 1 {
+			t.Fatalf("Draw failed: %v %v", dice, err)
+		}
+		counts[dice[0]]++
+	}
+	for _, entry := range paytable.Entries {
+		expected := float64(entry.Weight) / float64(paytable.TotalWeight())
+		if got := float64(counts[entry.Color]) / draws; math.Abs(got-expected) > 0.02 {
+			t.Errorf("%s drawn %.3f of the time, expected %.3f", entry.Color, got, expected)
+		}
+	}
+	if len(counts) != len(paytable.Entries) {
+		t.Errorf("Drew colors outside of the paytable: %v", counts)
+	}
+
+	// 2. The default table keeps the published provably fair formula
+	for _, roundID := range []string{"color_game-default-20251205120000000-000001", "r-2", "r-3", "r-4", "r-5"} {
+		mac := hmac.New(sha256.New, []byte("server-seed"))
+		mac.Write([]byte("client-seed:" + roundID))
+		legacy := []pbColorGame.ColorGameReward{
+			pbColorGame.ColorGameReward_REWARD_RED,
+			pbColorGame.ColorGameReward_REWARD_GREEN,
+			pbColorGame.ColorGameReward_REWARD_BLUE,
+			pbColorGame.ColorGameReward_REWARD_YELLOW,
+		}[binary.BigEndian.Uint32(mac.Sum(nil)[:4])%4]
+
+		if got := gmsDomain.FairResult("server-seed", "client-seed", roundID, colorgame.DefaultPaytable()); len(got) != 1 || got[0] != legacy {
+			t.Errorf("Round %s: expected %s, got %s", roundID, legacy, got)
+		}
+	}
+
+	// 3. A live dealer can only submit colors of the table
+	manual := gmsMachine.NewManualProvider(time.Second)
+	manual.Paytable = paytable
+	if err := manual.Submit("r-1", []gmsDomain.Color{pbColorGame.ColorGameReward_REWARD_YELLOW}); err == nil || err == gmsMachine.ErrNoPendingDraw {
+		t.Errorf("Expected YELLOW to be rejected, got %v", err)
+	}
+}
+
+func TestSettlementUsesPaytable(t *testing.T) {
+	paytable, _ := colorgame.ParsePaytable("RED:3:1.95,GREEN:3:1.95,BLUE:3:1.95,WHITE:1:5.55")
+
+	stateMachine := gmsMachine.NewStateMachine()
+	stateMachine.WaitDuration = 50 * time.Millisecond
+	stateMachine.BettingDuration = 2 * time.Second
+	provider := gmsMachine.NewRNGProvider()
+	provider.Paytable = paytable
+	stateMachine.SetResultProvider(provider)
+
+	broadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	roundUC := gmsUC.NewGMSUseCase(stateMachine, broadcaster, nil, &MockGameRoundRepository{})
+	roundUC.SetPaytable(paytable)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go stateMachine.Start(ctx)
+
+	walletSvc := wallet.NewMockService()
+	playerUC := gsUC.NewGSUseCase(gsRepo.NewBetRepository(), &MockBetOrderRepository{}, gmsLocal.NewHandler(roundUC), walletSvc, broadcaster)
+	playerUC.SetPaytable(paytable)
+
+	betting := waitForState(t, broadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_BETTING, time.Second)
+
+	// 1. Colors outside of the table are rejected, extra colors are accepted
+	walletSvc.SetBalance(9001, 1000)
+	walletSvc.SetBalance(9002, 1000)
+	if _, err := playerUC.PlaceBet(ctx, 9001, "", pbColorGame.ColorGameReward_REWARD_YELLOW, 100, ""); err == nil {
+		t.Error("Expected a bet on YELLOW to be rejected")
+	}
+	if _, err := playerUC.PlaceBet(ctx, 9001, "", pbColorGame.ColorGameReward_REWARD_WHITE, 100, ""); err != nil {
+		t.Fatalf("PlaceBet on WHITE failed: %v", err)
+	}
+	if _, err := playerUC.PlaceBet(ctx, 9002, "", pbColorGame.ColorGameReward_REWARD_RED, 100, ""); err != nil {
+		t.Fatalf("PlaceBet on RED failed: %v", err)
+	}
+
+	// 2. Winners are paid with the multiplier of their color
+	if err := playerUC.SettleRound(ctx, "", betting.RoundId, []pbColorGame.ColorGameReward{pbColorGame.ColorGameReward_REWARD_WHITE}); err != nil {
+		t.Fatalf("SettleRound failed: %v", err)
+	}
+	expected := map[int64]int64{
+		9001: 1000 - 100 + 555,
+		9002: 1000 - 100,
+	}
+	for userID, balance := range expected {
+		if got, _ := walletSvc.GetBalance(ctx, userID); got != balance {
+			t.Errorf("User %d: expected balance %d, got %d", userID, balance, got)
+		}
+	}
+
+	cancel()
+	stateMachine.WaitForDone()
+}
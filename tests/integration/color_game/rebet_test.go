@@ -0,0 +1,210 @@
+package colorgame_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	pbCommon "github.com/frankieli/game_product/shared/proto/common"
+
+	gsLocal "github.com/frankieli/game_product/internal/modules/color_game/gs/adapter/local"
+	gsDomain "github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	gsRepo "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/memory"
+	gsUC "github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
+	gatewayUC "github.com/frankieli/game_product/internal/modules/gateway/usecase"
+	"github.com/frankieli/game_product/internal/modules/wallet"
+	"github.com/frankieli/game_product/pkg/clock"
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
+)
+
+// roundsGMS is a GMS whose default table is in the round and state the test sets
+type roundsGMS struct {
+	colorgame.GMSService
+	mu      sync.Mutex
+	roundID string
+	state   pbColorGame.ColorGameState
+}
+
+func (g *roundsGMS) set(roundID string, state pbColorGame.ColorGameState) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.roundID, g.state = roundID, state
+}
+
+func (g *roundsGMS) GetCurrentRound(ctx context.Context, req *pbColorGame.ColorGameGetCurrentRoundReq) (*pbColorGame.ColorGameGetCurrentRoundRsp, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return &pbColorGame.ColorGameGetCurrentRoundRsp{
+		ErrorCode: pbCommon.ErrorCode_SUCCESS,
+		TableId:   colorgame.DefaultTableID,
+		RoundId:   g.roundID,
+		State:     g.state,
+	}, nil
+}
+
+func (g *roundsGMS) RecordBet(ctx context.Context, req *pbColorGame.ColorGameRecordBetReq) (*pbColorGame.ColorGameRecordBetRsp, error) {
+	return &pbColorGame.ColorGameRecordBetRsp{ErrorCode: pbCommon.ErrorCode_SUCCESS}, nil
+}
+
+func TestRebetAndAutoBet(t *testing.T) {
+	ctx := context.Background()
+	gms := &roundsGMS{roundID: "r-1", state: pbColorGame.ColorGameState_GAME_STATE_BETTING}
+	walletSvc := wallet.NewMockService()
+	betRepo := gsRepo.NewBetRepository()
+	playerUC := gsUC.NewGSUseCase(betRepo, &MockBetOrderRepository{}, gms, walletSvc, nil)
+	playerUC.SetClock(clock.NewFake(time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC)))
+	playerUC.SetBetLimits(gsDomain.BetLimits{MinBet: 10, MaxColorBet: 1000})
+
+	balance := func(userID int64) int64 {
+		b, _ := walletSvc.GetBalance(ctx, userID)
+		return b
+	}
+	settle := func(roundID string, dice ...gsDomain.Color) {
+		t.Helper()
+		gms.set(roundID, pbColorGame.ColorGameState_GAME_STATE_DRAWING)
+		if err := playerUC.SettleRound(ctx, "", roundID, dice); err != nil {
+			t.Fatalf("SettleRound %s failed: %v", roundID, err)
+		}
+	}
+	for _, userID := range []int64{9001, 9002, 9003} {
+		walletSvc.SetBalance(userID, 1000)
+	}
+
+	// 1. Nothing to repeat before a round was settled
+	if _, err := playerUC.Rebet(ctx, 9001, "", 1, ""); !errors.Is(err, gsDomain.ErrNoBetsToRepeat) {
+		t.Fatalf("Expected ErrNoBetsToRepeat without a settled round, got %v", err)
+	}
+	if _, err := playerUC.StartAutoBet(ctx, 9001, "", 1, 3, 0, 0); !errors.Is(err, gsDomain.ErrNoBetsToRepeat) {
+		t.Fatalf("Expected ErrNoBetsToRepeat starting an auto bet without a settled round, got %v", err)
+	}
+
+	// 2. The settled bets of a round become the slip Rebet repeats
+	if _, err := playerUC.PlaceBet(ctx, 9001, "", red, 100, ""); err != nil {
+		t.Fatalf("PlaceBet failed: %v", err)
+	}
+	if _, err := playerUC.PlaceBet(ctx, 9001, "", green, 50, ""); err != nil {
+		t.Fatalf("PlaceBet failed: %v", err)
+	}
+	settle("r-1", blue)
+	gms.set("r-2", pbColorGame.ColorGameState_GAME_STATE_BETTING)
+
+	bets, err := playerUC.Rebet(ctx, 9001, "", 1, "rb-1")
+	if err != nil || len(bets) != 2 || bets[0].Color != red || bets[0].Amount != 100 || bets[1].Color != green || bets[1].Amount != 50 {
+		t.Fatalf("Expected red 100 and green 50 repeated, got %+v %v", bets, err)
+	}
+	if got := balance(9001); got != 700 {
+		t.Fatalf("Expected balance 700 after the rebet, got %d", got)
+	}
+	replay, err := playerUC.Rebet(ctx, 9001, "", 1, "rb-1")
+	if err != nil || len(replay) != 2 || replay[0].BetID != bets[0].BetID || balance(9001) != 700 {
+		t.Fatalf("Expected the replay to return the placed bets, got %+v %v balance %d", replay, err, balance(9001))
+	}
+
+	// 3. Doubling adds twice the last slip, subject to the same limits
+	doubled, err := playerUC.Rebet(ctx, 9001, "", 2, "")
+	if err != nil || doubled[0].Amount != 300 || doubled[1].Amount != 150 || balance(9001) != 400 {
+		t.Fatalf("Expected red 300 and green 150 after doubling, got %+v %v balance %d", doubled, err, balance(9001))
+	}
+	if _, err := playerUC.Rebet(ctx, 9001, "", 10, ""); !errors.Is(err, gsDomain.ErrBetLimitExceeded) || balance(9001) != 400 {
+		t.Fatalf("Expected ErrBetLimitExceeded raising red above 1000, got %v balance %d", err, balance(9001))
+	}
+
+	// 4. An auto bet places the last slip once per betting round and ends after its rounds
+	settle("r-2", blue)
+	if _, err := playerUC.StartAutoBet(ctx, 9001, "", 1, 0, 0, 0); !errors.Is(err, gsDomain.ErrInvalidAutoBet) {
+		t.Fatalf("Expected ErrInvalidAutoBet for no rounds, got %v", err)
+	}
+	walletSvc.SetBalance(9001, 1000)
+	autoBet, err := playerUC.StartAutoBet(ctx, 9001, "", 1, 2, 0, 0)
+	if err != nil || len(autoBet.Lines) != 2 || autoBet.Lines[0].Amount != 300 || autoBet.Lines[1].Amount != 150 {
+		t.Fatalf("Expected an auto bet of red 300 and green 150, got %+v %v", autoBet, err)
+	}
+	if placed, _ := playerUC.RunAutoBets(ctx); placed != 0 {
+		t.Fatalf("Expected no slip while the table is drawing, got %d", placed)
+	}
+
+	gms.set("r-3", pbColorGame.ColorGameState_GAME_STATE_BETTING)
+	if placed, err := playerUC.RunAutoBets(ctx); placed != 1 || err != nil || balance(9001) != 550 {
+		t.Fatalf("Expected one slip of 450 in r-3, got %d %v balance %d", placed, err, balance(9001))
+	}
+	if placed, _ := playerUC.RunAutoBets(ctx); placed != 0 || balance(9001) != 550 {
+		t.Fatalf("Expected r-3 to be bet once, got %d more balance %d", placed, balance(9001))
+	}
+	if current, _ := playerUC.GetAutoBet(ctx, 9001); current == nil || current.RoundsLeft != 1 {
+		t.Fatalf("Expected 1 round left, got %+v", current)
+	}
+
+	settle("r-3", blue)
+	gms.set("r-4", pbColorGame.ColorGameState_GAME_STATE_BETTING)
+	if placed, _ := playerUC.RunAutoBets(ctx); placed != 1 || balance(9001) != 100 {
+		t.Fatalf("Expected the last slip in r-4, got %d balance %d", placed, balance(9001))
+	}
+	if current, _ := playerUC.GetAutoBet(ctx, 9001); current != nil {
+		t.Fatalf("Expected the auto bet to end after its rounds, got %+v", current)
+	}
+
+	// 5. An auto bet stops once the balance dropped by its loss threshold
+	if _, err := playerUC.PlaceBet(ctx, 9002, "", red, 100, ""); err != nil {
+		t.Fatalf("PlaceBet failed: %v", err)
+	}
+	settle("r-4", blue)
+	if _, err := playerUC.StartAutoBet(ctx, 9002, "", 1, 10, 100, 0); err != nil {
+		t.Fatalf("StartAutoBet failed: %v", err)
+	}
+	gms.set("r-5", pbColorGame.ColorGameState_GAME_STATE_BETTING)
+	if placed, _ := playerUC.RunAutoBets(ctx); placed != 1 || balance(9002) != 800 {
+		t.Fatalf("Expected one slip of 100 in r-5, got %d balance %d", placed, balance(9002))
+	}
+	settle("r-5", blue)
+	gms.set("r-6", pbColorGame.ColorGameState_GAME_STATE_BETTING)
+	if placed, _ := playerUC.RunAutoBets(ctx); placed != 0 || balance(9002) != 800 {
+		t.Fatalf("Expected the loss threshold to stop the auto bet, got %d balance %d", placed, balance(9002))
+	}
+	if current, _ := playerUC.GetAutoBet(ctx, 9002); current != nil {
+		t.Fatalf("Expected the auto bet to be removed, got %+v", current)
+	}
+
+	// 6. Players repeat, double and auto bet through the gateway
+	gateway := gatewayUC.NewGatewayUseCase(gsLocal.NewHandler(playerUC), nil)
+	send := func(message string) (string, map[string]interface{}) {
+		t.Helper()
+		respJSON, err := gateway.HandleMessage(ctx, 9002, []byte(message))
+		if err != nil {
+			t.Fatalf("HandleMessage failed: %v", err)
+		}
+		var resp struct {
+			Command string                 `json:"command"`
+			Data    map[string]interface{} `json:"data"`
+		}
+		if err := json.Unmarshal(respJSON, &resp); err != nil {
+			t.Fatalf("Failed to unmarshal response: %v", err)
+		}
+		return resp.Command, resp.Data
+	}
+	command, data := send(`{"game_code":"color_game","command":"ColorGameDoubleBetREQ","data":{}}`)
+	if command != "ColorGameDoubleBetRSP" || data["error_code"] != float64(pbCommon.ErrorCode_SUCCESS) || balance(9002) != 600 {
+		t.Fatalf("Expected red 200 doubled through the gateway, got %s %v balance %d", command, data, balance(9002))
+	}
+	if bets := data["bets"].([]interface{}); len(bets) != 1 || bets[0].(map[string]interface{})["color"] != "red" {
+		t.Fatalf("Expected the red bet in the response, got %v", data["bets"])
+	}
+	command, data = send(`{"game_code":"color_game","command":"ColorGameStartAutoBetREQ","data":{"rounds":5,"stop_win":500}}`)
+	if command != "ColorGameStartAutoBetRSP" || data["error_code"] != float64(pbCommon.ErrorCode_SUCCESS) || data["rounds_left"] != float64(5) {
+		t.Fatalf("Expected an auto bet of 5 rounds, got %s %v", command, data)
+	}
+	command, data = send(`{"game_code":"color_game","command":"ColorGameStopAutoBetREQ","data":{}}`)
+	if command != "ColorGameStopAutoBetRSP" || data["error_code"] != float64(pbCommon.ErrorCode_SUCCESS) {
+		t.Fatalf("Expected the auto bet to stop, got %s %v", command, data)
+	}
+	if current, _ := playerUC.GetAutoBet(ctx, 9002); current != nil {
+		t.Fatalf("Expected no auto bet after stopping it, got %+v", current)
+	}
+	_, data = send(`{"game_code":"color_game","command":"ColorGameRebetREQ","data":{}}`)
+	if data["error_code"] != float64(pbCommon.ErrorCode_SUCCESS) || balance(9002) != 500 {
+		t.Fatalf("Expected red 100 repeated through the gateway, got %v balance %d", data, balance(9002))
+	}
+}
@@ -0,0 +1,111 @@
+package colorgame_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	pbCommon "github.com/frankieli/game_product/shared/proto/common"
+
+	gsDomain "github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	gsRepo "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/memory"
+	gsUC "github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
+	"github.com/frankieli/game_product/internal/modules/wallet"
+	"github.com/frankieli/game_product/pkg/clock"
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
+)
+
+// sequenceGMS is a GMS whose table is always betting on the round with sequence seq
+type sequenceGMS struct {
+	bettingGMS
+	mu  sync.Mutex
+	day time.Time
+	seq int64
+}
+
+func (g *sequenceGMS) roundID() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return colorgame.NewRoundID("color_game", colorgame.DefaultTableID, g.day, g.seq)
+}
+
+func (g *sequenceGMS) advance(rounds int64) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.seq += rounds
+}
+
+func (g *sequenceGMS) GetCurrentRound(ctx context.Context, req *pbColorGame.ColorGameGetCurrentRoundReq) (*pbColorGame.ColorGameGetCurrentRoundRsp, error) {
+	return &pbColorGame.ColorGameGetCurrentRoundRsp{
+		ErrorCode: pbCommon.ErrorCode_SUCCESS,
+		TableId:   colorgame.DefaultTableID,
+		RoundId:   g.roundID(),
+		State:     pbColorGame.ColorGameState_GAME_STATE_BETTING,
+	}, nil
+}
+
+func TestPendingBetOrderReconciliation(t *testing.T) {
+	ctx := context.Background()
+	fakeClock := clock.NewFake(time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC))
+	gms := &sequenceGMS{day: fakeClock.Now(), seq: 1}
+	betOrderRepo := &MockBetOrderRepository{}
+
+	playerUC := gsUC.NewGSUseCase(gsRepo.NewBetRepository(), betOrderRepo, gms, wallet.NewMockService(), nil)
+	playerUC.SetClock(fakeClock)
+	playerUC.SetPayoutRepository(gsRepo.NewPayoutRepository(betOrderRepo))
+
+	// 1. A placed bet is recorded as a pending order with its wallet transaction
+	settledRound := gms.roundID()
+	bet, err := playerUC.PlaceBet(ctx, 8001, "", red, 100, "")
+	if err != nil {
+		t.Fatalf("PlaceBet failed: %v", err)
+	}
+	order := betOrderRepo.Order(bet.BetID)
+	if order == nil || order.Status != gsDomain.BetOrderStatusPending || order.Amount != 100 || order.TxIDs == "" {
+		t.Fatalf("Expected a pending order at placement, got %+v", order)
+	}
+
+	// 2. Adding to the bet updates the same order
+	if _, err := playerUC.PlaceBet(ctx, 8001, "", red, 50, ""); err != nil {
+		t.Fatalf("PlaceBet failed: %v", err)
+	}
+	order = betOrderRepo.Order(bet.BetID)
+	if len(betOrderRepo.Orders()) != 1 || order.Amount != 150 || strings.Count(order.TxIDs, ",") != 1 {
+		t.Fatalf("Expected one pending order of 150 with both transactions, got %d orders %+v", len(betOrderRepo.Orders()), order)
+	}
+
+	// 3. Settlement turns the pending order into a settled one
+	if err := playerUC.SettleRound(ctx, colorgame.DefaultTableID, settledRound, []pbColorGame.ColorGameReward{red}); err != nil {
+		t.Fatalf("SettleRound failed: %v", err)
+	}
+	if order = betOrderRepo.Order(bet.BetID); order.Status != gsDomain.BetOrderStatusSettled || order.Amount != 150 {
+		t.Fatalf("Expected the order to be settled, got %+v", order)
+	}
+
+	// 4. A bet whose round is never settled stays pending
+	gms.advance(1)
+	lost, err := playerUC.PlaceBet(ctx, 8002, "", green, 200, "")
+	if err != nil {
+		t.Fatalf("PlaceBet failed: %v", err)
+	}
+
+	gms.advance(2)
+	if flagged, err := playerUC.ReconcileBetOrders(ctx, 3); err != nil || flagged != 0 {
+		t.Fatalf("Expected nothing flagged two rounds later, got %d %v", flagged, err)
+	}
+
+	// 5. Three rounds later it is flagged for operators, once
+	gms.advance(1)
+	if flagged, err := playerUC.ReconcileBetOrders(ctx, 3); err != nil || flagged != 1 {
+		t.Fatalf("Expected the stale order to be flagged, got %d %v", flagged, err)
+	}
+	if order = betOrderRepo.Order(lost.BetID); order.Status != gsDomain.BetOrderStatusPending || order.FlaggedAt == nil {
+		t.Fatalf("Expected the stale order to stay pending and be flagged, got %+v", order)
+	}
+	if flagged, err := playerUC.ReconcileBetOrders(ctx, 3); err != nil || flagged != 0 {
+		t.Fatalf("Expected a flagged order not to be reported again, got %d %v", flagged, err)
+	}
+}
@@ -0,0 +1,109 @@
+package colorgame_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"google.golang.org/protobuf/proto"
+
+	gmsDomain "github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	gmsMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	gmsMemory "github.com/frankieli/game_product/internal/modules/color_game/gms/repository/memory"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+)
+
+func newRecoveryStateMachine(snapshotRepo gmsDomain.RoundSnapshotRepository) *gmsMachine.StateMachine {
+	stateMachine := gmsMachine.NewStateMachine()
+	stateMachine.WaitDuration = 50 * time.Millisecond
+	stateMachine.BettingDuration = 200 * time.Millisecond
+	stateMachine.DrawingDuration = 100 * time.Millisecond
+	stateMachine.ResultDuration = 100 * time.Millisecond
+	stateMachine.RestDuration = 50 * time.Millisecond
+	stateMachine.SetSnapshotRepository(snapshotRepo)
+	return stateMachine
+}
+
+func TestGMSRecoveryKeepsDrawnResult(t *testing.T) {
+	// 1. A previous GMS crashed while drawing
+	snapshotRepo := gmsMemory.NewRoundSnapshotRepository()
+	_ = snapshotRepo.Save(context.Background(), &gmsDomain.RoundSnapshot{
+		RoundID:      "20240101000000",
+		State:        pbColorGame.ColorGameState_GAME_STATE_DRAWING,
+		Result:       pbColorGame.ColorGameReward_REWARD_BLUE,
+		StartTime:    time.Now().Add(-time.Second),
+		BettingEnd:   time.Now().Add(-100 * time.Millisecond),
+		PhaseEndTime: time.Now().Add(50 * time.Millisecond),
+		RoundCounter: 7,
+		UpdatedAt:    time.Now(),
+	})
+
+	stateMachine := newRecoveryStateMachine(snapshotRepo)
+	gsBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	gmsUC.NewGMSUseCase(stateMachine, nil, gsBroadcaster, &MockGameRoundRepository{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	// 2. Restart the state machine
+	go stateMachine.Start(ctx)
+
+	// 3. The first result sent to GS must be the persisted one
+	select {
+	case msg := <-gsBroadcaster.Messages:
+		req, ok := msg.(*pbColorGame.ColorGameRoundResultReq)
+		if !ok {
+			t.Fatalf("Expected RoundResultReq, got %T", msg)
+		}
+		if req.RoundId != "20240101000000" {
+			t.Errorf("Expected recovered round 20240101000000, got %s", req.RoundId)
+		}
+		if req.Result != pbColorGame.ColorGameReward_REWARD_BLUE {
+			t.Errorf("Expected persisted result BLUE, got %s", req.Result)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for recovered round result")
+	}
+
+	stateMachine.Stop()
+}
+
+func TestGMSRecoveryVoidsExpiredRound(t *testing.T) {
+	// 1. A previous GMS crashed during betting a long time ago
+	snapshotRepo := gmsMemory.NewRoundSnapshotRepository()
+	_ = snapshotRepo.Save(context.Background(), &gmsDomain.RoundSnapshot{
+		RoundID:      "20240101000000",
+		State:        pbColorGame.ColorGameState_GAME_STATE_BETTING,
+		StartTime:    time.Now().Add(-time.Hour),
+		BettingEnd:   time.Now().Add(-time.Hour),
+		PhaseEndTime: time.Now().Add(-time.Hour),
+		RoundCounter: 7,
+		UpdatedAt:    time.Now().Add(-time.Hour),
+	})
+
+	stateMachine := newRecoveryStateMachine(snapshotRepo)
+	gsBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	gmsUC.NewGMSUseCase(stateMachine, nil, gsBroadcaster, &MockGameRoundRepository{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	go stateMachine.Start(ctx)
+
+	// 2. GS must be told to refund the round instead of settling it
+	select {
+	case msg := <-gsBroadcaster.Messages:
+		req, ok := msg.(*pbColorGame.ColorGameVoidRoundReq)
+		if !ok {
+			t.Fatalf("Expected VoidRoundReq, got %T", msg)
+		}
+		if req.RoundId != "20240101000000" {
+			t.Errorf("Expected voided round 20240101000000, got %s", req.RoundId)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for void notification")
+	}
+
+	stateMachine.Stop()
+}
@@ -0,0 +1,113 @@
+package colorgame_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"google.golang.org/protobuf/proto"
+
+	gmsMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+)
+
+func newManualStateMachine(timeout time.Duration) *gmsMachine.StateMachine {
+	stateMachine := gmsMachine.NewStateMachine()
+	stateMachine.WaitDuration = 50 * time.Millisecond
+	stateMachine.BettingDuration = 100 * time.Millisecond
+	stateMachine.DrawingDuration = 50 * time.Millisecond
+	stateMachine.ResultDuration = 100 * time.Millisecond
+	stateMachine.RestDuration = 50 * time.Millisecond
+	stateMachine.SetResultProvider(gmsMachine.NewManualProvider(timeout))
+	return stateMachine
+}
+
+// waitForState waits for the first ColorGameRoundStateBRC with the given state
+func waitForState(t *testing.T, messages chan proto.Message, state pbColorGame.ColorGameState, timeout time.Duration) *pbColorGame.ColorGameRoundStateBRC {
+	deadline := time.After(timeout)
+	for {
+		select {
+		case msg := <-messages:
+			if brc, ok := msg.(*pbColorGame.ColorGameRoundStateBRC); ok && brc.State == state {
+				return brc
+			}
+		case <-deadline:
+			t.Fatalf("Timeout waiting for %s", state)
+			return nil
+		}
+	}
+}
+
+func TestManualResultProvider(t *testing.T) {
+	// 1. Setup a live dealer table
+	stateMachine := newManualStateMachine(time.Second)
+	gatewayBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	gsBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	roundUC := gmsUC.NewGMSUseCase(stateMachine, gatewayBroadcaster, gsBroadcaster, &MockGameRoundRepository{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	go stateMachine.Start(ctx)
+
+	// 2. Results are rejected while the round is not drawing
+	betting := waitForState(t, gatewayBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_BETTING, time.Second)
+	if err := roundUC.SubmitResult(ctx, "", betting.RoundId, []pbColorGame.ColorGameReward{pbColorGame.ColorGameReward_REWARD_GREEN}, "tester"); err == nil {
+		t.Error("Expected SubmitResult to fail during betting")
+	}
+
+	// 3. The dealer submits the result during drawing
+	drawing := waitForState(t, gatewayBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_DRAWING, time.Second)
+	time.Sleep(100 * time.Millisecond) // Longer than DrawingDuration, the phase must wait for the dealer
+	if err := roundUC.SubmitResult(ctx, "", drawing.RoundId, []pbColorGame.ColorGameReward{pbColorGame.ColorGameReward_REWARD_GREEN}, "tester"); err != nil {
+		t.Fatalf("SubmitResult failed: %v", err)
+	}
+
+	// 4. GS settles with the submitted result
+	select {
+	case msg := <-gsBroadcaster.Messages:
+		req, ok := msg.(*pbColorGame.ColorGameRoundResultReq)
+		if !ok {
+			t.Fatalf("Expected RoundResultReq, got %T", msg)
+		}
+		if req.RoundId != drawing.RoundId || req.Result != pbColorGame.ColorGameReward_REWARD_GREEN {
+			t.Errorf("Expected GREEN for round %s, got %s for round %s", drawing.RoundId, req.Result, req.RoundId)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for round result")
+	}
+
+	stateMachine.Stop()
+}
+
+func TestManualResultProviderTimeoutVoidsRound(t *testing.T) {
+	// 1. Setup a live dealer table whose dealer never answers
+	stateMachine := newManualStateMachine(100 * time.Millisecond)
+	gatewayBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	gsBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	gmsUC.NewGMSUseCase(stateMachine, gatewayBroadcaster, gsBroadcaster, &MockGameRoundRepository{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	go stateMachine.Start(ctx)
+
+	// 2. The round is voided and GS refunds it
+	voided := waitForState(t, gatewayBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_VOIDED, time.Second)
+
+	select {
+	case msg := <-gsBroadcaster.Messages:
+		req, ok := msg.(*pbColorGame.ColorGameVoidRoundReq)
+		if !ok {
+			t.Fatalf("Expected VoidRoundReq, got %T", msg)
+		}
+		if req.RoundId != voided.RoundId {
+			t.Errorf("Expected void of round %s, got %s", voided.RoundId, req.RoundId)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Timeout waiting for void notification")
+	}
+
+	stateMachine.Stop()
+}
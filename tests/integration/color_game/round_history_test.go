@@ -0,0 +1,208 @@
+package colorgame_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	pbCommon "github.com/frankieli/game_product/shared/proto/common"
+
+	gmsLocal "github.com/frankieli/game_product/internal/modules/color_game/gms/adapter/local"
+	gmsDomain "github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	gmsMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+	gsLocal "github.com/frankieli/game_product/internal/modules/color_game/gs/adapter/local"
+	gsRepo "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/memory"
+	gsUC "github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
+	gatewayUC "github.com/frankieli/game_product/internal/modules/gateway/usecase"
+	"github.com/frankieli/game_product/internal/modules/wallet"
+	"github.com/frankieli/game_product/pkg/clock"
+)
+
+func TestRoundHistoryRoadmap(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC)
+	fakeClock := clock.NewFake(start)
+	stateMachine := gmsMachine.NewStateMachine()
+	stateMachine.SetClock(fakeClock)
+
+	gameRoundRepo := &MockGameRoundRepository{}
+	finished := func(roundID, tableID string, status gmsDomain.RoundStatus, result string, minute int) {
+		startTime := start.Add(time.Duration(minute) * time.Minute)
+		endTime := startTime.Add(30 * time.Second)
+		gameRoundRepo.Create(ctx, &gmsDomain.GameRound{
+			RoundID:   roundID,
+			GameCode:  "color_game",
+			TableID:   tableID,
+			Status:    status,
+			StartTime: startTime,
+			EndTime:   &endTime,
+			Result:    result,
+		})
+	}
+	finished("r-1", "default", gmsDomain.RoundStatusEnded, "REWARD_RED", -4)
+	finished("r-2", "default", gmsDomain.RoundStatusEnded, `["REWARD_RED","REWARD_RED","REWARD_BLUE"]`, -3)
+	finished("vip-1", "vip", gmsDomain.RoundStatusEnded, "REWARD_GREEN", -3)
+	finished("r-3", "default", gmsDomain.RoundStatusVoided, "", -2)
+	gameRoundRepo.Create(ctx, &gmsDomain.GameRound{RoundID: "r-4", GameCode: "color_game", TableID: "default", StartTime: start.Add(-time.Minute)})
+
+	roundUC := gmsUC.NewGMSUseCase(stateMachine, nil, nil, gameRoundRepo)
+
+	// 1. The roadmap is loaded from the round history: finished rounds of the table newest first
+	history, err := roundUC.GetRoundHistory(ctx, "", 0)
+	if err != nil {
+		t.Fatalf("GetRoundHistory failed: %v", err)
+	}
+	if history.TableID != "default" || len(history.Rounds) != 3 {
+		t.Fatalf("Expected the 3 finished rounds of the default table, got %+v", history)
+	}
+	if history.Rounds[0].RoundID != "r-3" || !history.Rounds[0].Voided || len(history.Rounds[0].Dice) != 0 {
+		t.Fatalf("Expected the voided r-3 first, got %+v", history.Rounds[0])
+	}
+	if history.Rounds[1].RoundID != "r-2" || len(history.Rounds[1].Dice) != 3 || history.Rounds[2].RoundID != "r-1" {
+		t.Fatalf("Expected r-2 with 3 dice then r-1, got %+v", history.Rounds[1:])
+	}
+
+	// 2. Colors are counted in paytable order, by dice and by rounds
+	wantStats := []gmsDomain.ColorStat{{Color: red, Count: 3, Rounds: 2}, {Color: green}, {Color: blue, Count: 1, Rounds: 1}, {Color: pbColorGame.ColorGameReward_REWARD_YELLOW}}
+	if len(history.Stats) != len(wantStats) {
+		t.Fatalf("Expected stats of the 4 paytable colors, got %+v", history.Stats)
+	}
+	for i, want := range wantStats {
+		if history.Stats[i] != want {
+			t.Errorf("Expected stat %+v, got %+v", want, history.Stats[i])
+		}
+	}
+
+	if limited, _ := roundUC.GetRoundHistory(ctx, "", 1); len(limited.Rounds) != 1 || limited.Stats[0].Count != 0 {
+		t.Fatalf("Expected only r-3 without any color drawn, got %+v", limited)
+	}
+	if _, err := roundUC.GetRoundHistory(ctx, "missing", 0); !errors.Is(err, gmsDomain.ErrTableNotFound) {
+		t.Fatalf("Expected ErrTableNotFound for an unknown table, got %v", err)
+	}
+
+	// 3. Rounds finished by another GMS replica show up after the refresh interval
+	finished("r-5", "default", gmsDomain.RoundStatusEnded, "REWARD_GREEN", 0)
+	if cached, _ := roundUC.GetRoundHistory(ctx, "", 0); len(cached.Rounds) != 3 {
+		t.Fatalf("Expected the cached roadmap before the refresh, got %d rounds", len(cached.Rounds))
+	}
+	fakeClock.Advance(gmsUC.RoadmapRefresh)
+	if refreshed, _ := roundUC.GetRoundHistory(ctx, "", 0); len(refreshed.Rounds) != 4 || refreshed.Rounds[0].RoundID != "r-5" {
+		t.Fatalf("Expected r-5 after the refresh, got %+v", refreshed.Rounds)
+	}
+
+	// 4. Support looks rounds up by ID and by time range
+	round, err := roundUC.GetRound(ctx, "r-2")
+	if err != nil || round.Status != gmsDomain.RoundStatusEnded || round.TableID != "default" {
+		t.Fatalf("Expected the ended r-2, got %+v %v", round, err)
+	}
+	if _, err := roundUC.GetRound(ctx, "unknown"); !errors.Is(err, gmsDomain.ErrRoundNotFound) {
+		t.Fatalf("Expected ErrRoundNotFound, got %v", err)
+	}
+	rounds, err := roundUC.ListRounds(ctx, "", start.Add(-3*time.Minute), start, 0)
+	if err != nil || len(rounds) != 4 || rounds[0].RoundID != "r-4" || rounds[3].RoundID != "r-2" {
+		t.Fatalf("Expected r-4, r-3, vip-1 and r-2 newest first, got %d rounds %v", len(rounds), err)
+	}
+	if vip, _ := roundUC.ListRounds(ctx, "vip", start.Add(-time.Hour), start, 0); len(vip) != 1 || vip[0].RoundID != "vip-1" {
+		t.Fatalf("Expected the vip round only, got %+v", vip)
+	}
+
+	// 5. Players read the roadmap through the gateway, and receive it when they connect
+	playerUC := gsUC.NewGSUseCase(gsRepo.NewBetRepository(), &MockBetOrderRepository{}, gmsLocal.NewHandler(roundUC), wallet.NewMockService(), nil)
+	gateway := gatewayUC.NewGatewayUseCase(gsLocal.NewHandler(playerUC), nil)
+	decode := func(message []byte, command string) map[string]interface{} {
+		t.Helper()
+		var resp struct {
+			Command string                 `json:"command"`
+			Data    map[string]interface{} `json:"data"`
+		}
+		if err := json.Unmarshal(message, &resp); err != nil || resp.Command != command {
+			t.Fatalf("Expected %s, got %s %v", command, message, err)
+		}
+		return resp.Data
+	}
+
+	respJSON, err := gateway.HandleMessage(ctx, 9201, []byte(`{"game_code":"color_game","command":"ColorGameRoundHistoryREQ","data":{"limit":2}}`))
+	if err != nil {
+		t.Fatalf("HandleMessage failed: %v", err)
+	}
+	data := decode(respJSON, "ColorGameRoundHistoryRSP")
+	entries := data["rounds"].([]interface{})
+	if data["error_code"] != float64(pbCommon.ErrorCode_SUCCESS) || data["table_id"] != "default" || len(entries) != 2 {
+		t.Fatalf("Expected the last 2 rounds of the default table, got %v", data)
+	}
+	if latest := entries[0].(map[string]interface{}); latest["round_id"] != "r-5" || latest["dice"].([]interface{})[0] != "green" {
+		t.Fatalf("Expected r-5 with green drawn, got %v", latest)
+	}
+	if stat := data["stats"].([]interface{})[1].(map[string]interface{}); stat["color"] != "green" || stat["count"] != float64(1) {
+		t.Fatalf("Expected green drawn once, got %v", stat)
+	}
+
+	respJSON, err = gateway.HandleMessage(ctx, 9201, []byte(`{"game_code":"color_game","command":"ColorGameRoundHistoryREQ","data":{"table_id":"missing"}}`))
+	if err != nil {
+		t.Fatalf("HandleMessage failed: %v", err)
+	}
+	if data := decode(respJSON, "ColorGameRoundHistoryRSP"); data["error_code"] != float64(pbCommon.ErrorCode_NOT_FOUND) {
+		t.Fatalf("Expected NOT_FOUND for an unknown table, got %v", data)
+	}
+
+	pushed, err := gateway.ColorGameRoundHistory(ctx, 9201)
+	if err != nil {
+		t.Fatalf("ColorGameRoundHistory failed: %v", err)
+	}
+	if data := decode(pushed, "ColorGameRoundHistoryBRC"); len(data["rounds"].([]interface{})) != 4 {
+		t.Fatalf("Expected the 4 finished rounds pushed on connect, got %v", data)
+	}
+}
+
+func TestRoundHistoryFollowsRoundEvents(t *testing.T) {
+	// 1. A GMS without round history builds its roadmap from the rounds it runs
+	stateMachine := gmsMachine.NewStateMachine()
+	stateMachine.WaitDuration = 20 * time.Millisecond
+	stateMachine.BettingDuration = 50 * time.Millisecond
+	stateMachine.DrawingDuration = 20 * time.Millisecond
+	stateMachine.ResultDuration = 20 * time.Millisecond
+	stateMachine.RestDuration = 20 * time.Millisecond
+
+	gsBroadcaster := NewTestBroadcaster()
+	roundUC := gmsUC.NewGMSUseCase(stateMachine, nil, gsBroadcaster, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go stateMachine.Start(ctx)
+	defer stateMachine.Stop()
+
+	// 2. Every drawn result is in the roadmap once GS is told about it
+	var results []*pbColorGame.ColorGameRoundResultReq
+	for len(results) < 2 {
+		select {
+		case msg := <-gsBroadcaster.Messages:
+			if req, ok := msg.(*pbColorGame.ColorGameRoundResultReq); ok {
+				results = append(results, req)
+			}
+		case <-ctx.Done():
+			t.Fatalf("Timeout waiting for round results")
+		}
+	}
+
+	history, err := roundUC.GetRoundHistory(ctx, "", 0)
+	if err != nil {
+		t.Fatalf("GetRoundHistory failed: %v", err)
+	}
+	if len(history.Rounds) < 2 || history.Rounds[0].RoundID == history.Rounds[1].RoundID {
+		t.Fatalf("Expected 2 distinct rounds, got %+v", history.Rounds)
+	}
+	byID := make(map[string]gmsDomain.RoadmapEntry)
+	for _, entry := range history.Rounds {
+		byID[entry.RoundID] = entry
+	}
+	for _, result := range results {
+		entry, ok := byID[result.RoundId]
+		if !ok || gmsDomain.FormatResult(entry.Dice) != gmsDomain.FormatResult(result.Dice) {
+			t.Errorf("Expected round %s with dice %v, got %+v", result.RoundId, result.Dice, entry)
+		}
+	}
+}
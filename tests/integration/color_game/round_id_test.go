@@ -0,0 +1,86 @@
+package colorgame_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"google.golang.org/protobuf/proto"
+
+	gmsMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
+)
+
+func TestRoundIDRoundTrip(t *testing.T) {
+	start := time.Date(2025, 12, 5, 12, 34, 56, 789_000_000, time.UTC)
+
+	// 1. Table IDs may contain '-', the fixed fields are parsed from both ends
+	roundID := colorgame.NewRoundID(colorgame.GameCode, "vip-2", start, 42)
+	if roundID != "color_game-vip-2-20251205123456789-000042" {
+		t.Fatalf("Unexpected round id %s", roundID)
+	}
+
+	info, err := colorgame.ParseRoundID(roundID)
+	if err != nil {
+		t.Fatalf("ParseRoundID failed: %v", err)
+	}
+	if info.GameCode != colorgame.GameCode || info.TableID != "vip-2" || !info.Time.Equal(start) || info.Seq != 42 {
+		t.Errorf("Unexpected parse result %+v", info)
+	}
+
+	// 2. IDs of a table sort by time, rounds in the same millisecond differ by sequence
+	ids := []string{
+		colorgame.NewRoundID(colorgame.GameCode, "default", start.Add(time.Second), 3),
+		colorgame.NewRoundID(colorgame.GameCode, "default", start, 2),
+		colorgame.NewRoundID(colorgame.GameCode, "default", start, 1),
+	}
+	sorted := append([]string(nil), ids...)
+	sort.Strings(sorted)
+	if sorted[0] != ids[2] || sorted[1] != ids[1] || sorted[2] != ids[0] {
+		t.Errorf("Expected round ids to sort chronologically, got %v", sorted)
+	}
+
+	// 3. Foreign IDs are rejected
+	for _, invalid := range []string{"20251205123456", "color_game-default-2025-000001", "color_game-default-20251205123456789-x"} {
+		if _, err := colorgame.ParseRoundID(invalid); err == nil {
+			t.Errorf("Expected ParseRoundID(%q) to fail", invalid)
+		}
+	}
+}
+
+func TestStateMachineRoundIDsAreTableScoped(t *testing.T) {
+	// Two tables starting at the same moment must not share round IDs
+	broadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	defaultTable := gmsMachine.NewStateMachine()
+	vipTable := gmsMachine.NewStateMachine()
+	vipTable.TableID = "vip"
+	roundUC := gmsUC.NewGMSUseCase(defaultTable, broadcaster, nil, &MockGameRoundRepository{})
+	roundUC.AddTable(vipTable)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	go roundUC.Start(ctx)
+
+	tables := map[string]string{}
+	for len(tables) < 2 {
+		brc := waitForState(t, broadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_ROUND_STARTED, time.Second)
+		info, err := colorgame.ParseRoundID(brc.RoundId)
+		if err != nil {
+			t.Fatalf("ParseRoundID(%s) failed: %v", brc.RoundId, err)
+		}
+		if info.TableID != brc.TableId || info.Seq != 1 {
+			t.Errorf("Expected round of table %s with seq 1, got %+v", brc.TableId, info)
+		}
+		tables[info.TableID] = brc.RoundId
+	}
+	if tables["default"] == tables["vip"] {
+		t.Errorf("Expected distinct round ids, got %v", tables)
+	}
+
+	cancel()
+	defaultTable.WaitForDone()
+	vipTable.WaitForDone()
+}
@@ -0,0 +1,114 @@
+package colorgame_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"google.golang.org/protobuf/proto"
+
+	gmsDomain "github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	gmsMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	gmsMemory "github.com/frankieli/game_product/internal/modules/color_game/gms/repository/memory"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+	"github.com/frankieli/game_product/pkg/clock"
+)
+
+// waitForRoundStatus polls the round repository until the round reaches status
+func waitForRoundStatus(t *testing.T, repo *MockGameRoundRepository, roundID string, status gmsDomain.RoundStatus) *gmsDomain.GameRound {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if round, _ := repo.GetByRoundID(context.Background(), roundID); round != nil && round.Status == status {
+			return round
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("Timeout waiting for round %s to reach status %v", roundID, status)
+	return nil
+}
+
+// waitForEviction waits until the stats of every round were evicted (eviction follows the repository update)
+func waitForEviction(statsRepo *gmsMemory.RoundStatsRepository) int {
+	deadline := time.Now().Add(time.Second)
+	for statsRepo.Len() != 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	return statsRepo.Len()
+}
+
+func TestRoundStatsArePersistedAndEvicted(t *testing.T) {
+	fakeClock := clock.NewFake(time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC))
+	stateMachine := gmsMachine.NewStateMachine()
+	stateMachine.SetClock(fakeClock)
+
+	broadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	roundRepo := &MockGameRoundRepository{}
+	statsRepo := gmsMemory.NewRoundStatsRepository()
+	roundUC := gmsUC.NewGMSUseCase(stateMachine, broadcaster, nil, roundRepo)
+	roundUC.SetRoundStatsRepository(statsRepo)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	go stateMachine.Start(ctx)
+
+	// 1. Two players, three bets
+	waitForState(t, broadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_ROUND_STARTED, time.Second)
+	fakeClock.BlockUntil(1)
+	fakeClock.Advance(stateMachine.WaitDuration)
+	betting := waitForState(t, broadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_BETTING, time.Second)
+	fakeClock.BlockUntil(1)
+
+	bets := []struct {
+		userID int64
+		amount int64
+	}{{7001, 100}, {7001, 50}, {7002, 30}}
+	for _, bet := range bets {
+		if err := roundUC.RecordBet(ctx, "", betting.RoundId, bet.userID, pbColorGame.ColorGameReward_REWARD_RED, bet.amount); err != nil {
+			t.Fatalf("RecordBet failed: %v", err)
+		}
+	}
+	stats, _ := statsRepo.Get(ctx, betting.RoundId)
+	if stats.TotalBets != 3 || stats.TotalPlayers != 2 || stats.TotalAmount != 180 {
+		t.Errorf("Unexpected live stats %+v", stats)
+	}
+
+	// 2. The result persists the stats, then they are evicted
+	fakeClock.Advance(stateMachine.BettingDuration)
+	waitForState(t, broadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_DRAWING, time.Second)
+	fakeClock.BlockUntil(1)
+	fakeClock.Advance(stateMachine.DrawingDuration)
+
+	round := waitForRoundStatus(t, roundRepo, betting.RoundId, gmsDomain.RoundStatusEnded)
+	if round.TotalBets != 3 || round.TotalPlayers != 2 || round.TotalBetAmount != 180 {
+		t.Errorf("Expected persisted stats 3 bets / 2 players / 180, got %d / %d / %.0f", round.TotalBets, round.TotalPlayers, round.TotalBetAmount)
+	}
+	if left := waitForEviction(statsRepo); left != 0 {
+		t.Errorf("Expected stats to be evicted after the result was persisted, %d rounds left", left)
+	}
+
+	// 3. A voided round is evicted as well
+	fakeClock.BlockUntil(1)
+	fakeClock.Advance(stateMachine.ResultDuration)
+	fakeClock.BlockUntil(1)
+	fakeClock.Advance(stateMachine.RestDuration)
+	waitForState(t, broadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_ROUND_STARTED, time.Second)
+	fakeClock.BlockUntil(1)
+	fakeClock.Advance(stateMachine.WaitDuration)
+	betting = waitForState(t, broadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_BETTING, time.Second)
+	fakeClock.BlockUntil(1)
+
+	if err := roundUC.RecordBet(ctx, "", betting.RoundId, 7001, pbColorGame.ColorGameReward_REWARD_BLUE, 10); err != nil {
+		t.Fatalf("RecordBet failed: %v", err)
+	}
+	if err := roundUC.VoidCurrentRound(ctx, "", betting.RoundId, "", "tester"); err != nil {
+		t.Fatalf("VoidCurrentRound failed: %v", err)
+	}
+	waitForRoundStatus(t, roundRepo, betting.RoundId, gmsDomain.RoundStatusVoided)
+	if left := waitForEviction(statsRepo); left != 0 {
+		t.Errorf("Expected stats of the voided round to be evicted, %d rounds left", left)
+	}
+
+	cancel()
+	stateMachine.WaitForDone()
+}
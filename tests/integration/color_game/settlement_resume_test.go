@@ -0,0 +1,223 @@
+package colorgame_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"google.golang.org/protobuf/proto"
+
+	gsDomain "github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	gsRepo "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/memory"
+	gsUC "github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
+	"github.com/frankieli/game_product/internal/modules/wallet"
+	"github.com/frankieli/game_product/pkg/clock"
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
+)
+
+// crashingPayoutRepository fails the failAt-th settlement batch, as if the GS died while persisting it
+type crashingPayoutRepository struct {
+	gsDomain.PayoutRepository
+	failAt int
+	calls  int
+}
+
+func (r *crashingPayoutRepository) CreateWithOrders(ctx context.Context, orders []*gsDomain.BetOrder, payouts []*gsDomain.Payout) error {
+	r.calls++
+	if r.calls == r.failAt {
+		return errors.New("gs crashed")
+	}
+	return r.PayoutRepository.CreateWithOrders(ctx, orders, payouts)
+}
+
+func TestSettlementResumesAfterCrash(t *testing.T) {
+	ctx := context.Background()
+	fakeClock := clock.NewFake(time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC))
+	walletSvc := wallet.NewMockService()
+	betOrderRepo := &MockBetOrderRepository{}
+	payoutRepo := gsRepo.NewPayoutRepository(betOrderRepo)
+
+	// Two GS instances share the bets, the settlement checkpoints and the payout outbox
+	betRepo := gsRepo.NewBetRepository()
+	settlementRepo := gsRepo.NewSettlementRepository()
+	newGS := func(payouts gsDomain.PayoutRepository) *gsUC.GSUseCase {
+		uc := gsUC.NewGSUseCase(betRepo, betOrderRepo, &bettingGMS{}, walletSvc, &TestBroadcaster{Messages: make(chan proto.Message, 2000)})
+		uc.SetClock(fakeClock)
+		uc.SetPayoutRepository(payouts)
+		uc.SetSettlementRepository(settlementRepo)
+		return uc
+	}
+	crashing := &crashingPayoutRepository{PayoutRepository: payoutRepo, failAt: 2}
+	gsA := newGS(crashing)
+	gsB := newGS(payoutRepo)
+
+	// 1. 600 bets, two settlement batches (500 + 100), every even player wins
+	const betCount = 600
+	for i := 0; i < betCount; i++ {
+		userID := int64(7100 + i)
+		color := green
+		if i%2 == 0 {
+			color = red
+		}
+		walletSvc.SetBalance(userID, 0)
+		_ = betRepo.SaveBet(ctx, gsDomain.NewBet(gsDomain.NewBetID(), "r-1", userID, color, 10, fmt.Sprintf("tx-%d", i), fakeClock.Now()))
+	}
+	dice := []pbColorGame.ColorGameReward{red}
+	winAmount := colorgame.DefaultPaytable().WinAmount(red, 10, dice)
+
+	// 2. GS A dies while persisting the second batch
+	if err := gsA.SettleRound(ctx, colorgame.DefaultTableID, "r-1", dice); err == nil {
+		t.Fatal("Expected the settlement to stop at the second batch")
+	}
+	settlement, err := gsB.GetSettlement(ctx, "r-1")
+	if err != nil || settlement.Status != gsDomain.SettlementStatusSettling || settlement.Batches != 1 || settlement.BetCount != 500 || settlement.LastError == "" {
+		t.Fatalf("Expected the first batch to be checkpointed, got %+v %v", settlement, err)
+	}
+	if len(betOrderRepo.Orders()) != 500 {
+		t.Fatalf("Expected 500 persisted bet orders, got %d", len(betOrderRepo.Orders()))
+	}
+	if unfinished, _ := gsB.ListSettlements(ctx, gsDomain.SettlementStatusSettling, 0); len(unfinished) != 1 {
+		t.Errorf("Expected operators to see one unfinished settlement, got %d", len(unfinished))
+	}
+
+	// 3. The lease protects the round until it expires, then GS B takes it over. GS A does not claim
+	// its own active lease again either (a RESULT repeated by GMS recovery)
+	if err := gsA.SettleRound(ctx, colorgame.DefaultTableID, "r-1", dice); err != nil || len(betOrderRepo.Orders()) != 500 {
+		t.Errorf("Expected GS A to skip its leased round, got %v with %d bet orders", err, len(betOrderRepo.Orders()))
+	}
+	if err := gsB.SettleRound(ctx, colorgame.DefaultTableID, "r-1", dice); err != nil {
+		t.Errorf("Expected a leased round to be skipped, got %v", err)
+	}
+	if n, _ := gsB.ResumeSettlements(ctx); n != 0 || len(betOrderRepo.Orders()) != 500 {
+		t.Error("Expected no takeover before the lease expired")
+	}
+	fakeClock.Advance(time.Minute + time.Second)
+	if n, err := gsB.ResumeSettlements(ctx); n != 1 || err != nil {
+		t.Fatalf("Expected GS B to resume the settlement, got %d %v", n, err)
+	}
+	settlement, _ = gsB.GetSettlement(ctx, "r-1")
+	if settlement.Status != gsDomain.SettlementStatusSettled || settlement.BetCount != betCount || settlement.Batches != 2 || settlement.Attempts != 2 || settlement.FinishedAt == nil {
+		t.Errorf("Expected the settlement to be completed by GS B, got %+v", settlement)
+	}
+	if len(betOrderRepo.Orders()) != betCount {
+		t.Errorf("Expected every bet to be persisted once, got %d bet orders", len(betOrderRepo.Orders()))
+	}
+
+	// 4. The winners of the resumed batch are paid by the payout worker, every winner exactly once
+	if n, _ := gsB.RunPayouts(ctx); n != 50 {
+		t.Errorf("Expected the 50 winners of the resumed batch to be paid by the worker, got %d", n)
+	}
+	if err := gsA.SettleRound(ctx, colorgame.DefaultTableID, "r-1", dice); err != nil {
+		t.Errorf("Expected a settled round to be skipped, got %v", err)
+	}
+	fakeClock.Advance(time.Hour)
+	_, _ = gsA.RunPayouts(ctx)
+	for i := 0; i < betCount; i++ {
+		expected := int64(0)
+		if i%2 == 0 {
+			expected = winAmount
+		}
+		if got, _ := walletSvc.GetBalance(ctx, int64(7100+i)); got != expected {
+			t.Fatalf("User %d: expected balance %d, got %d", 7100+i, expected, got)
+		}
+	}
+}
+
+// blockingPayoutRepository holds the first settlement batch until released
+type blockingPayoutRepository struct {
+	gsDomain.PayoutRepository
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (r *blockingPayoutRepository) CreateWithOrders(ctx context.Context, orders []*gsDomain.BetOrder, payouts []*gsDomain.Payout) error {
+	select {
+	case r.entered <- struct{}{}:
+		<-r.release
+	default:
+	}
+	return r.PayoutRepository.CreateWithOrders(ctx, orders, payouts)
+}
+
+func TestRepeatedResultDuringSettlementIsDropped(t *testing.T) {
+	ctx := context.Background()
+	fakeClock := clock.NewFake(time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC))
+	walletSvc := wallet.NewMockService()
+	betOrderRepo := &MockBetOrderRepository{}
+	betRepo := gsRepo.NewBetRepository()
+	payouts := &blockingPayoutRepository{
+		PayoutRepository: gsRepo.NewPayoutRepository(betOrderRepo),
+		entered:          make(chan struct{}),
+		release:          make(chan struct{}),
+	}
+	playerUC := gsUC.NewGSUseCase(betRepo, betOrderRepo, &bettingGMS{}, walletSvc, &TestBroadcaster{Messages: make(chan proto.Message, 100)})
+	playerUC.SetClock(fakeClock)
+	playerUC.SetPayoutRepository(payouts)
+
+	for i := 0; i < 3; i++ {
+		walletSvc.SetBalance(int64(7800+i), 0)
+		_ = betRepo.SaveBet(ctx, gsDomain.NewBet(gsDomain.NewBetID(), "r-1", int64(7800+i), red, 10, fmt.Sprintf("tx-%d", i), fakeClock.Now()))
+	}
+	dice := []pbColorGame.ColorGameReward{red}
+
+	// 1. The first RESULT settles the round, it is held while persisting its batch
+	done := make(chan error, 1)
+	go func() {
+		done <- playerUC.SettleRound(ctx, colorgame.DefaultTableID, "r-1", dice)
+	}()
+	<-payouts.entered
+
+	// 2. The RESULT announced again after the lease expired is dropped, the round is already settling here
+	fakeClock.Advance(time.Minute + time.Second)
+	if err := playerUC.SettleRound(ctx, colorgame.DefaultTableID, "r-1", dice); err != nil {
+		t.Fatalf("Expected the repeated RESULT to be dropped, got %v", err)
+	}
+	if n, _ := playerUC.ResumeSettlements(ctx); n != 0 {
+		t.Fatalf("Expected no resume of a round settling on this GS, got %d", n)
+	}
+
+	close(payouts.release)
+	if err := <-done; err != nil {
+		t.Fatalf("SettleRound failed: %v", err)
+	}
+	settlement, _ := playerUC.GetSettlement(ctx, "r-1")
+	if settlement.Status != gsDomain.SettlementStatusSettled || settlement.BetCount != 3 || settlement.Attempts != 1 || len(betOrderRepo.Orders()) != 3 {
+		t.Fatalf("Expected the round settled once, got %+v with %d bet orders", settlement, len(betOrderRepo.Orders()))
+	}
+}
+
+func TestSettlementLeaseRenewedOnlyByOwner(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 12, 5, 12, 0, 0, 0, time.UTC)
+	repo := gsRepo.NewSettlementRepository()
+
+	settlement := &gsDomain.RoundSettlement{RoundID: "r-1", TableID: colorgame.DefaultTableID, Dice: gsDomain.EncodeDice([]gsDomain.Color{red})}
+	if claimed, err := repo.Claim(ctx, settlement, "gs-a", now, now.Add(time.Minute)); err != nil || !claimed {
+		t.Fatalf("Claim failed: %v %v", claimed, err)
+	}
+
+	// 1. The owner keeps a long batch leased, the round is not up for takeover
+	if renewed, err := repo.Renew(ctx, "r-1", "gs-a", now.Add(2*time.Minute)); err != nil || !renewed {
+		t.Fatalf("Expected the owner to renew its lease, got %v %v", renewed, err)
+	}
+	if expired, _ := repo.ListExpired(ctx, now.Add(90*time.Second), 10); len(expired) != 0 {
+		t.Fatalf("Expected no expired settlement while renewed, got %d", len(expired))
+	}
+
+	// 2. Another GS cannot renew a lease it does not hold
+	if renewed, _ := repo.Renew(ctx, "r-1", "gs-b", now.Add(3*time.Minute)); renewed {
+		t.Fatal("Expected a renewal by another GS to be rejected")
+	}
+
+	// 3. A finished settlement is not renewed
+	settlement.Status = gsDomain.SettlementStatusSettled
+	if saved, _ := repo.Checkpoint(ctx, settlement); !saved {
+		t.Fatal("Expected the owner to finish the settlement")
+	}
+	if renewed, _ := repo.Renew(ctx, "r-1", "gs-a", now.Add(3*time.Minute)); renewed {
+		t.Fatal("Expected a finished settlement not to be renewed")
+	}
+}
@@ -53,7 +53,7 @@ func TestSettlement(t *testing.T) {
 	// 3. Wait for betting state
 	var currentRoundID string
 	for i := 0; i < 20; i++ {
-		round, err := playerUC.GetCurrentRound(ctx, 1001)
+		round, err := playerUC.GetCurrentRound(ctx, 1001, "")
 		if err != nil {
 			t.Logf("GetCurrentRound error: %v", err)
 		} else {
@@ -89,7 +89,7 @@ func TestSettlement(t *testing.T) {
 	// Set initial balance and place bets
 	for _, tc := range testCases {
 		walletSvc.SetBalance(tc.userID, 1000)
-		_, err := playerUC.PlaceBet(ctx, tc.userID, tc.color, tc.amount)
+		_, err := playerUC.PlaceBet(ctx, tc.userID, "", tc.color, tc.amount, "")
 		if err != nil {
 			t.Fatalf("PlaceBet failed for user %d: %v", tc.userID, err)
 		}
@@ -97,7 +97,7 @@ func TestSettlement(t *testing.T) {
 
 	// 5. Trigger settlement with Red as winning color
 	winningColor := pbColorGame.ColorGameReward_REWARD_RED
-	err := playerUC.SettleRound(ctx, currentRoundID, winningColor)
+	err := playerUC.SettleRound(ctx, "", currentRoundID, []gsDomain.Color{winningColor})
 	if err != nil {
 		t.Fatalf("SettleRound failed: %v", err)
 	}
@@ -2,14 +2,15 @@ package colorgame_test
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"google.golang.org/protobuf/proto"
 
 	gmsDomain "github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
-	gsDomain "github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
 	"github.com/frankieli/game_product/pkg/logger"
 	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"github.com/frankieli/game_product/tests/integration/testutil"
 )
 
 func init() {
@@ -31,15 +32,56 @@ func (m *TestBroadcaster) Broadcast(ctx context.Context, gameCode string, messag
 	m.Messages <- message
 }
 
+func (m *TestBroadcaster) BroadcastToTable(ctx context.Context, gameCode string, tableID string, message proto.Message) {
+	m.Messages <- message
+}
+
 func (m *TestBroadcaster) RoundResult(ctx context.Context, req *pbColorGame.ColorGameRoundResultReq) (*pbColorGame.ColorGameRoundResultRsp, error) {
 	m.Messages <- req
 	return &pbColorGame.ColorGameRoundResultRsp{}, nil
 }
 
+func (m *TestBroadcaster) VoidRound(ctx context.Context, req *pbColorGame.ColorGameVoidRoundReq) (*pbColorGame.ColorGameVoidRoundRsp, error) {
+	m.Messages <- req
+	return &pbColorGame.ColorGameVoidRoundRsp{}, nil
+}
+
+func (m *TestBroadcaster) CloseBetting(ctx context.Context, req *pbColorGame.ColorGameCloseBettingReq) (*pbColorGame.ColorGameCloseBettingRsp, error) {
+	return &pbColorGame.ColorGameCloseBettingRsp{}, nil
+}
+
 func (m *TestBroadcaster) PlaceBet(ctx context.Context, req *pbColorGame.ColorGamePlaceBetReq) (*pbColorGame.ColorGamePlaceBetRsp, error) {
 	return &pbColorGame.ColorGamePlaceBetRsp{}, nil
 }
 
+func (m *TestBroadcaster) CancelBet(ctx context.Context, req *pbColorGame.ColorGameCancelBetReq) (*pbColorGame.ColorGameCancelBetRsp, error) {
+	return &pbColorGame.ColorGameCancelBetRsp{}, nil
+}
+
+func (m *TestBroadcaster) PlaceBetSlip(ctx context.Context, req *pbColorGame.ColorGamePlaceBetSlipReq) (*pbColorGame.ColorGamePlaceBetSlipRsp, error) {
+	return &pbColorGame.ColorGamePlaceBetSlipRsp{}, nil
+}
+
+func (m *TestBroadcaster) Rebet(ctx context.Context, req *pbColorGame.ColorGameRebetReq) (*pbColorGame.ColorGameRebetRsp, error) {
+	return &pbColorGame.ColorGameRebetRsp{}, nil
+}
+
+func (m *TestBroadcaster) StartAutoBet(ctx context.Context, req *pbColorGame.ColorGameStartAutoBetReq) (*pbColorGame.ColorGameStartAutoBetRsp, error) {
+	return &pbColorGame.ColorGameStartAutoBetRsp{}, nil
+}
+
+func (m *TestBroadcaster) StopAutoBet(ctx context.Context, req *pbColorGame.ColorGameStopAutoBetReq) (*pbColorGame.ColorGameStopAutoBetRsp, error) {
+	return &pbColorGame.ColorGameStopAutoBetRsp{}, nil
+}
+
+func (m *TestBroadcaster) GetBetHistory(ctx context.Context, req *pbColorGame.ColorGameGetBetHistoryReq) (*pbColorGame.ColorGameGetBetHistoryRsp, error) {
+	return &pbColorGame.ColorGameGetBetHistoryRsp{}, nil
+}
+
+func (m *TestBroadcaster) GetRoundHistory(ctx context.Context, req *pbColorGame.ColorGameGetRoundHistoryReq) (*pbColorGame.ColorGameGetRoundHistoryRsp, error) {
+	return &pbColorGame.ColorGameGetRoundHistoryRsp{}, nil
+}
+
 func (m *TestBroadcaster) GetState(ctx context.Context, req *pbColorGame.ColorGameGetStateReq) (*pbColorGame.ColorGameGetStateRsp, error) {
 	return &pbColorGame.ColorGameGetStateRsp{}, nil
 }
@@ -51,26 +93,93 @@ func (m *TestBroadcaster) SendToUser(ctx context.Context, userID int64, gameCode
 // MockGameRoundRepository for testing
 type MockGameRoundRepository struct {
 	rounds []*gmsDomain.GameRound
+	mu     sync.Mutex
 }
 
 func (m *MockGameRoundRepository) Create(ctx context.Context, round *gmsDomain.GameRound) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.rounds = append(m.rounds, round)
 	return nil
 }
 
 func (m *MockGameRoundRepository) UpdateResult(ctx context.Context, roundID string, result string, endTime *time.Time, totalBets int, totalPlayers int, totalAmount float64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if round := m.find(roundID); round != nil {
+		round.Result = result
+		round.Status = gmsDomain.RoundStatusEnded
+		round.EndTime = endTime
+		round.TotalBets = totalBets
+		round.TotalPlayers = totalPlayers
+		round.TotalBetAmount = totalAmount
+	}
+	return nil
+}
+
+func (m *MockGameRoundRepository) MarkVoided(ctx context.Context, roundID string, endTime *time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if round := m.find(roundID); round != nil {
+		round.Status = gmsDomain.RoundStatusVoided
+		round.EndTime = endTime
+	}
+	return nil
+}
+
+func (m *MockGameRoundRepository) RevealServerSeed(ctx context.Context, roundID string, serverSeed string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if round := m.find(roundID); round != nil {
+		round.ServerSeed = serverSeed
+	}
 	return nil
 }
 
-// MockBetOrderRepository for testing
-type MockBetOrderRepository struct {
-	orders []*gsDomain.BetOrder
+func (m *MockGameRoundRepository) GetByRoundID(ctx context.Context, roundID string) (*gmsDomain.GameRound, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if round := m.find(roundID); round != nil {
+		copied := *round
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (m *MockGameRoundRepository) ListRecent(ctx context.Context, tableID string, limit int) ([]*gmsDomain.GameRound, error) {
+	return m.list(func(round *gmsDomain.GameRound) bool {
+		return round.TableID == tableID && round.Status != gmsDomain.RoundStatusInProgress
+	}, limit), nil
+}
+
+func (m *MockGameRoundRepository) ListByTimeRange(ctx context.Context, tableID string, from, to time.Time, limit int) ([]*gmsDomain.GameRound, error) {
+	return m.list(func(round *gmsDomain.GameRound) bool {
+		return (tableID == "" || round.TableID == tableID) && !round.StartTime.Before(from) && round.StartTime.Before(to)
+	}, limit), nil
 }
 
-func (m *MockBetOrderRepository) BatchCreate(ctx context.Context, orders []*gsDomain.BetOrder) error {
-	if m.orders == nil {
-		m.orders = make([]*gsDomain.BetOrder, 0)
+// list returns copies of the matching rounds newest first
+func (m *MockGameRoundRepository) list(match func(*gmsDomain.GameRound) bool, limit int) []*gmsDomain.GameRound {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	rounds := make([]*gmsDomain.GameRound, 0)
+	for i := len(m.rounds) - 1; i >= 0 && len(rounds) < limit; i-- {
+		if match(m.rounds[i]) {
+			copied := *m.rounds[i]
+			rounds = append(rounds, &copied)
+		}
+	}
+	return rounds
+}
+
+func (m *MockGameRoundRepository) find(roundID string) *gmsDomain.GameRound {
+	for _, round := range m.rounds {
+		if round.RoundID == roundID {
+			return round
+		}
 	}
-	m.orders = append(m.orders, orders...)
 	return nil
 }
+
+// MockBetOrderRepository for testing, shared with the other integration test packages
+type MockBetOrderRepository = testutil.MockBetOrderRepository
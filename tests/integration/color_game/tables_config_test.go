@@ -0,0 +1,40 @@
+package colorgame_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/frankieli/game_product/internal/config"
+)
+
+func TestParseTables(t *testing.T) {
+	// 1. Durations are optional, the default table is hosted when none is listed
+	tables, err := config.ParseTables("default, vip:20:3:5:2:3")
+	if err != nil {
+		t.Fatalf("ParseTables failed: %v", err)
+	}
+	if len(tables) != 2 || tables[0].ID != "default" || tables[0].Betting != 0 {
+		t.Fatalf("Expected default table with state machine durations, got %+v", tables)
+	}
+	if vip := tables[1]; vip.ID != "vip" || vip.Betting != 20*time.Second || vip.Rest != 3*time.Second {
+		t.Errorf("Expected vip table with 20s betting and 3s rest, got %+v", vip)
+	}
+	if tables, err := config.ParseTables(" "); err != nil || len(tables) != 1 || tables[0].ID != "default" {
+		t.Errorf("Expected the default table for an empty list, got %+v (%v)", tables, err)
+	}
+
+	// 2. A misconfigured table fails startup instead of being dropped or run with default timings
+	for _, spec := range []string{
+		"default,vip room",
+		"default,,vip",
+		"vip,vip:20",
+		"vip:20s",
+		"vip:20:0",
+		"vip:20:-3",
+		"vip:1:2:3:4:5:6",
+	} {
+		if tables, err := config.ParseTables(spec); err == nil {
+			t.Errorf("Expected an error for %q, got %+v", spec, tables)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package colorgame_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"google.golang.org/protobuf/proto"
+
+	gmsLocal "github.com/frankieli/game_product/internal/modules/color_game/gms/adapter/local"
+	gmsDomain "github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
+	gmsMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+	gsLocal "github.com/frankieli/game_product/internal/modules/color_game/gs/adapter/local"
+	gsRepo "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/memory"
+	gsUC "github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
+	"github.com/frankieli/game_product/internal/modules/wallet"
+	colorgame "github.com/frankieli/game_product/pkg/service/color_game"
+)
+
+const (
+	red   = pbColorGame.ColorGameReward_REWARD_RED
+	green = pbColorGame.ColorGameReward_REWARD_GREEN
+	blue  = pbColorGame.ColorGameReward_REWARD_BLUE
+)
+
+func TestThreeDicePaytable(t *testing.T) {
+	paytable, err := colorgame.NewPaytable(colorgame.DrawModeThreeDice, "", "")
+	if err != nil {
+		t.Fatalf("NewPaytable failed: %v", err)
+	}
+	if paytable.DiceCount() != 3 || len(paytable.Entries) != 6 {
+		t.Fatalf("Expected 3 dice of 6 colors, got %d dice of %d colors", paytable.DiceCount(), len(paytable.Entries))
+	}
+
+	// 1. 1:1, 2:1 and 3:1 depending on the matching dice
+	dice := []pbColorGame.ColorGameReward{red, red, blue}
+	for color, expected := range map[pbColorGame.ColorGameReward]int64{red: 300, blue: 200, green: 0} {
+		if win := paytable.WinAmount(color, 100, dice); win != expected {
+			t.Errorf("%s: expected win %d, got %d", color, expected, win)
+		}
+	}
+	if win := paytable.WinAmount(red, 100, []pbColorGame.ColorGameReward{red, red, red}); win != 400 {
+		t.Errorf("Expected triple RED to pay 400, got %d", win)
+	}
+
+	// 2. The traditional game returns 199/216 (house edge 7.87%)
+	if report := paytable.RTP(); math.Abs(report.Colors[0].RTP-199.0/216) > 1e-9 || math.Abs(report.Colors[0].Probability-91.0/216) > 1e-9 {
+		t.Errorf("Unexpected RTP %v, win probability %v", report.Colors[0].RTP, report.Colors[0].Probability)
+	}
+
+	// 3. Invalid configurations
+	if _, err := colorgame.ParseDicePaytable(colorgame.DefaultDicePaytableSpec, "2,3"); err == nil {
+		t.Error("Expected two match payouts to be rejected")
+	}
+	if _, err := colorgame.NewPaytable("five_dice", "", ""); err == nil {
+		t.Error("Expected an unknown draw mode to be rejected")
+	}
+	if err := paytable.ValidDice([]pbColorGame.ColorGameReward{red, blue}); err == nil {
+		t.Error("Expected two dice to be rejected")
+	}
+
+	// 4. Results round trip through GameRound.Result
+	if encoded := gmsDomain.FormatResult(dice); encoded != `["REWARD_RED","REWARD_RED","REWARD_BLUE"]` {
+		t.Errorf("Unexpected encoded result %s", encoded)
+	}
+	for _, result := range []string{"REWARD_GREEN", `["REWARD_RED","REWARD_RED","REWARD_BLUE"]`} {
+		decoded, err := gmsDomain.ParseResult(result)
+		if err != nil || gmsDomain.FormatResult(decoded) != result {
+			t.Errorf("Result %s did not round trip: %v %v", result, decoded, err)
+		}
+	}
+}
+
+func TestThreeDiceRoundSettlesPerMatch(t *testing.T) {
+	paytable, _ := colorgame.NewPaytable(colorgame.DrawModeThreeDice, "", "")
+
+	// 1. A live dealer table rolling three dice, GS settles the result
+	stateMachine := newManualStateMachine(time.Second)
+	stateMachine.BettingDuration = 300 * time.Millisecond
+	provider := gmsMachine.NewManualProvider(time.Second)
+	provider.Paytable = paytable
+	stateMachine.SetResultProvider(provider)
+
+	broadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	gameRoundRepo := &MockGameRoundRepository{}
+	roundUC := gmsUC.NewGMSUseCase(stateMachine, broadcaster, nil, gameRoundRepo)
+	roundUC.SetPaytable(paytable)
+
+	walletSvc := wallet.NewMockService()
+	playerUC := gsUC.NewGSUseCase(gsRepo.NewBetRepository(), &MockBetOrderRepository{}, gmsLocal.NewHandler(roundUC), walletSvc, broadcaster)
+	playerUC.SetPaytable(paytable)
+	roundUC.SetGSService(gsLocal.NewHandler(playerUC))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	go stateMachine.Start(ctx)
+
+	betting := waitForState(t, broadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_BETTING, time.Second)
+	bets := map[int64]pbColorGame.ColorGameReward{7001: red, 7002: blue, 7003: green}
+	for userID, color := range bets {
+		walletSvc.SetBalance(userID, 1000)
+		if _, err := playerUC.PlaceBet(ctx, userID, "", color, 100, ""); err != nil {
+			t.Fatalf("PlaceBet failed: %v", err)
+		}
+	}
+
+	// 2. The dealer must submit all three dice
+	waitForState(t, broadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_DRAWING, time.Second)
+	if err := roundUC.SubmitResult(ctx, "", betting.RoundId, []pbColorGame.ColorGameReward{red}, "tester"); err == nil {
+		t.Error("Expected a single die to be rejected")
+	}
+	if err := roundUC.SubmitResult(ctx, "", betting.RoundId, []pbColorGame.ColorGameReward{red, red, blue}, "tester"); err != nil {
+		t.Fatalf("SubmitResult failed: %v", err)
+	}
+
+	// 3. Every player, losers included, learns all dice and their match count
+	settlements := make(map[int64]*pbColorGame.ColorGameSettlementBRC)
+	deadline := time.After(time.Second)
+	for len(settlements) < len(bets) {
+		select {
+		case msg := <-broadcaster.Messages:
+			if brc, ok := msg.(*pbColorGame.ColorGameSettlementBRC); ok && brc.BetId != "" {
+				for userID, color := range bets {
+					if color == brc.BetColor {
+						settlements[userID] = brc
+					}
+				}
+			}
+		case <-deadline:
+			t.Fatalf("Timeout waiting for settlements, got %d", len(settlements))
+		}
+	}
+	if brc := settlements[7001]; brc.Matches != 2 || brc.WinAmount != 300 || len(brc.Dice) != 3 || brc.WinningColor != red {
+		t.Errorf("Unexpected RED settlement %+v", brc)
+	}
+	if brc := settlements[7002]; brc.Matches != 1 || brc.WinAmount != 200 {
+		t.Errorf("Unexpected BLUE settlement %+v", brc)
+	}
+
+	expected := map[int64]int64{7001: 1200, 7002: 1100, 7003: 900}
+	for userID, balance := range expected {
+		if got, _ := walletSvc.GetBalance(ctx, userID); got != balance {
+			t.Errorf("User %d: expected balance %d, got %d", userID, balance, got)
+		}
+	}
+
+	// 4. The round stores every die
+	round, _ := gameRoundRepo.GetByRoundID(ctx, betting.RoundId)
+	if round == nil || round.Result != `["REWARD_RED","REWARD_RED","REWARD_BLUE"]` {
+		t.Errorf("Expected the dice to be stored as JSON, got %+v", round)
+	}
+
+	cancel()
+	stateMachine.WaitForDone()
+}
+
+func TestThreeDiceProvablyFair(t *testing.T) {
+	paytable, _ := colorgame.NewPaytable(colorgame.DrawModeThreeDice, "", "")
+
+	stateMachine := newManualStateMachine(time.Second)
+	provider := gmsMachine.NewFairProvider("public-seed")
+	provider.Paytable = paytable
+	stateMachine.SetResultProvider(provider)
+
+	broadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	gameRoundRepo := &MockGameRoundRepository{}
+	roundUC := gmsUC.NewGMSUseCase(stateMachine, broadcaster, nil, gameRoundRepo)
+	roundUC.SetPaytable(paytable)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	go stateMachine.Start(ctx)
+
+	result := waitForState(t, broadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_RESULT, 2*time.Second)
+	waitForState(t, broadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_ROUND_ENDED, time.Second)
+	cancel()
+	stateMachine.WaitForDone()
+
+	proof, err := roundUC.VerifyRound(context.Background(), result.RoundId)
+	if err != nil {
+		t.Fatalf("VerifyRound failed: %v", err)
+	}
+	if !proof.Verified || len(proof.Dice) != 3 || len(proof.ComputedDice) != 3 {
+		t.Errorf("Expected three verified dice, got %+v", proof)
+	}
+	if proof.Result != proof.Dice[0] {
+		t.Errorf("Expected the result to be the first die, got %s and %v", proof.Result, proof.Dice)
+	}
+}
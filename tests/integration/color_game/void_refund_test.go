@@ -0,0 +1,98 @@
+package colorgame_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"google.golang.org/protobuf/proto"
+
+	gmsLocal "github.com/frankieli/game_product/internal/modules/color_game/gms/adapter/local"
+	gmsMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+	gsDomain "github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+	gsRepo "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/memory"
+	gsUC "github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
+	"github.com/frankieli/game_product/internal/modules/wallet"
+)
+
+func TestVoidRoundRefundsEveryBet(t *testing.T) {
+	// 1. Setup GMS with a long betting phase
+	stateMachine := gmsMachine.NewStateMachine()
+	stateMachine.WaitDuration = 50 * time.Millisecond
+	stateMachine.BettingDuration = 2 * time.Second
+
+	roundBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	roundUC := gmsUC.NewGMSUseCase(stateMachine, roundBroadcaster, nil, &MockGameRoundRepository{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	go stateMachine.Start(ctx)
+
+	// 2. Setup GS
+	betRepo := gsRepo.NewBetRepository()
+	betOrderRepo := &MockBetOrderRepository{}
+	walletSvc := wallet.NewMockService()
+	playerBroadcaster := &TestBroadcaster{Messages: make(chan proto.Message, 100)}
+	playerUC := gsUC.NewGSUseCase(betRepo, betOrderRepo, gmsLocal.NewHandler(roundUC), walletSvc, playerBroadcaster)
+
+	betting := waitForState(t, roundBroadcaster.Messages, pbColorGame.ColorGameState_GAME_STATE_BETTING, time.Second)
+
+	// 3. Place bets, user 1001 adds to the same bet twice (two wallet transactions)
+	walletSvc.SetBalance(1001, 1000)
+	walletSvc.SetBalance(1002, 1000)
+	bets := []struct {
+		userID int64
+		color  gsDomain.Color
+		amount int64
+	}{
+		{1001, pbColorGame.ColorGameReward_REWARD_RED, 100},
+		{1001, pbColorGame.ColorGameReward_REWARD_RED, 50},
+		{1002, pbColorGame.ColorGameReward_REWARD_BLUE, 300},
+	}
+	for _, bet := range bets {
+		if _, err := playerUC.PlaceBet(ctx, bet.userID, "", bet.color, bet.amount, ""); err != nil {
+			t.Fatalf("PlaceBet failed for user %d: %v", bet.userID, err)
+		}
+	}
+
+	// 4. Void the round
+	if err := playerUC.VoidRound(ctx, "", betting.RoundId, "dealer error"); err != nil {
+		t.Fatalf("VoidRound failed: %v", err)
+	}
+
+	// 5. Every stake is returned
+	for _, userID := range []int64{1001, 1002} {
+		balance, _ := walletSvc.GetBalance(ctx, userID)
+		if balance != 1000 {
+			t.Errorf("User %d: Expected balance 1000 after refund, got %d", userID, balance)
+		}
+	}
+
+	// 6. Bet orders are persisted as refunded
+	if len(betOrderRepo.Orders()) != 2 {
+		t.Fatalf("Expected 2 bet orders, got %d", len(betOrderRepo.Orders()))
+	}
+	for _, order := range betOrderRepo.Orders() {
+		if order.Status != gsDomain.BetOrderStatusRefunded || order.Payout != 0 || order.SettledAt == nil {
+			t.Errorf("Expected order %s refunded without payout, got status=%d payout=%v", order.OrderID, order.Status, order.Payout)
+		}
+	}
+
+	// 7. Each player is notified of its refund
+	refunds := map[int64]int64{}
+	for len(playerBroadcaster.Messages) > 0 {
+		if brc, ok := (<-playerBroadcaster.Messages).(*pbColorGame.ColorGameRefundBRC); ok {
+			if brc.RoundId != betting.RoundId || brc.Reason != "dealer error" {
+				t.Errorf("Unexpected refund notification %v", brc)
+			}
+			refunds[brc.RefundAmount]++
+		}
+	}
+	if refunds[150] != 1 || refunds[300] != 1 {
+		t.Errorf("Expected refunds of 150 and 300, got %v", refunds)
+	}
+
+	stateMachine.Stop()
+}
@@ -45,7 +45,7 @@ func TestGatewayFlow(t *testing.T) {
 	gsHandler := gsLocal.NewHandler(playerUC)
 
 	// 2. Setup Gateway
-	gateway := gatewayUC.NewGatewayUseCase(gsHandler)
+	gateway := gatewayUC.NewGatewayUseCase(gsHandler, nil)
 
 	// 3. Test: Place Bet via Gateway (New Protocol)
 	userID := int64(2001)
@@ -7,9 +7,9 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	gmsDomain "github.com/frankieli/game_product/internal/modules/color_game/gms/domain"
-	gsDomain "github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
 	"github.com/frankieli/game_product/pkg/logger"
 	pbColorGame "github.com/frankieli/game_product/shared/proto/colorgame"
+	"github.com/frankieli/game_product/tests/integration/testutil"
 )
 
 func init() {
@@ -19,6 +19,8 @@ func init() {
 type MockBroadcaster struct{}
 
 func (m *MockBroadcaster) Broadcast(ctx context.Context, gameCode string, message proto.Message) {}
+func (m *MockBroadcaster) BroadcastToTable(ctx context.Context, gameCode string, tableID string, message proto.Message) {
+}
 func (m *MockBroadcaster) SendToUser(ctx context.Context, userID int64, gameCode string, message proto.Message) {
 }
 
@@ -26,10 +28,46 @@ func (m *MockBroadcaster) RoundResult(ctx context.Context, req *pbColorGame.Colo
 	return &pbColorGame.ColorGameRoundResultRsp{}, nil
 }
 
+func (m *MockBroadcaster) VoidRound(ctx context.Context, req *pbColorGame.ColorGameVoidRoundReq) (*pbColorGame.ColorGameVoidRoundRsp, error) {
+	return &pbColorGame.ColorGameVoidRoundRsp{}, nil
+}
+
+func (m *MockBroadcaster) CloseBetting(ctx context.Context, req *pbColorGame.ColorGameCloseBettingReq) (*pbColorGame.ColorGameCloseBettingRsp, error) {
+	return &pbColorGame.ColorGameCloseBettingRsp{}, nil
+}
+
 func (m *MockBroadcaster) PlaceBet(ctx context.Context, req *pbColorGame.ColorGamePlaceBetReq) (*pbColorGame.ColorGamePlaceBetRsp, error) {
 	return &pbColorGame.ColorGamePlaceBetRsp{}, nil
 }
 
+func (m *MockBroadcaster) CancelBet(ctx context.Context, req *pbColorGame.ColorGameCancelBetReq) (*pbColorGame.ColorGameCancelBetRsp, error) {
+	return &pbColorGame.ColorGameCancelBetRsp{}, nil
+}
+
+func (m *MockBroadcaster) PlaceBetSlip(ctx context.Context, req *pbColorGame.ColorGamePlaceBetSlipReq) (*pbColorGame.ColorGamePlaceBetSlipRsp, error) {
+	return &pbColorGame.ColorGamePlaceBetSlipRsp{}, nil
+}
+
+func (m *MockBroadcaster) Rebet(ctx context.Context, req *pbColorGame.ColorGameRebetReq) (*pbColorGame.ColorGameRebetRsp, error) {
+	return &pbColorGame.ColorGameRebetRsp{}, nil
+}
+
+func (m *MockBroadcaster) StartAutoBet(ctx context.Context, req *pbColorGame.ColorGameStartAutoBetReq) (*pbColorGame.ColorGameStartAutoBetRsp, error) {
+	return &pbColorGame.ColorGameStartAutoBetRsp{}, nil
+}
+
+func (m *MockBroadcaster) StopAutoBet(ctx context.Context, req *pbColorGame.ColorGameStopAutoBetReq) (*pbColorGame.ColorGameStopAutoBetRsp, error) {
+	return &pbColorGame.ColorGameStopAutoBetRsp{}, nil
+}
+
+func (m *MockBroadcaster) GetBetHistory(ctx context.Context, req *pbColorGame.ColorGameGetBetHistoryReq) (*pbColorGame.ColorGameGetBetHistoryRsp, error) {
+	return &pbColorGame.ColorGameGetBetHistoryRsp{}, nil
+}
+
+func (m *MockBroadcaster) GetRoundHistory(ctx context.Context, req *pbColorGame.ColorGameGetRoundHistoryReq) (*pbColorGame.ColorGameGetRoundHistoryRsp, error) {
+	return &pbColorGame.ColorGameGetRoundHistoryRsp{}, nil
+}
+
 func (m *MockBroadcaster) GetState(ctx context.Context, req *pbColorGame.ColorGameGetStateReq) (*pbColorGame.ColorGameGetStateRsp, error) {
 	return &pbColorGame.ColorGameGetStateRsp{}, nil
 }
@@ -48,15 +86,25 @@ func (m *MockGameRoundRepository) UpdateResult(ctx context.Context, roundID stri
 	return nil
 }
 
-// MockBetOrderRepository for testing
-type MockBetOrderRepository struct {
-	orders []*gsDomain.BetOrder
+func (m *MockGameRoundRepository) MarkVoided(ctx context.Context, roundID string, endTime *time.Time) error {
+	return nil
 }
 
-func (m *MockBetOrderRepository) BatchCreate(ctx context.Context, orders []*gsDomain.BetOrder) error {
-	if m.orders == nil {
-		m.orders = make([]*gsDomain.BetOrder, 0)
-	}
-	m.orders = append(m.orders, orders...)
+func (m *MockGameRoundRepository) RevealServerSeed(ctx context.Context, roundID string, serverSeed string) error {
 	return nil
 }
+
+func (m *MockGameRoundRepository) GetByRoundID(ctx context.Context, roundID string) (*gmsDomain.GameRound, error) {
+	return nil, nil
+}
+
+func (m *MockGameRoundRepository) ListRecent(ctx context.Context, tableID string, limit int) ([]*gmsDomain.GameRound, error) {
+	return nil, nil
+}
+
+func (m *MockGameRoundRepository) ListByTimeRange(ctx context.Context, tableID string, from, to time.Time, limit int) ([]*gmsDomain.GameRound, error) {
+	return nil, nil
+}
+
+// MockBetOrderRepository for testing, shared with the other integration test packages
+type MockBetOrderRepository = testutil.MockBetOrderRepository
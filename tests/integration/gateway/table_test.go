@@ -0,0 +1,165 @@
+package gateway_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	gmsLocal "github.com/frankieli/game_product/internal/modules/color_game/gms/adapter/local"
+	gmsMachine "github.com/frankieli/game_product/internal/modules/color_game/gms/machine"
+	gmsUC "github.com/frankieli/game_product/internal/modules/color_game/gms/usecase"
+	gsLocal "github.com/frankieli/game_product/internal/modules/color_game/gs/adapter/local"
+	gsRepo "github.com/frankieli/game_product/internal/modules/color_game/gs/repository/memory"
+	gsUC "github.com/frankieli/game_product/internal/modules/color_game/gs/usecase"
+	gatewayLocal "github.com/frankieli/game_product/internal/modules/gateway/adapter/local"
+	gatewayUC "github.com/frankieli/game_product/internal/modules/gateway/usecase"
+	"github.com/frankieli/game_product/internal/modules/gateway/ws"
+	"github.com/frankieli/game_product/internal/modules/wallet"
+	pbCommon "github.com/frankieli/game_product/shared/proto/common"
+)
+
+func newTableStateMachine(tableID string, betting time.Duration) *gmsMachine.StateMachine {
+	stateMachine := gmsMachine.NewStateMachine()
+	stateMachine.TableID = tableID
+	stateMachine.WaitDuration = 50 * time.Millisecond
+	stateMachine.BettingDuration = betting
+	stateMachine.DrawingDuration = 50 * time.Millisecond
+	stateMachine.ResultDuration = 50 * time.Millisecond
+	stateMachine.RestDuration = 50 * time.Millisecond
+	return stateMachine
+}
+
+// sendCommand sends a color game command through the gateway and returns the data of the response
+func sendCommand(t *testing.T, gateway *gatewayUC.GatewayUseCase, userID int64, command string, data map[string]interface{}) map[string]interface{} {
+	t.Helper()
+
+	reqJSON, _ := json.Marshal(map[string]interface{}{
+		"game_code": "color_game",
+		"command":   command,
+		"data":      data,
+	})
+	respJSON, err := gateway.HandleMessage(context.Background(), userID, reqJSON)
+	if err != nil {
+		t.Fatalf("%s failed: %v", command, err)
+	}
+
+	var resp struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(respJSON, &resp); err != nil {
+		t.Fatalf("Failed to unmarshal %s response: %v", command, err)
+	}
+	return resp.Data
+}
+
+// collectRoundStates returns the table_id of every round state broadcast a client received within d
+func collectRoundStates(client *ws.Connection, d time.Duration) []string {
+	var tableIDs []string
+	timeout := time.After(d)
+	for {
+		select {
+		case msg := <-client.Send:
+			var brc struct {
+				Command string `json:"command"`
+				Data    struct {
+					TableID string `json:"table_id"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(msg, &brc); err == nil && brc.Command == "ColorGameRoundStateBRC" {
+				tableIDs = append(tableIDs, brc.Data.TableID)
+			}
+		case <-timeout:
+			return tableIDs
+		}
+	}
+}
+
+// waitForRoundHistory returns the table_id of the first results roadmap pushed to a client within d ("" if none)
+func waitForRoundHistory(client *ws.Connection, d time.Duration) string {
+	timeout := time.After(d)
+	for {
+		select {
+		case msg := <-client.Send:
+			var brc struct {
+				Command string `json:"command"`
+				Data    struct {
+					TableID string `json:"table_id"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(msg, &brc); err == nil && brc.Command == "ColorGameRoundHistoryBRC" {
+				return brc.Data.TableID
+			}
+		case <-timeout:
+			return ""
+		}
+	}
+}
+
+func TestTableBroadcastsReachOnlyMembers(t *testing.T) {
+	// 1. Gateway with a local WebSocket manager, two players connected
+	manager := ws.NewManager()
+	go manager.Run()
+	defaultPlayer := manager.Register(nil, 3001)
+	vipPlayer := manager.Register(nil, 3002)
+
+	// 2. GMS hosts two tables with different betting durations
+	defaultTable := newTableStateMachine("default", 200*time.Millisecond)
+	vipTable := newTableStateMachine("vip", 400*time.Millisecond)
+
+	broadcaster := gatewayLocal.NewHandler(manager)
+	roundUC := gmsUC.NewGMSUseCase(defaultTable, broadcaster, nil, &MockGameRoundRepository{})
+	roundUC.AddTable(vipTable)
+
+	playerUC := gsUC.NewGSUseCase(gsRepo.NewBetRepository(), &MockBetOrderRepository{}, gmsLocal.NewHandler(roundUC), wallet.NewMockService(), broadcaster)
+	gateway := gatewayUC.NewGatewayUseCase(gsLocal.NewHandler(playerUC), manager)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	go roundUC.Start(ctx)
+	time.Sleep(20 * time.Millisecond)
+
+	// 3. Players join their tables, an unknown table is rejected
+	if data := sendCommand(t, gateway, 3001, "ColorGameJoinTableREQ", map[string]interface{}{"table_id": "default"}); data["error_code"] != float64(pbCommon.ErrorCode_SUCCESS) {
+		t.Fatalf("Expected to join default table, got %v", data)
+	}
+	if data := sendCommand(t, gateway, 3002, "ColorGameJoinTableREQ", map[string]interface{}{"table_id": "vip"}); data["error_code"] != float64(pbCommon.ErrorCode_SUCCESS) {
+		t.Fatalf("Expected to join vip table, got %v", data)
+	}
+	if tableID := waitForRoundHistory(vipPlayer, 100*time.Millisecond); tableID != "vip" {
+		t.Errorf("Expected the vip roadmap pushed on join, got %q", tableID)
+	}
+	if data := sendCommand(t, gateway, 3002, "ColorGameJoinTableREQ", map[string]interface{}{"table_id": "missing"}); data["error_code"] != float64(pbCommon.ErrorCode_NOT_FOUND) {
+		t.Errorf("Expected NOT_FOUND for unknown table, got %v", data)
+	}
+	if manager.CurrentTable(3002, "color_game") != "vip" {
+		t.Errorf("Expected a rejected join to keep the vip table, got %q", manager.CurrentTable(3002, "color_game"))
+	}
+
+	// 4. Each player only receives the rounds of its own table
+	defaultStates := make(chan []string, 1)
+	go func() { defaultStates <- collectRoundStates(defaultPlayer, time.Second) }()
+	vipStates := collectRoundStates(vipPlayer, time.Second)
+
+	for name, states := range map[string][]string{"default": <-defaultStates, "vip": vipStates} {
+		if len(states) == 0 {
+			t.Errorf("Expected %s player to receive round states", name)
+		}
+		for _, tableID := range states {
+			if tableID != name {
+				t.Errorf("Player of table %s received a broadcast of table %s", name, tableID)
+			}
+		}
+	}
+
+	// 5. A player that left its table no longer receives broadcasts
+	if data := sendCommand(t, gateway, 3002, "ColorGameLeaveTableREQ", nil); data["table_id"] != "vip" {
+		t.Errorf("Expected to leave vip table, got %v", data)
+	}
+	collectRoundStates(vipPlayer, 50*time.Millisecond) // drain broadcasts sent before leaving
+	if states := collectRoundStates(vipPlayer, 500*time.Millisecond); len(states) != 0 {
+		t.Errorf("Expected no broadcasts after leaving the table, got %v", states)
+	}
+
+	_ = roundUC.GracefulShutdown(time.Second)
+}
@@ -0,0 +1,166 @@
+// Package testutil holds the test doubles shared by the integration test packages.
+package testutil
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	gsDomain "github.com/frankieli/game_product/internal/modules/color_game/gs/domain"
+)
+
+// MockBetOrderRepository is an in-memory gsDomain.BetOrderRepository, orders are upserted by OrderID
+type MockBetOrderRepository struct {
+	orders []*gsDomain.BetOrder
+	mu     sync.Mutex
+}
+
+func (m *MockBetOrderRepository) BatchCreate(ctx context.Context, orders []*gsDomain.BetOrder) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, order := range orders {
+		m.upsert(order)
+	}
+	return nil
+}
+
+func (m *MockBetOrderRepository) SavePending(ctx context.Context, orders []*gsDomain.BetOrder) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, order := range orders {
+		if existing := m.find(order.OrderID); existing != nil && existing.Status != gsDomain.BetOrderStatusPending {
+			return gsDomain.ErrBetChanged
+		}
+	}
+	for _, order := range orders {
+		m.upsert(order)
+	}
+	return nil
+}
+
+func (m *MockBetOrderRepository) ListPending(ctx context.Context, limit int) ([]*gsDomain.BetOrder, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	pending := make([]*gsDomain.BetOrder, 0)
+	for _, order := range m.orders {
+		if order.Status == gsDomain.BetOrderStatusPending && order.FlaggedAt == nil && len(pending) < limit {
+			pending = append(pending, order)
+		}
+	}
+	return pending, nil
+}
+
+func (m *MockBetOrderRepository) Flag(ctx context.Context, orderIDs []string, flaggedAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, id := range orderIDs {
+		if order := m.find(id); order != nil {
+			order.FlaggedAt = &flaggedAt
+		}
+	}
+	return nil
+}
+
+func (m *MockBetOrderRepository) ListByUser(ctx context.Context, userID int64, cursor *gsDomain.BetOrderCursor, limit int) ([]*gsDomain.BetOrder, error) {
+	return m.Search(ctx, gsDomain.BetOrderFilter{UserID: userID}, cursor, limit)
+}
+
+func (m *MockBetOrderRepository) ListByRound(ctx context.Context, roundID string) ([]*gsDomain.BetOrder, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	orders := make([]*gsDomain.BetOrder, 0)
+	for _, order := range m.orders {
+		if order.RoundID == roundID {
+			stored := *order
+			orders = append(orders, &stored)
+		}
+	}
+	sort.SliceStable(orders, func(i, j int) bool { return orders[i].CreatedAt.Before(orders[j].CreatedAt) })
+	return orders, nil
+}
+
+func (m *MockBetOrderRepository) ListByUserRound(ctx context.Context, userID int64, roundID string) ([]*gsDomain.BetOrder, error) {
+	orders, _ := m.ListByRound(ctx, roundID)
+	owned := make([]*gsDomain.BetOrder, 0, len(orders))
+	for _, order := range orders {
+		if order.UserID == userID {
+			owned = append(owned, order)
+		}
+	}
+	return owned, nil
+}
+
+func (m *MockBetOrderRepository) Search(ctx context.Context, filter gsDomain.BetOrderFilter, cursor *gsDomain.BetOrderCursor, limit int) ([]*gsDomain.BetOrder, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	orders := make([]*gsDomain.BetOrder, 0)
+	for _, order := range m.orders {
+		if (filter.UserID != 0 && order.UserID != filter.UserID) ||
+			(filter.GameCode != "" && order.GameCode != filter.GameCode) ||
+			(!filter.From.IsZero() && order.CreatedAt.Before(filter.From)) ||
+			(!filter.To.IsZero() && !order.CreatedAt.Before(filter.To)) {
+			continue
+		}
+		stored := *order
+		orders = append(orders, &stored)
+	}
+	// Newest first, the cursor is the last order of the previous page
+	newer := func(a *gsDomain.BetOrder, createdAt time.Time, orderID string) bool {
+		return a.CreatedAt.After(createdAt) || (a.CreatedAt.Equal(createdAt) && a.OrderID > orderID)
+	}
+	sort.Slice(orders, func(i, j int) bool { return newer(orders[i], orders[j].CreatedAt, orders[j].OrderID) })
+	page := make([]*gsDomain.BetOrder, 0, limit)
+	for _, order := range orders {
+		if cursor != nil && !newer(&gsDomain.BetOrder{CreatedAt: cursor.CreatedAt, OrderID: cursor.OrderID}, order.CreatedAt, order.OrderID) {
+			continue
+		}
+		if len(page) < limit {
+			page = append(page, order)
+		}
+	}
+	return page, nil
+}
+
+// Orders returns a copy of every stored order in the order they were first saved
+func (m *MockBetOrderRepository) Orders() []*gsDomain.BetOrder {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	orders := make([]*gsDomain.BetOrder, 0, len(m.orders))
+	for _, order := range m.orders {
+		stored := *order
+		orders = append(orders, &stored)
+	}
+	return orders
+}
+
+// Order returns a copy of the stored order, nil when it does not exist
+func (m *MockBetOrderRepository) Order(orderID string) *gsDomain.BetOrder {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if order := m.find(orderID); order != nil {
+		stored := *order
+		return &stored
+	}
+	return nil
+}
+
+func (m *MockBetOrderRepository) upsert(order *gsDomain.BetOrder) {
+	stored := *order
+	for i, existing := range m.orders {
+		if existing.OrderID == order.OrderID {
+			m.orders[i] = &stored
+			return
+		}
+	}
+	m.orders = append(m.orders, &stored)
+}
+
+func (m *MockBetOrderRepository) find(orderID string) *gsDomain.BetOrder {
+	for _, order := range m.orders {
+		if order.OrderID == orderID {
+			return order
+		}
+	}
+	return nil
+}